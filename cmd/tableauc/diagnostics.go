@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/tableauio/tableau"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	ErrorFormatText  = "text"  // localized text, for humans
+	ErrorFormatJSON  = "json"  // JSON array of diagnostics
+	ErrorFormatSARIF = "sarif" // SARIF 2.1.0 log, e.g.: for GitHub code scanning
+)
+
+// checkErrorFormat checks the --error-format flag.
+func checkErrorFormat() error {
+	switch errorFormat {
+	case ErrorFormatText, ErrorFormatJSON, ErrorFormatSARIF:
+		return nil
+	default:
+		return fmt.Errorf("unknown error format: %s", errorFormat)
+	}
+}
+
+// reportDiagnostics renders err (nil means no errors) in the machine-readable
+// error format. If --error-output is specified, the report is written to it
+// and the returned report is empty.
+func reportDiagnostics(err error) (string, error) {
+	diags := xerrors.NewDesc(err).Diagnostics()
+	var v any
+	switch errorFormat {
+	case ErrorFormatJSON:
+		if diags == nil {
+			diags = []*xerrors.Diagnostic{}
+		}
+		v = diags
+	case ErrorFormatSARIF:
+		v = newSARIFLog(diags)
+	default:
+		return "", fmt.Errorf("unknown error format: %s", errorFormat)
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal diagnostics failed: %s", err)
+	}
+	if errorOutput == "" {
+		return string(data), nil
+	}
+	if err := os.MkdirAll(filepath.Dir(errorOutput), 0755); err != nil {
+		return "", fmt.Errorf("create dir of error output failed: %s", err)
+	}
+	if err := os.WriteFile(errorOutput, append(data, '\n'), 0644); err != nil {
+		return "", fmt.Errorf("write error output failed: %s", err)
+	}
+	return "", nil
+}
+
+// SARIF 2.1.0, see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+//
+// Only the subset used by GitHub code scanning is defined, see
+// https://docs.github.com/en/code-security/code-scanning/integrating-with-code-scanning/sarif-support-for-code-scanning
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type sarifLog struct {
+	Schema  string      `json:"$schema"`
+	Version string      `json:"version"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool      `json:"tool"`
+	Results []*sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version,omitempty"`
+	InformationURI string       `json:"informationUri,omitempty"`
+	Rules          []*sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	Help             *sarifMessage `json:"help,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string           `json:"ruleId,omitempty"`
+	Level     string           `json:"level"`
+	Message   sarifMessage     `json:"message"`
+	Locations []*sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// newSARIFLog converts diagnostics to a SARIF log with one run. Each error
+// code is a rule.
+func newSARIFLog(diags []*xerrors.Diagnostic) *sarifLog {
+	run := &sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "tableauc",
+				Version:        tableau.GetVersionInfo().Version,
+				InformationURI: "https://tableauio.github.io",
+			},
+		},
+		Results: []*sarifResult{},
+	}
+	rules := map[string]bool{}
+	for _, diag := range diags {
+		result := &sarifResult{
+			RuleID:  diag.Code,
+			Level:   "error",
+			Message: sarifMessage{Text: diag.Message},
+		}
+		if loc := sarifLocationOf(diag); loc != nil {
+			result.Locations = []*sarifLocation{loc}
+		}
+		run.Results = append(run.Results, result)
+		if diag.Code != "" && !rules[diag.Code] {
+			rules[diag.Code] = true
+			rule := &sarifRule{ID: diag.Code}
+			if diag.Desc != "" {
+				rule.ShortDescription = &sarifMessage{Text: diag.Desc}
+			}
+			if diag.Help != "" {
+				rule.Help = &sarifMessage{Text: diag.Help}
+			}
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+		}
+	}
+	return &sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []*sarifRun{run},
+	}
+}
+
+// sarifLocationOf returns the location of the workbook file (relative to
+// current working dir if indir is relative). For CSV workbooks, the
+// worksheet file and the cell region (row as line, column as column) are
+// located.
+func sarifLocationOf(diag *xerrors.Diagnostic) *sarifLocation {
+	if diag.Book == "" {
+		return nil
+	}
+	bookPath := diag.Book
+	if !filepath.IsAbs(bookPath) {
+		bookPath = filepath.Join(indir, bookPath)
+	}
+	uri := filepath.ToSlash(filepath.Clean(bookPath))
+	var region *sarifRegion
+	if format.GetFormat(bookPath) == format.CSV && diag.Sheet != "" {
+		// CSV book name pattern "<BookName>#*.csv" to worksheet file
+		// "<BookName>#<SheetName>.csv"
+		if bookName, _, err := xfs.ParseCSVFilenamePattern(bookPath); err == nil {
+			uri = path.Join(path.Dir(uri), path.Base(bookName)+"#"+diag.Sheet+format.CSVExt)
+			if col, row, err := excelize.CellNameToCoordinates(diag.CellPos); err == nil {
+				region = &sarifRegion{StartLine: row, StartColumn: col}
+			}
+		}
+	}
+	return &sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: uri},
+			Region:           region,
+		},
+	}
+}
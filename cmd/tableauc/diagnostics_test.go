@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+)
+
+func TestNewSARIFLog(t *testing.T) {
+	indir = "testdata"
+	defer func() { indir = "" }()
+	diags := []*xerrors.Diagnostic{
+		{
+			Code:    "E2027",
+			Desc:    "protovalidate violation",
+			Book:    "Validate#*.csv",
+			Sheet:   "ValidateFieldLevel",
+			CellPos: "B5",
+			Help:    "fix the field value",
+			Message: "error[E2027]: protovalidate violation",
+		},
+		{
+			Code:    "E2027",
+			Book:    "excel/Item.xlsx",
+			Sheet:   "ItemConf",
+			CellPos: "C3",
+			Message: "error[E2027]: protovalidate violation",
+		},
+		{Message: "plain error"},
+	}
+	log := newSARIFLog(diags)
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	require.Len(t, run.Tool.Driver.Rules, 1)
+	assert.Equal(t, "E2027", run.Tool.Driver.Rules[0].ID)
+	require.Len(t, run.Results, 3)
+
+	loc := run.Results[0].Locations[0].PhysicalLocation
+	assert.Equal(t, "testdata/Validate#ValidateFieldLevel.csv", loc.ArtifactLocation.URI)
+	assert.Equal(t, &sarifRegion{StartLine: 5, StartColumn: 2}, loc.Region)
+
+	loc = run.Results[1].Locations[0].PhysicalLocation
+	assert.Equal(t, "testdata/excel/Item.xlsx", loc.ArtifactLocation.URI)
+	assert.Nil(t, loc.Region)
+
+	assert.Empty(t, run.Results[2].Locations)
+	assert.Equal(t, "plain error", run.Results[2].Message.Text)
+}
+
+func TestReportDiagnostics(t *testing.T) {
+	defer func() { errorFormat, errorOutput = ErrorFormatText, "" }()
+	err := xerrors.WrapKV(xerrors.E2005("1"), xerrors.KeyBookName, "Item.xlsx")
+
+	errorFormat = ErrorFormatJSON
+	report, rerr := reportDiagnostics(err)
+	require.NoError(t, rerr)
+	var diags []*xerrors.Diagnostic
+	require.NoError(t, json.Unmarshal([]byte(report), &diags))
+	require.Len(t, diags, 1)
+	assert.Equal(t, "E2005", diags[0].Code)
+	assert.Equal(t, "Item.xlsx", diags[0].Book)
+
+	report, rerr = reportDiagnostics(nil)
+	require.NoError(t, rerr)
+	assert.Equal(t, "[]", report)
+
+	errorFormat = ErrorFormatSARIF
+	errorOutput = filepath.Join(t.TempDir(), "report", "tableauc.sarif")
+	report, rerr = reportDiagnostics(err)
+	require.NoError(t, rerr)
+	assert.Empty(t, report)
+	data, rerr := os.ReadFile(errorOutput)
+	require.NoError(t, rerr)
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(data, &log))
+	assert.Equal(t, sarifVersion, log.Version)
+	require.Len(t, log.Runs, 1)
+	assert.Len(t, log.Runs[0].Results, 1)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tableauio/tableau"
+	"github.com/tableauio/tableau/log"
+)
+
+// newLSPCmd builds the lsp subcommand, which serves LSP over stdio for
+// workbooks in text formats (CSV, YAML, and XML).
+func newLSPCmd() *cobra.Command {
+	lspCmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Run the language server (LSP over stdio) for CSV/YAML/XML workbooks.",
+		Long: `Run the language server (LSP over stdio) for CSV/YAML/XML workbooks, which
+publishes diagnostics by parsing the changed workbook against the proto files
+configured in conf.input, and offers hover info of field notes and completions
+of enum values and refer targets.`,
+		Args: cobra.NoArgs,
+		RunE: runLSP,
+	}
+	lspCmd.Flags().StringVarP(&protoPackage, "proto-package", "p", "protoconf", "Protobuf package name.")
+	lspCmd.Flags().StringVarP(&indir, "indir", "i", ".", "Input directory, default is current directory.")
+	lspCmd.Flags().StringVarP(&configPath, "config", "c", "", "Tableauc config file path, e.g.: ./config.yaml.")
+	return lspCmd
+}
+
+func runLSP(cmd *cobra.Command, args []string) error {
+	config, err := loadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("load config failed: %s", err)
+	}
+	if err := tableau.SetLang(config.Lang); err != nil {
+		return fmt.Errorf("set lang failed: %s", err)
+	}
+	// NOTE: stdout is used by LSP, so logs are only written to the log file
+	// if specified.
+	if config.Log != nil && config.Log.Filename != "" {
+		logOpts := *config.Log
+		logOpts.Sink = "FILE"
+		if err := log.Init(&logOpts); err != nil {
+			return fmt.Errorf("init log failed: %s", err)
+		}
+	}
+	server := tableau.NewLanguageServer(protoPackage, indir, config)
+	server.SetVersion(tableau.GetVersionInfo().Version)
+	return server.Serve(os.Stdin, os.Stdout)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -16,9 +17,12 @@ import (
 )
 
 const (
-	ModeDefault = "default" // generate both proto and conf files
-	ModeProto   = "proto"   // generate proto files only
-	ModeConf    = "conf"    // generate conf files only.
+	ModeDefault  = "default"  // generate both proto and conf files
+	ModeProto    = "proto"    // generate proto files only
+	ModeConf     = "conf"     // generate conf files only.
+	ModeTemplate = "template" // generate workbook templates from proto files.
+	ModeDoc      = "doc"      // generate documentation from proto files.
+	ModeCheck    = "check"    // check breaking changes of proto files.
 )
 
 var (
@@ -27,15 +31,25 @@ var (
 	outdir       string
 
 	preserveFieldNumbers bool
+	cache                bool
 
 	confInputIgnoreUnknownWorkbook bool
 	confOutputSubdir               string
 	confOutputFormats              []string
 
+	templateOverwrite bool
+	docFormat         string
+
 	mode             string
 	configPath       string
 	showConfigSample bool
 	dryRun           options.DryRun
+	watchMode        bool
+
+	errorFormat     string
+	errorOutput     string
+	junitReport     string
+	referenceReport string
 )
 
 func main() {
@@ -54,26 +68,49 @@ func newRootCmd() *cobra.Command {
 		Version: genVersion(),
 		Short:   "tableauc is a modern configuration converter.",
 		Long:    `Complete documentation is available on https://tableauio.github.io.`,
+		Args:    cobra.ArbitraryArgs,
 		Run:     run,
 	}
+	rootCmd.AddCommand(newLSPCmd())
 
 	rootCmd.Flags().StringVarP(&protoPackage, "proto-package", "p", "protoconf", "Protobuf package name.")
 	rootCmd.Flags().StringVarP(&indir, "indir", "i", ".", "Input directory, default is current directory.")
 	rootCmd.Flags().StringVarP(&outdir, "outdir", "o", ".", "Output directory, default is current directory.")
 	rootCmd.Flags().BoolVarP(&preserveFieldNumbers, "preserve-field-numbers", "", false, `Preserve protobuf field numbers for backward/forward compatibility (assign new fields the max field number + 1), set it to override proto.output.preserveFieldNumbers.`)
+	rootCmd.Flags().BoolVarP(&cache, "cache", "", false, `Enable build cache to skip unchanged workbooks in incremental builds, set it to override cache.enable.`)
 	rootCmd.Flags().StringVarP(&confOutputSubdir, "conf-output-subdir", "", "", "Conf output sub-directory, set it to override conf.output.subdir.")
 	rootCmd.Flags().StringSliceVarP(&confOutputFormats, "conf-output-formats", "", nil, "Available format: json, binpb, and txtpb, set it to override conf.output.formats.")
 	rootCmd.Flags().BoolVarP(&confInputIgnoreUnknownWorkbook, "conf-input-ignore-unknown-workbook", "", false, `Whether converter will not report an error and abort if a workbook
 is not recognized in proto files.`)
 
-	rootCmd.Flags().StringVarP(&mode, "mode", "m", "default", `Available mode: default, proto, and conf.
+	rootCmd.Flags().BoolVarP(&templateOverwrite, "template-overwrite", "", false, "Whether to overwrite the existing workbooks in template mode.")
+
+	rootCmd.Flags().StringVarP(&docFormat, "doc-format", "", "md", "Available format: md and html, used in doc mode.")
+
+	rootCmd.Flags().StringVarP(&mode, "mode", "m", "default", `Available mode: default, proto, conf, template, doc, and check.
   - default: generate both proto and conf files.
   - proto: generate proto files only.
   - conf: generate conf files only.
+  - template: generate empty workbook templates from proto files.
+  - doc: generate documentation (Markdown or HTML) from proto files.
+  - check: check breaking changes between previously generated and new proto files.
 `)
 	rootCmd.Flags().StringVarP(&configPath, "config", "c", "", "Tableauc config file path, e.g.: ./config.yaml.")
 	rootCmd.Flags().BoolVarP(&showConfigSample, "show-config-sample", "s", false, "Show config sample.")
 	rootCmd.Flags().StringVarP(&dryRun, "dry-run", "", "", "Preview the final result, available: patch.")
+	rootCmd.Flags().BoolVarP(&watchMode, "watch", "w", false, `Keep running and regenerate the changed workbooks in indir (or subdirs)
+after the first generation, only available in mode: default, proto, and conf.`)
+	rootCmd.Flags().StringVarP(&errorFormat, "error-format", "", "text", `Available format: text, json, and sarif.
+  - text: localized text for humans.
+  - json: JSON array of diagnostics (error code, module, book, sheet, cell
+    position, column name, value, and localized message).
+  - sarif: SARIF 2.1.0 log, e.g.: for GitHub code scanning.`)
+	rootCmd.Flags().StringVarP(&errorOutput, "error-output", "", "", `Write errors in the error format to this file (an empty report if no
+errors) instead of stderr, and print errors as text to stderr.`)
+	rootCmd.Flags().StringVarP(&junitReport, "junit-report", "", "", "JUnit XML report file path of validation results, set it to override conf.output.junitReport.")
+	rootCmd.Flags().StringVarP(&referenceReport, "reference-report", "", "", `Reverse reference report file path (JSON if ".json" extension, otherwise
+text) of referred values not referred by any field, set it to override
+conf.output.referenceReport.`)
 
 	return rootCmd
 }
@@ -90,6 +127,9 @@ func runE(cmd *cobra.Command, args []string) error {
 	if showConfigSample {
 		return ShowConfigSample()
 	}
+	if err := checkErrorFormat(); err != nil {
+		return err
+	}
 
 	config, err := loadConfig(configPath)
 	if err != nil {
@@ -105,6 +145,30 @@ func runE(cmd *cobra.Command, args []string) error {
 	yamlOut, _ := yaml.Marshal(config)
 	log.Debugf("loaded config:\n%s", string(yamlOut))
 
+	if watchMode {
+		if mode != ModeDefault && mode != ModeProto && mode != ModeConf {
+			return fmt.Errorf("watch is not available in mode: %s", mode)
+		}
+		// errors are not fatal in watch mode
+		if err := generate(args, config); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		return watch(config)
+	}
+	if err := generate(args, config); err != nil {
+		return err
+	}
+	if errorFormat != ErrorFormatText && errorOutput != "" {
+		// write an empty report
+		if _, err := reportDiagnostics(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate runs the generator(s) of current mode.
+func generate(args []string, config *options.Options) error {
 	switch mode {
 	case ModeDefault:
 		if err := genProto(args, config); err != nil {
@@ -117,6 +181,12 @@ func runE(cmd *cobra.Command, args []string) error {
 		return genProto(args, config)
 	case ModeConf:
 		return genConf(args, config)
+	case ModeTemplate:
+		return genTemplate(args, config)
+	case ModeDoc:
+		return genDoc(config)
+	case ModeCheck:
+		return genCheck(args, config)
 	default:
 		return fmt.Errorf("unknown mode: %s", mode)
 	}
@@ -128,10 +198,10 @@ func runE(cmd *cobra.Command, args []string) error {
 // override takes effect only when its flag is explicitly provided on the
 // command line, so config-file values are preserved when a flag is omitted.
 //
-// --preserve-field-numbers is bidirectional: both --preserve-field-numbers
-// and --preserve-field-numbers=false override the config value (the latter
-// disables it even when set to true in the config file). The other flags
-// preserve their pre-existing, one-directional override semantics.
+// --preserve-field-numbers and --cache are bidirectional: both --<flag> and
+// --<flag>=false override the config value (the latter disables it even when
+// set to true in the config file). The other flags preserve their
+// pre-existing, one-directional override semantics.
 //
 // NOTE: --conf-output-subdir is applied later in genConf to gain dynamic
 // output subdir ability, so it is intentionally not handled here.
@@ -142,6 +212,15 @@ func applyFlags(cmd *cobra.Command, config *options.Options) {
 		v, _ := cmd.Flags().GetBool("preserve-field-numbers")
 		config.Proto.Output.PreserveFieldNumbers = v
 	}
+	if cmd.Flags().Changed("cache") {
+		// override cache.enable in config file if the flag is explicitly
+		// set (either true or false).
+		v, _ := cmd.Flags().GetBool("cache")
+		if config.Cache == nil {
+			config.Cache = &options.CacheOption{}
+		}
+		config.Cache.Enable = v
+	}
 	if cmd.Flags().Changed("conf-output-formats") {
 		formats, _ := cmd.Flags().GetStringSlice("conf-output-formats")
 		if len(formats) != 0 {
@@ -164,6 +243,18 @@ func applyFlags(cmd *cobra.Command, config *options.Options) {
 			config.Conf.Output.DryRun = options.DryRun(v)
 		}
 	}
+	if cmd.Flags().Changed("junit-report") {
+		// use command argument if provided
+		if v, _ := cmd.Flags().GetString("junit-report"); v != "" {
+			config.Conf.Output.JUnitReport = v
+		}
+	}
+	if cmd.Flags().Changed("reference-report") {
+		// use command argument if provided
+		if v, _ := cmd.Flags().GetString("reference-report"); v != "" {
+			config.Conf.Output.ReferenceReport = v
+		}
+	}
 }
 
 // genProto runs the proto generator to convert the specified workbooks into .proto files.
@@ -189,9 +280,54 @@ func genConf(workbooks []string, config *options.Options) error {
 	return nil
 }
 
+// genTemplate runs the book generator to generate empty workbook templates
+// from the proto files configured in conf.input.
+func genTemplate(workbooks []string, config *options.Options) error {
+	gen := tableau.NewBookGeneratorWithOptions(protoPackage, outdir, config)
+	gen.Overwrite = templateOverwrite
+	if err := gen.Generate(workbooks...); err != nil {
+		return formatError(ModeTemplate, err)
+	}
+	return nil
+}
+
+// genDoc runs the doc generator to generate documentation from the proto
+// files configured in conf.input.
+func genDoc(config *options.Options) error {
+	gen := tableau.NewDocGeneratorWithOptions(protoPackage, outdir, config)
+	gen.Format = format.Format(docFormat)
+	if err := gen.Generate(); err != nil {
+		return formatError(ModeDoc, err)
+	}
+	return nil
+}
+
+// genCheck runs the proto generator to check breaking changes between the
+// previously generated proto files and the new ones converted from the
+// specified workbooks.
+func genCheck(workbooks []string, config *options.Options) error {
+	gen := tableau.NewProtoGeneratorWithOptions(protoPackage, indir, outdir, config)
+	if err := gen.Check(workbooks...); err != nil {
+		return formatError(ModeCheck, err)
+	}
+	return nil
+}
+
 // formatError formats the generation error message. At debug level, it includes the full stack
 // trace (%+v) for detailed diagnostics; at higher levels, it uses a concise format (%v).
+//
+// In machine-readable error formats, the diagnostics report is returned as
+// the error message, or written to the error output file if specified.
 func formatError(mode string, err error) error {
+	if errorFormat != ErrorFormatText {
+		report, rerr := reportDiagnostics(err)
+		if rerr != nil {
+			return rerr
+		}
+		if report != "" {
+			return errors.New(report)
+		}
+	}
 	if log.LevelEnabled(zapcore.DebugLevel) {
 		return fmt.Errorf("generate %s failed: \n%+v", mode, err)
 	} else {
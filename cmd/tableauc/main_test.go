@@ -55,6 +55,32 @@ func TestApplyFlags_PreserveFieldNumbers(t *testing.T) {
 	}
 }
 
+// TestApplyFlags_Cache covers the --cache flag's bidirectional override of
+// cache.enable, including a config file without the cache section.
+func TestApplyFlags_Cache(t *testing.T) {
+	cases := []struct {
+		name  string
+		cache *options.CacheOption // config value before applying flags
+		args  []string
+		want  bool
+	}{
+		{"flag omitted: default false preserved", &options.CacheOption{}, nil, false},
+		{"flag omitted: config true preserved", &options.CacheOption{Enable: true}, nil, true},
+		{"bare flag enables from false", &options.CacheOption{}, []string{"--cache"}, true},
+		{"bare flag enables without cache section", nil, []string{"--cache"}, true},
+		{"explicit false disables even when config true", &options.CacheOption{Enable: true}, []string{"--cache=false"}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newCmd(t, tc.args...)
+			config := options.NewDefault()
+			config.Cache = tc.cache
+			applyFlags(cmd, config)
+			assert.Equal(t, tc.want, config.Cache.Enable)
+		})
+	}
+}
+
 // TestApplyFlags_ConfOutputFormats verifies the consolidation of the
 // --conf-output-formats override into applyFlags did not change behavior:
 // the flag overrides only when a non-empty list is provided.
@@ -116,3 +142,39 @@ func TestApplyFlags_DryRun(t *testing.T) {
 		assert.Equal(t, options.DryRun(""), config.Conf.Output.DryRun)
 	})
 }
+
+// TestApplyFlags_JUnitReport verifies the --junit-report override only
+// applies when a non-empty value is provided.
+func TestApplyFlags_JUnitReport(t *testing.T) {
+	t.Run("flag overrides junit report", func(t *testing.T) {
+		cmd := newCmd(t, "--junit-report=_out/report.xml")
+		config := options.NewDefault()
+		applyFlags(cmd, config)
+		assert.Equal(t, "_out/report.xml", config.Conf.Output.JUnitReport)
+	})
+	t.Run("flag omitted preserves config", func(t *testing.T) {
+		cmd := newCmd(t)
+		config := options.NewDefault()
+		config.Conf.Output.JUnitReport = "report.xml"
+		applyFlags(cmd, config)
+		assert.Equal(t, "report.xml", config.Conf.Output.JUnitReport)
+	})
+}
+
+// TestApplyFlags_ReferenceReport verifies the --reference-report override
+// only applies when a non-empty value is provided.
+func TestApplyFlags_ReferenceReport(t *testing.T) {
+	t.Run("flag overrides reference report", func(t *testing.T) {
+		cmd := newCmd(t, "--reference-report=_out/reference.json")
+		config := options.NewDefault()
+		applyFlags(cmd, config)
+		assert.Equal(t, "_out/reference.json", config.Conf.Output.ReferenceReport)
+	})
+	t.Run("flag omitted preserves config", func(t *testing.T) {
+		cmd := newCmd(t)
+		config := options.NewDefault()
+		config.Conf.Output.ReferenceReport = "reference.txt"
+		applyFlags(cmd, config)
+		assert.Equal(t, "reference.txt", config.Conf.Output.ReferenceReport)
+	})
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/tableauio/tableau"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
+)
+
+// defaultDebounce is the quiet period to wait for after the last change
+// before regenerating. Saving a workbook usually emits a burst of events,
+// e.g. Excel writes a temp file, then renames the original workbook away
+// and the temp file to the workbook.
+const defaultDebounce = 500 * time.Millisecond
+
+// watcher watches input dirs, and regenerates the changed workbooks.
+type watcher struct {
+	indir      string
+	dirs       []string        // dirs to watch recursively
+	formats    []format.Format // input formats, nil means all
+	debounce   time.Duration
+	regenerate func(workbooks []string) error // workbooks are relative to indir
+	out        io.Writer                      // error summary output
+}
+
+// newWatcher creates a watcher of indir (or its subdirs if specified).
+func newWatcher(indir string, subdirs []string, formats []format.Format, regenerate func(workbooks []string) error) *watcher {
+	w := &watcher{
+		indir:      indir,
+		formats:    formats,
+		debounce:   defaultDebounce,
+		regenerate: regenerate,
+		out:        os.Stderr,
+	}
+	if len(subdirs) == 0 {
+		w.dirs = []string{indir}
+	}
+	for _, subdir := range subdirs {
+		w.dirs = append(w.dirs, filepath.Join(indir, subdir))
+	}
+	return w
+}
+
+// Run watches until ctx is done.
+func (w *watcher) Run(ctx context.Context) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("create watcher failed: %s", err)
+	}
+	defer fsw.Close()
+	for _, dir := range w.dirs {
+		if err := w.addRecursive(fsw, dir); err != nil {
+			return err
+		}
+	}
+	log.Infof("%15s: %s", "watching", strings.Join(w.dirs, ", "))
+
+	pending := map[string]bool{} // changed file path -> true
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-fsw.Events:
+			if !ok {
+				return nil
+			}
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := w.addRecursive(fsw, event.Name); err != nil {
+						log.Warnf("watch %s failed: %s", event.Name, err)
+					}
+					continue
+				}
+			}
+			if !w.isWorkbook(event.Name) {
+				continue
+			}
+			log.Debugf("watch event: %s", event)
+			pending[event.Name] = true
+			timer.Reset(w.debounce)
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return nil
+			}
+			log.Warnf("watch error: %s", err)
+		case <-timer.C:
+			workbooks := w.collect(pending)
+			clear(pending)
+			if len(workbooks) == 0 {
+				continue
+			}
+			log.Infof("%15s: %s", "changed", strings.Join(workbooks, ", "))
+			if err := w.regenerate(workbooks); err != nil {
+				printErrorSummary(w.out, err)
+			}
+		}
+	}
+}
+
+// addRecursive watches dir and all its subdirs, except hidden ones (e.g.:
+// the build cache dir).
+func (w *watcher) addRecursive(fsw *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if err := fsw.Add(path); err != nil {
+			return fmt.Errorf("watch %s failed: %s", path, err)
+		}
+		return nil
+	})
+}
+
+// isWorkbook reports whether the file is an input workbook, ignoring temp
+// files (e.g.: Excel's owner file "~$Book.xlsx") and hidden files.
+func (w *watcher) isWorkbook(path string) bool {
+	name := filepath.Base(path)
+	if strings.HasPrefix(name, "~$") || strings.HasPrefix(name, ".") {
+		return false
+	}
+	return format.FilterInput(format.GetFormat(name), w.formats)
+}
+
+// collect returns the sorted workbook paths (relative to indir) of changed
+// files. Removed files are skipped, and multiple CSV files of the same
+// workbook are deduplicated.
+func (w *watcher) collect(pending map[string]bool) []string {
+	books := map[string]string{} // book key -> relative path
+	for path := range pending {
+		if info, err := os.Stat(path); err != nil || info.IsDir() {
+			continue
+		}
+		relPath, err := xfs.Rel(w.indir, path)
+		if err != nil {
+			log.Warnf("skip changed file %s: %s", path, err)
+			continue
+		}
+		key := relPath
+		if format.GetFormat(path) == format.CSV {
+			if pattern, err := xfs.ParseCSVBooknamePatternFrom(relPath); err == nil {
+				key = pattern
+			}
+		}
+		if existed, ok := books[key]; !ok || relPath < existed {
+			books[key] = relPath
+		}
+	}
+	var workbooks []string
+	for _, relPath := range books {
+		workbooks = append(workbooks, relPath)
+	}
+	slices.Sort(workbooks)
+	return workbooks
+}
+
+// printErrorSummary prints a compact error summary, one line per error.
+func printErrorSummary(out io.Writer, err error) {
+	desc := xerrors.NewDesc(err)
+	if desc == nil {
+		return
+	}
+	summary := desc.Compact()
+	fmt.Fprintf(out, "%d error(s):\n", strings.Count(summary, "\n")+1)
+	for _, line := range strings.Split(summary, "\n") {
+		fmt.Fprintf(out, "  %s\n", line)
+	}
+}
+
+// watch runs the generators of current mode on changed workbooks until
+// interrupted.
+func watch(config *options.Options) error {
+	var subdirs []string
+	var formats []format.Format
+	allFormats := false
+	addInput := func(inputSubdirs []string, inputFormats []format.Format) {
+		for _, subdir := range inputSubdirs {
+			if !slices.Contains(subdirs, subdir) {
+				subdirs = append(subdirs, subdir)
+			}
+		}
+		if len(inputFormats) == 0 {
+			allFormats = true
+		}
+		formats = append(formats, inputFormats...)
+	}
+	if mode == ModeDefault || mode == ModeProto {
+		addInput(config.Proto.Input.Subdirs, config.Proto.Input.Formats)
+	}
+	if mode == ModeDefault || mode == ModeConf {
+		addInput(config.Conf.Input.Subdirs, config.Conf.Input.Formats)
+	}
+	if allFormats {
+		formats = nil
+	}
+	w := newWatcher(indir, subdirs, formats, func(workbooks []string) error {
+		return regenerate(workbooks, config)
+	})
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return w.Run(ctx)
+}
+
+// regenerate runs the generators of current mode on the specified
+// workbooks, and returns the raw error for summary.
+func regenerate(workbooks []string, config *options.Options) error {
+	if mode == ModeDefault || mode == ModeProto {
+		gen := tableau.NewProtoGeneratorWithOptions(protoPackage, indir, outdir, config)
+		if err := gen.Generate(workbooks...); err != nil {
+			return err
+		}
+	}
+	if mode == ModeDefault || mode == ModeConf {
+		if confOutputSubdir != "" {
+			config.Conf.Output.Subdir = confOutputSubdir
+		}
+		gen := tableau.NewConfGeneratorWithOptions(protoPackage, indir, outdir, config)
+		if err := gen.Generate(workbooks...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+)
+
+func TestWatcher_isWorkbook(t *testing.T) {
+	w := newWatcher(".", nil, []format.Format{format.Excel, format.CSV}, nil)
+	assert.True(t, w.isWorkbook("excel/Item.xlsx"))
+	assert.True(t, w.isWorkbook("Item#ItemConf.csv"))
+	assert.False(t, w.isWorkbook("excel/~$Item.xlsx"), "Excel owner file")
+	assert.False(t, w.isWorkbook("excel/.Item.xlsx"), "hidden file")
+	assert.False(t, w.isWorkbook("excel/A1B2C3D4"), "Excel temp file")
+	assert.False(t, w.isWorkbook("Item.yaml"), "format not specified")
+	assert.False(t, w.isWorkbook("item.proto"))
+}
+
+func TestWatcher_collect(t *testing.T) {
+	indir := t.TempDir()
+	for _, name := range []string{"Item#ItemConf.csv", "Item#@TABLEAU.csv", "Hero.xlsx"} {
+		require.NoError(t, os.WriteFile(filepath.Join(indir, name), nil, 0644))
+	}
+	w := newWatcher(indir, nil, nil, nil)
+	got := w.collect(map[string]bool{
+		filepath.Join(indir, "Item#ItemConf.csv"): true,
+		filepath.Join(indir, "Item#@TABLEAU.csv"): true,
+		filepath.Join(indir, "Hero.xlsx"):         true,
+		filepath.Join(indir, "Removed.xlsx"):      true,
+	})
+	assert.Equal(t, []string{"Hero.xlsx", "Item#@TABLEAU.csv"}, got)
+}
+
+func TestWatcher_Run(t *testing.T) {
+	indir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(indir, "excel"), 0755))
+	regenerated := make(chan []string, 10)
+	w := newWatcher(indir, []string{"excel"}, nil, func(workbooks []string) error {
+		regenerated <- workbooks
+		return errors.New("failed to parse")
+	})
+	w.debounce = 50 * time.Millisecond
+	var out bytes.Buffer
+	w.out = &out
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.Run(ctx) }()
+	time.Sleep(100 * time.Millisecond) // wait for watches to be added
+
+	// a burst of events of an Excel save is debounced to one regeneration
+	write := func(name string) {
+		require.NoError(t, os.WriteFile(filepath.Join(indir, "excel", name), []byte(name), 0644))
+	}
+	write("~$Item.xlsx")
+	write("A1B2C3D4")
+	write("Item.xlsx")
+	require.NoError(t, os.Remove(filepath.Join(indir, "excel", "~$Item.xlsx")))
+	write("Item.xlsx")
+	select {
+	case workbooks := <-regenerated:
+		assert.Equal(t, []string{"excel/Item.xlsx"}, workbooks)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for regeneration")
+	}
+
+	// workbooks in a new subdir are also watched
+	require.NoError(t, os.Mkdir(filepath.Join(indir, "excel", "hero"), 0755))
+	time.Sleep(100 * time.Millisecond)
+	write("hero/Hero#HeroConf.csv")
+	select {
+	case workbooks := <-regenerated:
+		assert.Equal(t, []string{"excel/hero/Hero#HeroConf.csv"}, workbooks)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for regeneration")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Contains(t, out.String(), "1 error(s):\n  error: failed to parse\n")
+}
+
+func TestPrintErrorSummary(t *testing.T) {
+	err := errors.Join(
+		xerrors.WrapKV(xerrors.E2005("1"), xerrors.KeyBookName, "Item#*.csv", xerrors.KeySheetName, "ItemConf", xerrors.KeyDataCellPos, "A5"),
+		errors.New("plain error"),
+	)
+	var out bytes.Buffer
+	printErrorSummary(&out, err)
+	assert.Equal(t, "2 error(s):\n"+
+		"  error[E2005] Item#*.csv#ItemConf A5: map key \"1\" already exists\n"+
+		"  error: plain error\n", out.String())
+}
@@ -16,6 +16,9 @@ const (
 	JSON Format = "json"
 	Bin  Format = "binpb"
 	Text Format = "txtpb"
+	// doc formats
+	Markdown Format = "md"
+	HTML     Format = "html"
 )
 
 // File format extension
@@ -30,6 +33,9 @@ const (
 	JSONExt string = ".json"
 	BinExt  string = ".binpb"
 	TextExt string = ".txtpb"
+	// doc formats
+	MarkdownExt string = ".md"
+	HTMLExt     string = ".html"
 )
 
 // GetFormat returns the file's format by filename extension.
@@ -53,6 +59,10 @@ func Ext2Format(ext string) Format {
 		return Bin
 	case TextExt:
 		return Text
+	case MarkdownExt:
+		return Markdown
+	case HTMLExt:
+		return HTML
 	default:
 		return UnknownFormat
 	}
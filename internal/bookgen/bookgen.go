@@ -0,0 +1,205 @@
+// Package bookgen generates empty workbook templates (Excel/CSV) from
+// protoconf files, which is the reverse direction of protogen. It is useful
+// when the protoconf is written first and a matching workbook is needed.
+package bookgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/confgen"
+	"github.com/tableauio/tableau/internal/importer/book"
+	"github.com/tableauio/tableau/internal/importer/book/tableparser"
+	"github.com/tableauio/tableau/internal/importer/metasheet"
+	"github.com/tableauio/tableau/internal/strcase"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultHorizontalSize is the count of repeated columns generated for a
+// horizontal list or map if FieldProp.size is not set.
+const DefaultHorizontalSize = 3
+
+type Generator struct {
+	ctx          context.Context
+	ProtoPackage string // protobuf package name.
+	OutputDir    string // output dir of generated workbook templates.
+
+	InputOpt  *options.ConfInputOption // input settings, only proto related settings are used.
+	HeaderOpt *options.HeaderOption    // global header settings.
+
+	// Overwrite specifies whether to overwrite the existing workbooks. If
+	// false, the existing workbooks will be skipped.
+	Overwrite bool
+}
+
+func NewGenerator(protoPackage, outdir string, setters ...options.Option) *Generator {
+	opts := options.ParseOptions(setters...)
+	return NewGeneratorWithOptions(protoPackage, outdir, opts)
+}
+
+func NewGeneratorWithOptions(protoPackage, outdir string, opts *options.Options) *Generator {
+	ctx := context.Background()
+	ctx = strcase.NewContext(ctx, strcase.New(opts.Acronyms))
+	metasheetName := metasheet.DefaultMetasheetName
+	var header *options.HeaderOption
+	if opts.Proto != nil && opts.Proto.Input != nil {
+		metasheetName = opts.Proto.Input.MetasheetName
+		header = opts.Proto.Input.Header
+	}
+	ctx = metasheet.NewContext(ctx, &metasheet.Metasheet{Name: metasheetName})
+	return &Generator{
+		ctx:          ctx,
+		ProtoPackage: protoPackage,
+		OutputDir:    outdir,
+		InputOpt:     opts.Conf.Input,
+		HeaderOpt:    header,
+	}
+}
+
+// Generate generates workbook templates of all the proto files in the
+// proto package. If bookNames is not empty, only the specified workbooks
+// (e.g.: excel/Item.xlsx) will be generated.
+func (gen *Generator) Generate(bookNames ...string) error {
+	prFiles, err := confgen.LoadProtoRegistryFiles(gen.ProtoPackage, gen.InputOpt.ProtoPaths, gen.InputOpt.ProtoFiles, gen.InputOpt.ExcludedProtoFiles...)
+	if err != nil {
+		return err
+	}
+	specified := make(map[string]bool, len(bookNames))
+	for _, name := range bookNames {
+		specified[xfs.CleanSlashPath(name)] = true
+	}
+	var genErr error
+	prFiles.RangeFilesByPackage(
+		protoreflect.FullName(gen.ProtoPackage),
+		func(fd protoreflect.FileDescriptor) bool {
+			_, workbook := confgen.ParseFileOptions(fd)
+			if workbook == nil {
+				return true
+			}
+			if len(specified) != 0 && !specified[xfs.CleanSlashPath(workbook.Name)] {
+				return true
+			}
+			if err := gen.genBook(fd, workbook); err != nil {
+				genErr = xerrors.WrapKV(err, xerrors.KeyBookName, workbook.Name)
+				return false
+			}
+			return true
+		})
+	return genErr
+}
+
+func (gen *Generator) genBook(fd protoreflect.FileDescriptor, workbook *tableaupb.WorkbookOptions) error {
+	bookFormat := format.GetFormat(workbook.Name)
+	if bookFormat != format.Excel && bookFormat != format.CSV {
+		log.Debugf("skip workbook %s: format %s is not a table format", workbook.Name, bookFormat)
+		return nil
+	}
+	filename := filepath.Join(gen.OutputDir, workbook.Name)
+	bookName := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	if bookFormat == format.CSV {
+		csvBookName, _, err := xfs.ParseCSVFilenamePattern(filename)
+		if err != nil {
+			return err
+		}
+		bookName = csvBookName
+	}
+	if !gen.Overwrite {
+		existed, err := gen.exists(bookFormat, filepath.Dir(filename), bookName)
+		if err != nil {
+			return err
+		}
+		if existed {
+			log.Warnf("skip workbook %s: already existed in %s", workbook.Name, gen.OutputDir)
+			return nil
+		}
+	}
+
+	metasheetName := metasheet.FromContext(gen.ctx).Name
+	wb := book.NewBook(gen.ctx, bookName, filename, nil)
+	meta := newMetaBuilder(workbook)
+	var sheets []*book.Sheet
+	msgs := fd.Messages()
+	for i := 0; i < msgs.Len(); i++ {
+		md := msgs.Get(i)
+		_, sheetOpts := confgen.ParseMessageOptions(md)
+		if sheetOpts == nil {
+			continue // skip non-sheet
+		}
+		header := tableparser.NewHeader(sheetOpts, workbook, gen.HeaderOpt)
+		sb := newSheetBuilder(gen.ctx, gen.ProtoPackage, md)
+		rows := sb.Build(header)
+		if sheetOpts.Transpose {
+			rows = transpose(rows)
+		}
+		sheets = append(sheets, book.NewTableSheet(sheetOpts.Name, rows))
+		meta.Add(md, sheetOpts)
+	}
+	if len(sheets) == 0 {
+		log.Debugf("skip workbook %s: no worksheet found", workbook.Name)
+		return nil
+	}
+	wb.AddSheet(book.NewTableSheet(metasheetName, meta.Build()))
+	for _, sheet := range sheets {
+		wb.AddSheet(sheet)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return xerrors.Wrapf(err, "failed to create output dir: %s", filepath.Dir(filename))
+	}
+	switch bookFormat {
+	case format.Excel:
+		err := wb.ExportExcel()
+		if err != nil {
+			return err
+		}
+	case format.CSV:
+		err := wb.ExportCSV()
+		if err != nil {
+			return err
+		}
+	}
+	log.Infof("%15s: %s", "generated book", workbook.Name)
+	return nil
+}
+
+// exists checks whether the workbook already exists in dir.
+func (gen *Generator) exists(bookFormat format.Format, dir, bookName string) (bool, error) {
+	var pattern string
+	switch bookFormat {
+	case format.CSV:
+		pattern = xfs.GenCSVBooknamePattern(dir, bookName)
+	default:
+		pattern = filepath.Join(dir, bookName+format.ExcelExt)
+	}
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return false, xerrors.Wrapf(err, "failed to glob files in %s", pattern)
+	}
+	return len(matches) != 0, nil
+}
+
+// transpose interchanges the rows and columns of the table.
+func transpose(rows [][]string) [][]string {
+	maxCol := 0
+	for _, row := range rows {
+		maxCol = max(maxCol, len(row))
+	}
+	transposed := make([][]string, maxCol)
+	for col := range transposed {
+		transposed[col] = make([]string, len(rows))
+		for row := range rows {
+			if col < len(rows[row]) {
+				transposed[col][row] = rows[row][col]
+			}
+		}
+	}
+	return transposed
+}
@@ -0,0 +1,138 @@
+package bookgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/options"
+	"github.com/xuri/excelize/v2"
+)
+
+// testProtoDir is the directory containing the proto files used by tests.
+// They are loaded at runtime via protoc compiler.
+const testProtoDir = "./testdata/proto"
+
+func newTestGenerator(outdir string) *Generator {
+	return NewGenerator("bookgentest", outdir,
+		options.Conf(
+			&options.ConfOption{
+				Input: &options.ConfInputOption{
+					ProtoPaths: []string{testProtoDir},
+					ProtoFiles: []string{testProtoDir + "/*.proto"},
+				},
+			},
+		),
+	)
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestGenerator_Generate_CSV(t *testing.T) {
+	outdir := t.TempDir()
+	gen := newTestGenerator(outdir)
+	require.NoError(t, gen.Generate("Item#*.csv"))
+
+	wantMeta := `Sheet,Transpose,Index
+ItemConf,,"Type,Name@Item"
+ItemGlobalConf,true,
+`
+	assert.Equal(t, wantMeta, readFile(t, filepath.Join(outdir, "Item#@TABLEAU.csv")))
+
+	wantItem := `ID,Name,Type,Param,Reward1ID,Reward1Num,Reward2ID,Reward2Num,PropertyWeight,PropertyTag1,PropertyTag2,PropertyTag3
+"map<uint32, Item>",string,enum<.ItemType>,[]int32,[.Reward]uint32|{size:2},int32,uint32,int32,"{Property}int32|{range:""1,~""}",[]string,string,string
+Item's ID,Item's name,Item's type,Params,Reward1,Reward1,Reward2,Reward2,Weight,,,
+`
+	assert.Equal(t, wantItem, readFile(t, filepath.Join(outdir, "Item#ItemConf.csv")))
+
+	// transposed sheet
+	wantGlobal := `MaxNum,int32,Max number
+Reward,{.Reward},Reward
+TypeFlags,flags<.ItemType>,Type flags
+Title,text,Title
+Icon,"url|{schemes:""https""}",Icon
+`
+	assert.Equal(t, wantGlobal, readFile(t, filepath.Join(outdir, "Item#ItemGlobalConf.csv")))
+
+	_, err := os.Stat(filepath.Join(outdir, "hero"))
+	assert.True(t, os.IsNotExist(err), "unspecified workbook should not be generated")
+}
+
+func TestGenerator_Generate_Excel(t *testing.T) {
+	outdir := t.TempDir()
+	gen := newTestGenerator(outdir)
+	require.NoError(t, gen.Generate("hero/Hero.xlsx"))
+
+	file, err := excelize.OpenFile(filepath.Join(outdir, "hero/Hero.xlsx"))
+	require.NoError(t, err)
+	defer file.Close()
+	require.Equal(t, []string{"@TABLEAU", "Hero"}, file.GetSheetList())
+
+	rows, err := file.GetRows("@TABLEAU")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Sheet", "Alias", "Namerow", "Typerow", "Noterow", "Datarow", "Nameline", "OrderedMap"},
+		{"#", "", "1", "2", "3", "5"},
+		{"Hero", "HeroConf", "1", "2", "3", "5", "2", "true"},
+	}, rows)
+
+	rows, err = file.GetRows("Hero")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"\nHeroID", "\nHeroAttr"},
+		{"[Hero]<uint32>", "map<int32, string>"},
+		{"Hero's ID", "Attributes"},
+	}, rows)
+}
+
+func TestGenerator_Generate_Overwrite(t *testing.T) {
+	outdir := t.TempDir()
+	existed := filepath.Join(outdir, "Item#ItemConf.csv")
+	require.NoError(t, os.WriteFile(existed, []byte("ID\n"), 0644))
+
+	gen := newTestGenerator(outdir)
+	require.NoError(t, gen.Generate("Item#*.csv"))
+	assert.Equal(t, "ID\n", readFile(t, existed), "existing workbook should be skipped")
+
+	gen.Overwrite = true
+	require.NoError(t, gen.Generate("Item#*.csv"))
+	assert.NotEqual(t, "ID\n", readFile(t, existed), "existing workbook should be overwritten")
+}
+
+func Test_transpose(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]string
+		want [][]string
+	}{
+		{
+			name: "empty",
+			rows: nil,
+			want: [][]string{},
+		},
+		{
+			name: "different-row-lengths",
+			rows: [][]string{
+				{"A", "B", "C"},
+				{"1"},
+			},
+			want: [][]string{
+				{"A", "1"},
+				{"B", ""},
+				{"C", ""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, transpose(tt.rows))
+		})
+	}
+}
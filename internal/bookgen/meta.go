@@ -0,0 +1,162 @@
+package bookgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/importer/book"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"github.com/tableauio/tableau/proto/tableaupb/internalpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// metaBuilder builds the metasheet (default "@TABLEAU") from workbook and
+// worksheet options. Only the columns with non-default values are generated.
+type metaBuilder struct {
+	metasheets []*internalpb.Metasheet
+}
+
+func newMetaBuilder(workbook *tableaupb.WorkbookOptions) *metaBuilder {
+	b := &metaBuilder{}
+	bookMeta := &internalpb.Metasheet{
+		Sheet:    book.BookNameInMetasheet,
+		Alias:    workbook.GetAlias(),
+		Namerow:  workbook.GetNamerow(),
+		Typerow:  workbook.GetTyperow(),
+		Noterow:  workbook.GetNoterow(),
+		Datarow:  workbook.GetDatarow(),
+		Nameline: workbook.GetNameline(),
+		Typeline: workbook.GetTypeline(),
+		Noteline: workbook.GetNoteline(),
+		Sep:      workbook.GetSep(),
+		Subsep:   workbook.GetSubsep(),
+	}
+	// NOTE: protogen fills the book-level header options with the global
+	// ones, so only add the special row "#" if it is different.
+	defaultBookMeta := &internalpb.Metasheet{
+		Sheet:   book.BookNameInMetasheet,
+		Namerow: 1,
+		Typerow: 2,
+		Noterow: 3,
+		Datarow: 4,
+	}
+	if !proto.Equal(bookMeta, defaultBookMeta) {
+		b.metasheets = append(b.metasheets, bookMeta)
+	}
+	return b
+}
+
+// Add adds a worksheet's options to metasheet. It is the reverse of
+// [book.Sheet.ToWorkseet].
+func (b *metaBuilder) Add(md protoreflect.MessageDescriptor, opts *tableaupb.WorksheetOptions) {
+	meta := &internalpb.Metasheet{
+		Sheet: opts.Name,
+
+		Namerow: opts.Namerow,
+		Typerow: opts.Typerow,
+		Noterow: opts.Noterow,
+		Datarow: opts.Datarow,
+
+		Nameline: opts.Nameline,
+		Typeline: opts.Typeline,
+		Noteline: opts.Noteline,
+
+		Sep:                    opts.Sep,
+		Subsep:                 opts.Subsep,
+		Nested:                 opts.Nested,
+		Transpose:              opts.Transpose,
+		Labels:                 opts.Labels,
+		Merger:                 opts.Merger,
+		AdjacentKey:            opts.AdjacentKey,
+		FieldPresence:          opts.FieldPresence,
+		Template:               opts.Template,
+		Mode:                   opts.Mode,
+		Scatter:                opts.Scatter,
+		Optional:               opts.Optional,
+		Patch:                  opts.Patch,
+		WithParentDir:          opts.WithParentDir,
+		ScatterWithoutBookName: opts.ScatterWithoutBookName,
+		Validate:               opts.Validate,
+		// Loader options:
+		OrderedMap:   opts.OrderedMap,
+		Index:        strings.Join(opts.Index, ","),
+		OrderedIndex: strings.Join(opts.OrderedIndex, ","),
+		LangOptions:  opts.LangOptions,
+	}
+	if name := string(md.Name()); name != opts.Name {
+		meta.Alias = name
+	}
+	b.metasheets = append(b.metasheets, meta)
+}
+
+// Build generates the metasheet rows: the first row is name row, and the
+// other rows are data rows.
+func (b *metaBuilder) Build() [][]string {
+	fds := (&internalpb.Metasheet{}).ProtoReflect().Descriptor().Fields()
+	var used []protoreflect.FieldDescriptor
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if i == 0 {
+			// the first column "Sheet" is always needed
+			used = append(used, fd)
+			continue
+		}
+		for _, meta := range b.metasheets {
+			if meta.ProtoReflect().Has(fd) {
+				used = append(used, fd)
+				break
+			}
+		}
+	}
+	rows := make([][]string, 0, len(b.metasheets)+1)
+	var nameRow []string
+	for _, fd := range used {
+		opts := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		nameRow = append(nameRow, opts.GetName())
+	}
+	rows = append(rows, nameRow)
+	for _, meta := range b.metasheets {
+		msg := meta.ProtoReflect()
+		var row []string
+		for _, fd := range used {
+			if msg.Has(fd) {
+				row = append(row, formatValue(fd, msg.Get(fd)))
+			} else {
+				row = append(row, "")
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// formatValue formats the field value to incell text which can be parsed
+// by confgen.
+func formatValue(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch {
+	case fd.IsList():
+		var elems []string
+		list := value.List()
+		for i := 0; i < list.Len(); i++ {
+			elems = append(elems, list.Get(i).String())
+		}
+		return strings.Join(elems, ",")
+	case fd.IsMap():
+		var items []string
+		value.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+			items = append(items, key.String()+":"+val.String())
+			return true
+		})
+		sort.Strings(items)
+		return strings.Join(items, ",")
+	case fd.Kind() == protoreflect.EnumKind:
+		if ed := fd.Enum().Values().ByNumber(value.Enum()); ed != nil {
+			return string(ed.Name())
+		}
+		return fmt.Sprint(value.Enum())
+	default:
+		return value.String()
+	}
+}
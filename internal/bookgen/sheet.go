@@ -0,0 +1,487 @@
+package bookgen
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/importer/book/tableparser"
+	"github.com/tableauio/tableau/internal/strcase"
+	"github.com/tableauio/tableau/internal/types"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// wellKnownAliases maps well-known message full names to the type aliases
+// which can be used in type row.
+var wellKnownAliases = map[protoreflect.FullName]string{
+	types.WellKnownMessageTimestamp:  "datetime",
+	types.WellKnownMessageDuration:   "duration",
+	types.WellKnownMessageFraction:   "fraction",
+	types.WellKnownMessageComparator: "comparator",
+	types.WellKnownMessageVersion:    "version",
+	types.WellKnownMessageVector2:    "vector2",
+	types.WellKnownMessageVector3:    "vector3",
+	types.WellKnownMessageVector4:    "vector4",
+	types.WellKnownMessageVector2i:   "vector2i",
+	types.WellKnownMessageVector3i:   "vector3i",
+	types.WellKnownMessageVector4i:   "vector4i",
+	types.WellKnownMessageDecimal:    "decimal",
+	types.WellKnownMessageColor:      "color",
+
+	types.WellKnownMessageInterval:          "interval",
+	types.WellKnownMessageDoubleInterval:    "doubleinterval",
+	types.WellKnownMessageTimestampInterval: "datetimeinterval",
+	types.WellKnownMessageDate:              "date",
+	types.WellKnownMessageTimeOfDay:         "time",
+	types.WellKnownMessageSchedule:          "schedule",
+}
+
+// column is a column definition in the sheet header.
+type column struct {
+	name string
+	typ  string
+	prop *tableaupb.FieldProp
+	note string
+}
+
+// Type returns the full type cell text, with field property appended.
+func (c *column) Type() string {
+	if text := marshalProp(c.prop); text != "" {
+		return c.typ + "|{" + text + "}"
+	}
+	return c.typ
+}
+
+// wrap wraps the column type with the composite type prefix (e.g.: "[Item]",
+// "{Item}") and merges the composite field property.
+func (c *column) wrap(prefix string, prop *tableaupb.FieldProp) {
+	c.typ = prefix + c.typ
+	c.mergeProp(prop)
+}
+
+func (c *column) mergeProp(prop *tableaupb.FieldProp) {
+	if prop == nil {
+		return
+	}
+	merged := proto.Clone(prop).(*tableaupb.FieldProp)
+	if c.prop != nil {
+		proto.Merge(merged, c.prop)
+	}
+	c.prop = merged
+}
+
+type sheetBuilder struct {
+	ctx           context.Context
+	protoPackage  string
+	md            protoreflect.MessageDescriptor // worksheet message descriptor
+	fieldPresence bool                           // sheet-level field presence
+}
+
+func newSheetBuilder(ctx context.Context, protoPackage string, md protoreflect.MessageDescriptor) *sheetBuilder {
+	sheetOpts, _ := proto.GetExtension(md.Options(), tableaupb.E_Worksheet).(*tableaupb.WorksheetOptions)
+	return &sheetBuilder{
+		ctx:           ctx,
+		protoPackage:  protoPackage,
+		md:            md,
+		fieldPresence: sheetOpts.GetFieldPresence(),
+	}
+}
+
+// Build generates the header rows (name, type, and note) of the sheet.
+func (b *sheetBuilder) Build(header *tableparser.Header) [][]string {
+	cols := b.parseMessage(b.md, "")
+	rowCount := max(header.NameRow, header.TypeRow, header.NoteRow, header.DataRow-1)
+	rows := make([][]string, rowCount)
+	for i := range rows {
+		rows[i] = make([]string, len(cols))
+	}
+	for i, col := range cols {
+		setCell(rows[header.NameRow-1], i, header.NameLine, col.name)
+		setCell(rows[header.TypeRow-1], i, header.TypeLine, col.Type())
+		setCell(rows[header.NoteRow-1], i, header.NoteLine, col.note)
+	}
+	return rows
+}
+
+// setCell sets the cell value at the specified line. Line 0 means the
+// whole cell.
+func setCell(row []string, col, line int, value string) {
+	if line <= 0 {
+		row[col] = value
+		return
+	}
+	lines := strings.Split(row[col], "\n")
+	for len(lines) < line {
+		lines = append(lines, "")
+	}
+	lines[line-1] = value
+	row[col] = strings.Join(lines, "\n")
+}
+
+func (b *sheetBuilder) parseMessage(md protoreflect.MessageDescriptor, prefix string) []*column {
+	if xproto.IsUnion(md) {
+		return b.parseUnionMessage(md, prefix)
+	}
+	var cols []*column
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+			continue // skip oneof fields
+		}
+		cols = append(cols, b.parseField(fd, prefix)...)
+	}
+	return cols
+}
+
+func (b *sheetBuilder) parseField(fd protoreflect.FieldDescriptor, prefix string) []*column {
+	opts := b.parseFieldOptions(fd)
+	if fd.IsMap() {
+		return b.parseMapField(fd, opts, prefix)
+	} else if fd.IsList() {
+		return b.parseListField(fd, opts, prefix)
+	} else if fd.Kind() == protoreflect.MessageKind {
+		return b.parseStructField(fd, opts, prefix)
+	}
+	typ := b.scalarType(fd)
+	if flags := opts.GetProp().GetFlags(); flags != "" {
+		// protogen generates bit flags from type syntax "flags<EnumType>"
+		typ = "flags<" + b.fullNameRef(flags) + ">"
+		opts.Prop.Flags = ""
+	}
+	if opts.GetProp().GetText() {
+		// protogen generates localized text from type alias "text"
+		typ = "text"
+		opts.Prop.Text = false
+	}
+	if format := opts.GetProp().GetStringFormat(); format != tableaupb.StringFormat_STRING_FORMAT_NONE {
+		// protogen generates validated string from type alias "uuid", "regexp", or "url"
+		typ = types.StringFormatAlias(format)
+		opts.Prop.StringFormat = tableaupb.StringFormat_STRING_FORMAT_NONE
+	}
+	return []*column{{
+		name: prefix + opts.Name,
+		typ:  typ,
+		prop: opts.Prop,
+		note: opts.Note,
+	}}
+}
+
+func (b *sheetBuilder) parseMapField(fd protoreflect.FieldDescriptor, opts *tableaupb.FieldOptions, prefix string) []*column {
+	valueFd := fd.MapValue()
+	name := prefix + opts.Name
+	if valueFd.Kind() != protoreflect.MessageKind {
+		// incell scalar map
+		return []*column{{
+			name: name,
+			typ:  "map<" + fd.MapKey().Kind().String() + ", " + b.scalarType(valueFd) + ">",
+			prop: opts.Prop,
+			note: opts.Note,
+		}}
+	}
+	valueMd := valueFd.Message()
+	layout := opts.Layout
+	if layout == tableaupb.Layout_LAYOUT_DEFAULT {
+		// map default layout is vertical
+		layout = tableaupb.Layout_LAYOUT_VERTICAL
+	}
+	if layout == tableaupb.Layout_LAYOUT_INCELL {
+		// incell map with value as simple KV message, e.g.: enum key map
+		keyType, valueType := "", ""
+		if valueMd.Fields().Len() == 2 {
+			keyType = b.scalarType(valueMd.Fields().Get(0))
+			valueType = b.scalarType(valueMd.Fields().Get(1))
+		}
+		return []*column{{
+			name: name,
+			typ:  "map<" + keyType + ", " + valueType + ">",
+			prop: opts.Prop,
+			note: opts.Note,
+		}}
+	}
+	// The first field of map value message is the map key field.
+	keyType := fd.MapKey().Kind().String()
+	if valueMd.Fields().Len() != 0 {
+		keyType = b.scalarType(valueMd.Fields().Get(0))
+	}
+	mapType := "map<" + keyType + ", " + b.messageType(valueMd) + ">"
+	if layout == tableaupb.Layout_LAYOUT_VERTICAL {
+		cols := b.parseMessage(valueMd, name)
+		if len(cols) != 0 {
+			cols[0].typ = mapType
+			cols[0].mergeProp(opts.Prop)
+		}
+		return cols
+	}
+	// horizontal map
+	var cols []*column
+	for i := 1; i <= horizontalSize(opts.Prop); i++ {
+		elemCols := b.parseMessage(valueMd, name+strconv.Itoa(i))
+		prefixNote(elemCols, opts.Note, i)
+		if i == 1 && len(elemCols) != 0 {
+			elemCols[0].typ = mapType
+			elemCols[0].mergeProp(opts.Prop)
+		}
+		cols = append(cols, elemCols...)
+	}
+	return cols
+}
+
+func (b *sheetBuilder) parseListField(fd protoreflect.FieldDescriptor, opts *tableaupb.FieldOptions, prefix string) []*column {
+	name := prefix + opts.Name
+	layout := opts.Layout
+	if layout == tableaupb.Layout_LAYOUT_DEFAULT {
+		// list default layout is horizontal
+		layout = tableaupb.Layout_LAYOUT_HORIZONTAL
+	}
+	isStruct := fd.Kind() == protoreflect.MessageKind && !types.IsWellKnownMessage(fd.Message().FullName())
+	switch layout {
+	case tableaupb.Layout_LAYOUT_INCELL:
+		elemType := b.incellType(fd)
+		if opts.Key != "" && !isStruct {
+			elemType = "<" + elemType + ">"
+		}
+		return []*column{{
+			name: name,
+			typ:  "[]" + elemType,
+			prop: opts.Prop,
+			note: opts.Note,
+		}}
+	case tableaupb.Layout_LAYOUT_VERTICAL:
+		if !isStruct {
+			return []*column{{
+				name: name,
+				typ:  "[]" + b.incellType(fd),
+				prop: opts.Prop,
+				note: opts.Note,
+			}}
+		}
+		cols := b.parseMessage(fd.Message(), name)
+		if len(cols) != 0 {
+			if opts.Key != "" {
+				cols[0].typ = "<" + cols[0].typ + ">"
+			}
+			cols[0].wrap("["+b.messageType(fd.Message())+"]", opts.Prop)
+		}
+		return cols
+	default:
+		// horizontal list
+		var cols []*column
+		for i := 1; i <= horizontalSize(opts.Prop); i++ {
+			elemName := name + strconv.Itoa(i)
+			var elemCols []*column
+			if isStruct && opts.Span != tableaupb.Span_SPAN_INNER_CELL {
+				elemCols = b.parseMessage(fd.Message(), elemName)
+			} else {
+				elemCols = []*column{{
+					name: elemName,
+					typ:  b.incellType(fd),
+				}}
+			}
+			prefixNote(elemCols, opts.Note, i)
+			if i == 1 && len(elemCols) != 0 {
+				elemType := ""
+				if isStruct && opts.Span != tableaupb.Span_SPAN_INNER_CELL {
+					elemType = b.messageType(fd.Message())
+				}
+				elemCols[0].wrap("["+elemType+"]", opts.Prop)
+			}
+			cols = append(cols, elemCols...)
+		}
+		return cols
+	}
+}
+
+func (b *sheetBuilder) parseStructField(fd protoreflect.FieldDescriptor, opts *tableaupb.FieldOptions, prefix string) []*column {
+	name := prefix + opts.Name
+	md := fd.Message()
+	if types.IsWellKnownMessage(md.FullName()) || opts.Span == tableaupb.Span_SPAN_INNER_CELL {
+		return []*column{{
+			name: name,
+			typ:  b.incellType(fd),
+			prop: opts.Prop,
+			note: opts.Note,
+		}}
+	}
+	// cross-cell struct
+	cols := b.parseMessage(md, name)
+	if len(cols) != 0 {
+		structType := b.messageType(md)
+		if !b.isPredefined(md) && opts.Name != string(md.Name()) {
+			structType += "(" + opts.Name + ")"
+		}
+		cols[0].wrap("{"+structType+"}", opts.Prop)
+	}
+	return cols
+}
+
+// prefixNote prefixes the notes of the horizontal element columns with the
+// list (or map) note and element index (e.g.: "Item1ID"), which is the same
+// rule as the names. So protogen can extract the list (or map) note back.
+func prefixNote(cols []*column, note string, index int) {
+	if note == "" {
+		return
+	}
+	for _, col := range cols {
+		col.note = note + strconv.Itoa(index) + col.note
+	}
+}
+
+// parseUnionMessage generates union columns: type column and value columns.
+// The count of value columns is the max field count of all union members.
+func (b *sheetBuilder) parseUnionMessage(md protoreflect.MessageDescriptor, prefix string) []*column {
+	unionDesc := xproto.ExtractUnionDescriptor(md)
+	if unionDesc == nil {
+		return nil
+	}
+	typeOpts := b.parseFieldOptions(unionDesc.Type)
+	cols := []*column{{
+		name: prefix + strcase.FromContext(b.ctx).ToCamel(unionDesc.TypeName()),
+		typ:  b.scalarType(unionDesc.Type),
+		note: typeOpts.Note,
+	}}
+	fieldCount := 0
+	for i := 0; i < unionDesc.Value.Fields().Len(); i++ {
+		fd := unionDesc.Value.Fields().Get(i)
+		if fd.Kind() == protoreflect.MessageKind {
+			fieldCount = max(fieldCount, fd.Message().Fields().Len())
+		} else {
+			fieldCount = max(fieldCount, 1)
+		}
+	}
+	for i := 1; i <= fieldCount; i++ {
+		cols = append(cols, &column{
+			name: prefix + unionDesc.ValueFieldName() + strconv.Itoa(i),
+			typ:  "union",
+		})
+	}
+	return cols
+}
+
+// parseFieldOptions parses the field options, and fills default values if
+// not set.
+func (b *sheetBuilder) parseFieldOptions(fd protoreflect.FieldDescriptor) *tableaupb.FieldOptions {
+	fieldOpts := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+	var opts *tableaupb.FieldOptions
+	if fieldOpts != nil {
+		opts = proto.Clone(fieldOpts).(*tableaupb.FieldOptions)
+	} else {
+		// default processing, same as confgen
+		opts = &tableaupb.FieldOptions{
+			Name: strcase.FromContext(b.ctx).ToCamel(string(fd.Name())),
+		}
+		if fd.IsList() {
+			opts.Name = strings.TrimSuffix(opts.Name, types.DefaultListFieldOptNameSuffix)
+		} else if fd.IsMap() {
+			opts.Name = strings.TrimSuffix(opts.Name, types.DefaultMapFieldOptNameSuffix)
+			opts.Key = types.DefaultMapKeyOptName
+		}
+	}
+	if fd.Options().(*descriptorpb.FieldOptions).GetDeprecated() {
+		// protogen generates the deprecated prop as protobuf built-in option
+		if opts.Prop == nil {
+			opts.Prop = &tableaupb.FieldProp{}
+		}
+		opts.Prop.Deprecated = true
+	}
+	if !b.fieldPresence && xproto.HasExplicitPresence(fd) {
+		// protogen generates the field presence prop as label "optional" or
+		// feature "field_presence = EXPLICIT"
+		if opts.Prop == nil {
+			opts.Prop = &tableaupb.FieldProp{}
+		}
+		opts.Prop.FieldPresence = true
+	}
+	if opts.Note == "" {
+		// protogen generates the note as field's trailing comment
+		loc := fd.ParentFile().SourceLocations().ByDescriptor(fd)
+		opts.Note = strings.TrimSpace(loc.TrailingComments)
+	}
+	return opts
+}
+
+// scalarType returns the type of scalar, enum, or well-known field.
+func (b *sheetBuilder) scalarType(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		return "enum<" + b.typeRef(fd.Enum()) + ">"
+	case protoreflect.MessageKind:
+		return b.incellType(fd)
+	default:
+		return fd.Kind().String()
+	}
+}
+
+// incellType returns the type which can be parsed in one cell. For list
+// field, it returns the element type.
+func (b *sheetBuilder) incellType(fd protoreflect.FieldDescriptor) string {
+	if fd.Kind() != protoreflect.MessageKind {
+		return b.scalarType(fd)
+	}
+	md := fd.Message()
+	if alias, ok := wellKnownAliases[md.FullName()]; ok {
+		return alias
+	}
+	if b.isPredefined(md) {
+		return "{" + b.typeRef(md) + "}"
+	}
+	// incell struct: {int32 ID, string Name}Item
+	var pairs []string
+	for i := 0; i < md.Fields().Len(); i++ {
+		subFd := md.Fields().Get(i)
+		pairs = append(pairs, b.scalarType(subFd)+" "+b.parseFieldOptions(subFd).Name)
+	}
+	return "{" + strings.Join(pairs, ", ") + "}" + string(md.Name())
+}
+
+// messageType returns the message type name used in composite types.
+func (b *sheetBuilder) messageType(md protoreflect.MessageDescriptor) string {
+	if b.isPredefined(md) {
+		return b.typeRef(md)
+	}
+	return string(md.Name())
+}
+
+// isPredefined reports whether the type is predefined, which means it
+// is not defined nested in this worksheet message.
+func (b *sheetBuilder) isPredefined(desc protoreflect.Descriptor) bool {
+	return !strings.HasPrefix(string(desc.FullName()), string(b.md.FullName())+".")
+}
+
+// typeRef returns the predefined type reference, e.g.: ".Item" for type in
+// the same proto package, or "base.Item" for type in other proto package.
+func (b *sheetBuilder) typeRef(desc protoreflect.Descriptor) string {
+	return b.fullNameRef(string(desc.FullName()))
+}
+
+// fullNameRef returns the type reference by full name, same as typeRef.
+func (b *sheetBuilder) fullNameRef(fullName string) string {
+	if trimmed, ok := strings.CutPrefix(fullName, b.protoPackage+"."); ok {
+		return "." + trimmed
+	}
+	return fullName
+}
+
+// horizontalSize returns the count of repeated columns of horizontal list or map.
+func horizontalSize(prop *tableaupb.FieldProp) int {
+	if prop.GetSize() > 0 {
+		return int(prop.GetSize())
+	}
+	return DefaultHorizontalSize
+}
+
+// marshalProp marshals field property to compact text.
+func marshalProp(prop *tableaupb.FieldProp) string {
+	if prop == nil || proto.Size(prop) == 0 {
+		return ""
+	}
+	bin, err := prototext.Marshal(prop)
+	if err != nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(string(bin)), " ")
+}
@@ -0,0 +1,299 @@
+// Package buildcache implements a content-hash based build cache for
+// incremental builds.
+//
+// Each generation unit (e.g. a workbook) is recorded as an entry with the
+// hash of all its inputs (workbook contents, proto descriptors, dependent
+// workbooks, and options), and the hashes of its output files. The output
+// files are also stored in the cache as content-addressed objects, so that
+// they can be restored if they are missing or modified in the next run.
+//
+// Layout of the cache dir:
+//
+//	<dir>/<name>/manifest.json
+//	<dir>/<name>/objects/<hash[:2]>/<hash>
+package buildcache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
+)
+
+const (
+	manifestFilename = "manifest.json"
+	objectsDirname   = "objects"
+)
+
+// Entry records the input hash, dependencies and output hashes of a
+// generation unit.
+type Entry struct {
+	Input   string            `json:"input"`          // hash of all inputs
+	Deps    []string          `json:"deps,omitempty"` // dependencies resolved at last generation
+	Outputs map[string]string `json:"outputs"`        // output file path -> content hash
+}
+
+type manifest struct {
+	Entries map[string]*Entry `json:"entries"` // unit key -> entry
+}
+
+// Cache is a build cache of one generator, which is safe for concurrent
+// use. A nil *Cache is valid and means the cache is disabled: no entry
+// can be found, and nothing will be recorded.
+type Cache struct {
+	dir string // cache dir of this generator: <dir>/<name>
+
+	mu      sync.Mutex
+	entries map[string]*Entry
+	hits    int
+}
+
+// Open opens the build cache of the named generator (e.g.: "protogen")
+// in dir, and an empty dir means the default cache dir. An empty or broken
+// manifest is treated as an empty cache.
+func Open(dir, name string) (*Cache, error) {
+	if dir == "" {
+		dir = options.DefaultCacheDir
+	}
+	c := &Cache{
+		dir:     filepath.Join(dir, name),
+		entries: map[string]*Entry{},
+	}
+	data, err := os.ReadFile(filepath.Join(c.dir, manifestFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, xerrors.Wrapf(err, "failed to read build cache manifest")
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		log.Warnf("ignore broken build cache manifest in %s: %s", c.dir, err)
+		return c, nil
+	}
+	if m.Entries != nil {
+		c.entries = m.Entries
+	}
+	return c, nil
+}
+
+// Get returns the entry of the unit key, or nil if not found.
+func (c *Cache) Get(key string) *Entry {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[key]
+}
+
+// Hit reports whether the unit is up to date, which means the entry's input
+// hash equals to the given input hash, and all its output files are present
+// with the recorded content (restoring them from the cache if needed).
+// It returns false if any output cannot be restored, so the caller should
+// just regenerate the unit.
+func (c *Cache) Hit(key, input string) bool {
+	entry := c.Get(key)
+	if entry == nil || entry.Input != input {
+		return false
+	}
+	for path, hash := range entry.Outputs {
+		if err := c.restore(path, hash); err != nil {
+			log.Debugf("build cache: failed to restore %s: %s", path, err)
+			return false
+		}
+	}
+	c.mu.Lock()
+	c.hits++
+	c.mu.Unlock()
+	return true
+}
+
+// Hits returns the count of up-to-date units found by Hit.
+func (c *Cache) Hits() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits
+}
+
+// Put records the unit with its input hash, dependencies and output files,
+// and stores the output files into the cache.
+func (c *Cache) Put(key, input string, deps []string, outputs []string) error {
+	if c == nil {
+		return nil
+	}
+	entry := &Entry{
+		Input:   input,
+		Deps:    deps,
+		Outputs: make(map[string]string, len(outputs)),
+	}
+	for _, path := range outputs {
+		hash, err := c.store(path)
+		if err != nil {
+			return err
+		}
+		entry.Outputs[path] = hash
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = entry
+	return nil
+}
+
+// Delete removes the entry of the unit key.
+func (c *Cache) Delete(key string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// Save writes the manifest, and removes the stored objects which are not
+// referenced by any entry.
+func (c *Cache) Save() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, err := json.MarshalIndent(&manifest{Entries: c.entries}, "", "  ")
+	if err != nil {
+		return xerrors.Wrap(err)
+	}
+	if err := os.MkdirAll(c.dir, xfs.DefaultDirPerm); err != nil {
+		return xerrors.Wrapf(err, "failed to create build cache dir: %s", c.dir)
+	}
+	if err := writeFileAtomic(filepath.Join(c.dir, manifestFilename), data); err != nil {
+		return err
+	}
+	referenced := map[string]bool{}
+	for _, entry := range c.entries {
+		for _, hash := range entry.Outputs {
+			referenced[hash] = true
+		}
+	}
+	objects, err := filepath.Glob(filepath.Join(c.dir, objectsDirname, "*", "*"))
+	if err != nil {
+		return xerrors.Wrap(err)
+	}
+	for _, object := range objects {
+		if !referenced[filepath.Base(object)] {
+			if err := os.Remove(object); err != nil {
+				return xerrors.Wrap(err)
+			}
+		}
+	}
+	log.Debugf("build cache: saved %d entries with %d hits in %s", len(c.entries), c.hits, c.dir)
+	return nil
+}
+
+func (c *Cache) objectPath(hash string) string {
+	return filepath.Join(c.dir, objectsDirname, hash[:2], hash)
+}
+
+// store stores the file into the cache, and returns its content hash.
+func (c *Cache) store(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", xerrors.Wrapf(err, "failed to read output file: %s", path)
+	}
+	hash := HashBytes(data)
+	objPath := c.objectPath(hash)
+	if existed, err := xfs.Exists(objPath); err != nil {
+		return "", xerrors.Wrap(err)
+	} else if existed {
+		return hash, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(objPath), xfs.DefaultDirPerm); err != nil {
+		return "", xerrors.Wrapf(err, "failed to create build cache dir: %s", filepath.Dir(objPath))
+	}
+	if err := writeFileAtomic(objPath, data); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+// restore restores the file from the cache if it is missing or modified.
+func (c *Cache) restore(path, hash string) error {
+	if data, err := os.ReadFile(path); err == nil && HashBytes(data) == hash {
+		return nil // up to date
+	}
+	data, err := os.ReadFile(c.objectPath(hash))
+	if err != nil {
+		return xerrors.Wrap(err)
+	}
+	if HashBytes(data) != hash {
+		return xerrors.Newf("corrupted object: %s", hash)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), xfs.DefaultDirPerm); err != nil {
+		return xerrors.Wrap(err)
+	}
+	if err := os.WriteFile(path, data, xfs.DefaultFilePerm); err != nil {
+		return xerrors.Wrap(err)
+	}
+	log.Debugf("build cache: restored %s", path)
+	return nil
+}
+
+// writeFileAtomic writes data to a temp file and then renames it to path,
+// so concurrent readers never see a partially written file.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return xerrors.Wrap(err)
+	}
+	tmpPath := f.Name()
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, xfs.DefaultFilePerm)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return xerrors.Wrapf(err, "failed to write file: %s", path)
+	}
+	return nil
+}
+
+// Outputs collects the output files of a generation unit, which is safe for
+// concurrent use.
+type Outputs struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+// Add adds output files. It is a no-op on a nil *Outputs.
+func (o *Outputs) Add(paths ...string) {
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.paths = append(o.paths, paths...)
+}
+
+// Paths returns all added output files.
+func (o *Outputs) Paths() []string {
+	if o == nil {
+		return nil
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return slices.Clone(o.paths)
+}
@@ -0,0 +1,88 @@
+package buildcache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	cacheDir := t.TempDir()
+	outdir := t.TempDir()
+	output := filepath.Join(outdir, "ItemConf.json")
+	require.NoError(t, os.WriteFile(output, []byte(`{"id":1}`), 0644))
+
+	cache, err := Open(cacheDir, "confgen")
+	require.NoError(t, err)
+	assert.False(t, cache.Hit("item.proto", "v1"))
+	require.NoError(t, cache.Put("item.proto", "v1", []string{"common.proto"}, []string{output}))
+	require.NoError(t, cache.Save())
+
+	cache, err = Open(cacheDir, "confgen")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"common.proto"}, cache.Get("item.proto").Deps)
+	assert.False(t, cache.Hit("item.proto", "v2"), "input changed")
+	assert.False(t, cache.Hit("hero.proto", "v1"), "unknown key")
+	assert.True(t, cache.Hit("item.proto", "v1"))
+
+	// modified output is restored
+	require.NoError(t, os.WriteFile(output, []byte(`{"id":2}`), 0644))
+	assert.True(t, cache.Hit("item.proto", "v1"))
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(data))
+	assert.Equal(t, 2, cache.Hits())
+
+	// unreferenced objects are removed
+	cache.Delete("item.proto")
+	require.NoError(t, cache.Save())
+	objects, err := filepath.Glob(filepath.Join(cacheDir, "confgen", objectsDirname, "*", "*"))
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+	assert.False(t, cache.Hit("item.proto", "v1"))
+}
+
+func TestCache_Nil(t *testing.T) {
+	var cache *Cache
+	assert.Nil(t, cache.Get("item.proto"))
+	assert.False(t, cache.Hit("item.proto", "v1"))
+	assert.NoError(t, cache.Put("item.proto", "v1", nil, nil))
+	assert.NoError(t, cache.Save())
+	assert.Equal(t, 0, cache.Hits())
+}
+
+func TestWorkbookFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"Item#ItemConf.csv", "Item#@TABLEAU.csv", "Hero#HeroConf.csv"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0644))
+	}
+	want := []string{filepath.Join(dir, "Item#@TABLEAU.csv"), filepath.Join(dir, "Item#ItemConf.csv")}
+
+	got, err := WorkbookFiles(filepath.Join(dir, "Item#*.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = WorkbookFiles(filepath.Join(dir, "Item#ItemConf.csv"))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = WorkbookFiles(filepath.Join(dir, "Item.xlsx"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "Item.xlsx")}, got)
+
+	_, err = WorkbookFiles(filepath.Join(dir, "Skill#*.csv"))
+	assert.Error(t, err)
+}
+
+func TestHasher(t *testing.T) {
+	sum := func(ss ...string) string {
+		h := NewHasher()
+		h.WriteString(ss...)
+		return h.Sum()
+	}
+	assert.Equal(t, sum("a", "b"), sum("a", "b"))
+	assert.NotEqual(t, sum("ab"), sum("a", "b"), "length-prefixed")
+}
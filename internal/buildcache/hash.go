@@ -0,0 +1,125 @@
+package buildcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HashBytes returns the hex encoded SHA-256 hash of data.
+func HashBytes(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Hasher accumulates all inputs of a generation unit into one hash.
+type Hasher struct {
+	h hash.Hash
+}
+
+// NewHasher creates a new hasher.
+func NewHasher() *Hasher {
+	return &Hasher{h: sha256.New()}
+}
+
+// WriteString writes strings, each one is length-prefixed to avoid
+// ambiguity between different splits of the same bytes.
+func (x *Hasher) WriteString(ss ...string) {
+	for _, s := range ss {
+		x.writeBytes([]byte(s))
+	}
+}
+
+// WriteJSON writes the JSON encoding of v, which is deterministic as map
+// keys are sorted.
+func (x *Hasher) WriteJSON(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return xerrors.Wrap(err)
+	}
+	x.writeBytes(data)
+	return nil
+}
+
+// WriteFiles writes both paths and contents of files.
+func (x *Hasher) WriteFiles(paths ...string) error {
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to read file: %s", path)
+		}
+		x.WriteString(xfs.CleanSlashPath(path))
+		x.writeBytes(data)
+	}
+	return nil
+}
+
+// WriteWorkbook writes all files which compose the workbook.
+func (x *Hasher) WriteWorkbook(path string) error {
+	paths, err := WorkbookFiles(path)
+	if err != nil {
+		return err
+	}
+	return x.WriteFiles(paths...)
+}
+
+// WriteFileDescriptor writes the file descriptor in deterministic binary
+// encoding.
+func (x *Hasher) WriteFileDescriptor(fd protoreflect.FileDescriptor) error {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(protodesc.ToFileDescriptorProto(fd))
+	if err != nil {
+		return xerrors.Wrap(err)
+	}
+	x.WriteString(fd.Path())
+	x.writeBytes(data)
+	return nil
+}
+
+// Sum returns the hex encoded hash of all written inputs.
+func (x *Hasher) Sum() string {
+	return hex.EncodeToString(x.h.Sum(nil))
+}
+
+func (x *Hasher) writeBytes(data []byte) {
+	var size [8]byte
+	n := uint64(len(data))
+	for i := range size {
+		size[i] = byte(n >> (8 * i))
+	}
+	x.h.Write(size[:])
+	x.h.Write(data)
+}
+
+// WorkbookFiles returns all files which compose the workbook, in sorted
+// order. A CSV workbook is composed of multiple files named by pattern
+// "<BookName>#<SheetName>.csv", and path can be either the book name pattern
+// "<BookName>#*.csv" or one of its sheet files.
+func WorkbookFiles(path string) ([]string, error) {
+	if format.GetFormat(path) != format.CSV {
+		return []string{path}, nil
+	}
+	pattern, err := xfs.ParseCSVBooknamePatternFrom(path)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to glob pattern: %s", pattern)
+	}
+	if len(matches) == 0 {
+		return nil, xerrors.Newf("no workbook files found by pattern: %s", pattern)
+	}
+	slices.Sort(matches)
+	return matches, nil
+}
@@ -0,0 +1,252 @@
+package confgen
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/buildcache"
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
+	"github.com/tableauio/tableau/internal/importer"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// cacheName is the name of confgen's build cache in the cache dir.
+const cacheName = "confgen"
+
+// cacheSalt returns the hash of generator settings, which is mixed into the
+// input hash of each workbook, so that any setting change invalidates all
+// cached workbooks.
+func cacheSalt(protoPackage, indir, outdir string, opts *options.Options) (string, error) {
+	h := buildcache.NewHasher()
+	h.WriteString(cacheName, Version, protoPackage, indir, outdir, opts.LocationName)
+	var metasheetName string
+	if opts.Proto != nil && opts.Proto.Input != nil {
+		metasheetName = opts.Proto.Input.MetasheetName
+	}
+	conf := opts.Conf
+	if conf != nil && conf.Output != nil {
+		// report settings do not affect generated conf files.
+		output := *conf.Output
+		output.JUnitReport = ""
+		output.ReferenceReport = ""
+		output.ReferenceAllowlist = nil
+		conf = &options.ConfOption{Input: conf.Input, Output: &output}
+	}
+	if err := h.WriteJSON([]any{metasheetName, opts.Acronyms, conf}); err != nil {
+		return "", xerrors.Wrapf(err, "failed to hash generator settings")
+	}
+	return h.Sum(), nil
+}
+
+// openCache opens the build cache if enabled.
+func (gen *Generator) openCache() error {
+	if gen.CacheOpt == nil || !gen.CacheOpt.Enable {
+		return nil
+	}
+	if gen.cacheSaltErr != nil {
+		log.Warnf("%15s: disabled as %v", "build cache", gen.cacheSaltErr)
+		return nil
+	}
+	if gen.OutputOpt != nil && gen.OutputOpt.ReferenceReport != "" {
+		log.Infof("%15s: disabled as reference report needs refer checks of all books", "build cache")
+		return nil
+	}
+	if gen.localizationEnabled() {
+		log.Infof("%15s: disabled as string tables need texts of all books", "build cache")
+		return nil
+	}
+	cache, err := buildcache.Open(gen.CacheOpt.Dir, cacheName)
+	if err != nil {
+		return err
+	}
+	gen.cache = cache
+	return nil
+}
+
+// saveCache saves the build cache if enabled.
+func (gen *Generator) saveCache() error {
+	if gen.cache == nil {
+		return nil
+	}
+	if hits := gen.cache.Hits(); hits > 0 {
+		log.Infof("%15s: %d unchanged book(s) skipped", "build cache", hits)
+	}
+	return gen.cache.Save()
+}
+
+// requireUniqueDomains reports whether any worksheet has fields with prop
+// "unique_domain".
+func requireUniqueDomains(sheets []*SheetInfo) bool {
+	for _, sheetInfo := range sheets {
+		if fieldprop.RequireUniqueDomains(sheetInfo.MD) {
+			return true
+		}
+	}
+	return false
+}
+
+// bookCacheInput returns the input hash of a workbook, which consists of:
+//   - generator settings
+//   - descriptors of the proto file and all its imported proto files (e.g.:
+//     the generated proto files of imported struct/enum type sheets)
+//   - contents of all workbook files
+//   - contents of dependent workbooks: scatter and merger workbooks of each
+//     sheet, and workbooks of refer targets (with their merger workbooks).
+func (gen *Generator) bookCacheInput(prFiles *protoregistry.Files, fd protoreflect.FileDescriptor, absWbPath string, sheets []*SheetInfo) (string, error) {
+	h := buildcache.NewHasher()
+	h.WriteString(gen.cacheSalt)
+	if err := writeFileDescriptors(h, fd, map[string]bool{}); err != nil {
+		return "", err
+	}
+	if err := h.WriteWorkbook(absWbPath); err != nil {
+		return "", err
+	}
+	for _, sheetInfo := range sheets {
+		specifiers := append(slices.Clone(sheetInfo.SheetOpts.GetScatter()), sheetInfo.SheetOpts.GetMerger()...)
+		if err := gen.writeSpecifiedWorkbooks(h, sheetInfo.BookName(), specifiers); err != nil {
+			return "", err
+		}
+	}
+	for _, target := range collectReferTargets(gen.ProtoPackage, prFiles, sheets) {
+		bookName := xfs.RewriteSubdir(target.bookName, gen.InputOpt.SubdirRewrites)
+		h.WriteString(target.bookName, target.sheetName)
+		if err := h.WriteWorkbook(filepath.Join(gen.InputDir, bookName)); err != nil {
+			return "", err
+		}
+		if err := gen.writeSpecifiedWorkbooks(h, bookName, target.merger); err != nil {
+			return "", err
+		}
+	}
+	return h.Sum(), nil
+}
+
+// writeSpecifiedWorkbooks writes all workbooks resolved from the sheet
+// specifiers of scatter or merger.
+func (gen *Generator) writeSpecifiedWorkbooks(h *buildcache.Hasher, primaryBookName string, specifiers []string) error {
+	for _, specifier := range specifiers {
+		relBookPaths, _, err := importer.ResolveSheetSpecifier(gen.InputDir, primaryBookName, specifier, gen.InputOpt.SubdirRewrites)
+		if err != nil {
+			return err
+		}
+		paths := make([]string, 0, len(relBookPaths))
+		for relBookPath := range relBookPaths {
+			paths = append(paths, relBookPath)
+		}
+		slices.Sort(paths)
+		h.WriteString(specifier)
+		for _, path := range paths {
+			if err := h.WriteWorkbook(filepath.Join(gen.InputDir, path)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeFileDescriptors writes the file descriptor and all its imports
+// recursively.
+func writeFileDescriptors(h *buildcache.Hasher, fd protoreflect.FileDescriptor, visited map[string]bool) error {
+	if visited[fd.Path()] {
+		return nil
+	}
+	visited[fd.Path()] = true
+	if err := h.WriteFileDescriptor(fd); err != nil {
+		return err
+	}
+	imports := fd.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if err := writeFileDescriptors(h, imports.Get(i).FileDescriptor, visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type referTarget struct {
+	bookName  string
+	sheetName string
+	merger    []string
+}
+
+// collectReferTargets collects the referred worksheets of all fields
+// (including nested fields) in sheets, sorted by book name and sheet name.
+func collectReferTargets(protoPackage string, prFiles *protoregistry.Files, sheets []*SheetInfo) []*referTarget {
+	targets := map[string]*referTarget{}
+	visited := map[protoreflect.FullName]bool{}
+	var walk func(md protoreflect.MessageDescriptor)
+	walk = func(md protoreflect.MessageDescriptor) {
+		if visited[md.FullName()] {
+			return
+		}
+		visited[md.FullName()] = true
+		fds := md.Fields()
+		for i := 0; i < fds.Len(); i++ {
+			fd := fds.Get(i)
+			opts, _ := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+			for _, refer := range fieldprop.SplitRefers(opts.GetProp().GetRefer()) {
+				if target := resolveReferTarget(protoPackage, prFiles, refer); target != nil {
+					targets[target.bookName+"#"+target.sheetName] = target
+				}
+			}
+			if fd.IsMap() {
+				fd = fd.MapValue()
+			}
+			if fd.Message() != nil {
+				walk(fd.Message())
+			}
+		}
+	}
+	for _, sheetInfo := range sheets {
+		walk(sheetInfo.MD)
+	}
+	keys := make([]string, 0, len(targets))
+	for key := range targets {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	var result []*referTarget
+	for _, key := range keys {
+		result = append(result, targets[key])
+	}
+	return result
+}
+
+// resolveReferTarget resolves the referred worksheet, and returns nil if
+// not found, which will be reported when parsing the field.
+func resolveReferTarget(protoPackage string, prFiles *protoregistry.Files, refer string) *referTarget {
+	if strings.TrimSpace(refer) == "" {
+		return nil
+	}
+	desc, err := fieldprop.ParseRefer(refer)
+	if err != nil {
+		return nil
+	}
+	d, err := prFiles.FindDescriptorByName(protoreflect.FullName(protoPackage + "." + desc.GetMessageName()))
+	if err != nil {
+		return nil
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil
+	}
+	fileOpts := md.ParentFile().Options().(*descriptorpb.FileOptions)
+	bookOpts := proto.GetExtension(fileOpts, tableaupb.E_Workbook).(*tableaupb.WorkbookOptions)
+	sheetOpts := proto.GetExtension(md.Options(), tableaupb.E_Worksheet).(*tableaupb.WorksheetOptions)
+	if bookOpts == nil || sheetOpts == nil {
+		return nil
+	}
+	return &referTarget{
+		bookName:  bookOpts.Name,
+		sheetName: sheetOpts.Name,
+		merger:    sheetOpts.Merger,
+	}
+}
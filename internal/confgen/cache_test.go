@@ -0,0 +1,103 @@
+package confgen
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/options"
+	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func newCacheTestGenerator(indir, outdir, cacheDir string) *Generator {
+	const protoDir = "./testdata/cache/proto"
+	return NewGenerator("cachetest", indir, outdir,
+		options.Conf(
+			&options.ConfOption{
+				Input: &options.ConfInputOption{
+					ProtoPaths: []string{protoDir},
+					ProtoFiles: []string{protoDir + "/*.proto"},
+					Formats:    []format.Format{format.CSV},
+				},
+				Output: &options.ConfOutputOption{
+					Formats: []format.Format{format.JSON},
+				},
+			},
+		),
+		options.Cache(&options.CacheOption{Enable: true, Dir: cacheDir}),
+	)
+}
+
+func TestGenerator_Cache(t *testing.T) {
+	indir := t.TempDir()
+	outdir := t.TempDir()
+	cacheDir := t.TempDir()
+	require.NoError(t, os.CopyFS(indir, os.DirFS("./testdata/cache/csv")))
+
+	generate := func() int {
+		gen := newCacheTestGenerator(indir, outdir, cacheDir)
+		require.NoError(t, gen.Generate())
+		return gen.cache.Hits()
+	}
+
+	assert.Equal(t, 0, generate(), "first build")
+	assert.Equal(t, 3, generate(), "all workbooks are unchanged")
+
+	// ItemConf merges ItemShard*.csv, and HeroConf refers to ItemConf.ID,
+	// so both are invalidated by a change of the shard workbook.
+	shardPath := filepath.Join(indir, "ItemShard1#ItemConf.csv")
+	f, err := os.OpenFile(shardPath, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString("4,40\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Equal(t, 1, generate(), "merger and refer dependents are rebuilt")
+	data, err := os.ReadFile(filepath.Join(outdir, "ItemConf.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"num":40`)
+
+	// missing output of an unchanged workbook is restored from cache
+	skillPath := filepath.Join(outdir, "SkillConf.json")
+	expected, err := os.ReadFile(skillPath)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(skillPath))
+	assert.Equal(t, 3, generate(), "all workbooks are unchanged")
+	actual, err := os.ReadFile(skillPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+
+	// changed settings invalidate all workbooks
+	gen := newCacheTestGenerator(indir, outdir, cacheDir)
+	gen.cacheSalt = "changed"
+	require.NoError(t, gen.Generate())
+	assert.Equal(t, 0, gen.cache.Hits())
+
+	// failure of hashing settings skips build cache
+	gen = newCacheTestGenerator(indir, outdir, cacheDir)
+	gen.cacheSaltErr = errors.New("unsupported value")
+	require.NoError(t, gen.Generate())
+	assert.Nil(t, gen.cache)
+}
+
+func Test_requireUniqueDomains(t *testing.T) {
+	tests := []struct {
+		name string
+		md   protoreflect.MessageDescriptor
+		want bool
+	}{
+		{name: "no-unique-domain", md: (&unittestpb.ComputeConf{}).ProtoReflect().Descriptor(), want: false},
+		{name: "map-unique-domain", md: (&unittestpb.UniqueDomainMapConf{}).ProtoReflect().Descriptor(), want: true},
+		{name: "nested-unique-domain", md: (&unittestpb.UniqueDomainListConf{}).ProtoReflect().Descriptor(), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sheets := []*SheetInfo{{MD: tt.md}}
+			assert.Equal(t, tt.want, requireUniqueDomains(sheets))
+		})
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"path/filepath"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/buildcache"
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
 	"github.com/tableauio/tableau/internal/importer"
 	"github.com/tableauio/tableau/internal/importer/metasheet"
 	"github.com/tableauio/tableau/internal/strcase"
@@ -42,9 +45,22 @@ type Generator struct {
 	LocationName string                    // TZ location name.
 	InputOpt     *options.ConfInputOption  // Input settings.
 	OutputOpt    *options.ConfOutputOption // output settings.
+	CacheOpt     *options.CacheOption      // build cache settings.
 
-	validator protovalidate.Validator // validator with extension type resolver for custom predefined rules.
-	collector *xerrors.Collector      // concurrent error collector shared across the generator.
+	validator    protovalidate.Validator // validator with extension type resolver for custom predefined rules.
+	collector    *xerrors.Collector      // concurrent error collector shared across the generator.
+	cache        *buildcache.Cache       // build cache, nil if not enabled.
+	cacheSalt    string                  // hash of generator settings for build cache.
+	cacheSaltErr error                   // error of hashing generator settings, which disables build cache.
+
+	// values of uniqueness domains (field prop "unique_domain") across sheets in one generation.
+	uniqueDomains *fieldprop.UniqueDomains
+	// localized texts (field prop "text") across sheets in one generation,
+	// nil if localization is not enabled.
+	texts *fieldprop.TextTable
+
+	reportMu     sync.Mutex
+	reportSheets []*reportSheet // converted worksheets for the JUnit report.
 
 	// Performance stats
 	PerfStats sync.Map
@@ -72,18 +88,42 @@ func NewGeneratorWithOptions(protoPackage, indir, outdir string, opts *options.O
 		LocationName: opts.LocationName,
 		InputOpt:     opts.Conf.Input,
 		OutputOpt:    opts.Conf.Output,
+		CacheOpt:     opts.Cache,
 		ctx:          ctx,
 		collector:    xerrors.NewCollector(maxErrors),
 		PerfStats:    sync.Map{},
 	}
+	g.cacheSalt, g.cacheSaltErr = cacheSalt(protoPackage, indir, outdir, opts)
 	return g
 }
 
 // bookSpecifier can be:
 //   - only workbook: excel/Item.xlsx
 //   - specific worksheet: excel/Item.xlsx#Item (To be implemented)
+//
+// If build cache is enabled, unchanged workbooks are skipped, and their
+// conf files are restored from the cache if needed.
+//
+// If the JUnit report path is specified, the validation results are written
+// to it after generation.
+//
+// If the reference report path is specified, the reverse reference report is
+// written to it after all workbooks are generated successfully.
 func (gen *Generator) Generate(bookSpecifiers ...string) (err error) {
 	defer PrintPerfStats(gen)
+	defer func() {
+		if reportErr := gen.writeJUnitReport(err); err == nil {
+			err = reportErr
+		}
+	}()
+	if err := gen.openCache(); err != nil {
+		return err
+	}
+	defer func() {
+		if cacheErr := gen.saveCache(); err == nil {
+			err = cacheErr
+		}
+	}()
 
 	if len(bookSpecifiers) == 0 {
 		return gen.GenAll()
@@ -92,7 +132,7 @@ func (gen *Generator) Generate(bookSpecifiers ...string) (err error) {
 }
 
 func (gen *Generator) GenAll() error {
-	prFiles, err := loadProtoRegistryFiles(gen.ProtoPackage, gen.InputOpt.ProtoPaths, gen.InputOpt.ProtoFiles, gen.InputOpt.ExcludedProtoFiles...)
+	prFiles, err := LoadProtoRegistryFiles(gen.ProtoPackage, gen.InputOpt.ProtoPaths, gen.InputOpt.ProtoFiles, gen.InputOpt.ExcludedProtoFiles...)
 	if err != nil {
 		return err
 	}
@@ -101,7 +141,15 @@ func (gen *Generator) GenAll() error {
 	if err != nil {
 		return err
 	}
+	gen.uniqueDomains = fieldprop.NewUniqueDomains()
+	if err := gen.newTextTable(); err != nil {
+		return err
+	}
 	log.Debugf("count of proto files with package name '%s': %v", gen.ProtoPackage, prFiles.NumFilesByPackage(protoreflect.FullName(gen.ProtoPackage)))
+	if gen.referenceReportEnabled() {
+		// record referenced values of this generation only
+		fieldprop.ClearReferredCache()
+	}
 	g := gen.collector.NewGroup(context.Background())
 	prFiles.RangeFilesByPackage(
 		protoreflect.FullName(gen.ProtoPackage),
@@ -111,14 +159,23 @@ func (gen *Generator) GenAll() error {
 			})
 			return true
 		})
-	return g.Wait()
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	if err := gen.checkUniqueDomains(); err != nil {
+		return err
+	}
+	if err := gen.writeTextTables(); err != nil {
+		return err
+	}
+	return gen.writeReferenceReport(prFiles)
 }
 
 // bookSpecifier can be:
 //   - only workbook: excel/Item.xlsx
 //   - with worksheet: excel/Item.xlsx#Item (To be implemented)
 func (gen *Generator) GenWorkbook(bookSpecifiers ...string) error {
-	prFiles, err := loadProtoRegistryFiles(gen.ProtoPackage, gen.InputOpt.ProtoPaths, gen.InputOpt.ProtoFiles, gen.InputOpt.ExcludedProtoFiles...)
+	prFiles, err := LoadProtoRegistryFiles(gen.ProtoPackage, gen.InputOpt.ProtoPaths, gen.InputOpt.ProtoFiles, gen.InputOpt.ExcludedProtoFiles...)
 	if err != nil {
 		return err
 	}
@@ -127,7 +184,16 @@ func (gen *Generator) GenWorkbook(bookSpecifiers ...string) error {
 	if err != nil {
 		return err
 	}
+	gen.uniqueDomains = fieldprop.NewUniqueDomains()
+	// texts are not localized, as string tables need texts of all workbooks
+	gen.texts = nil
 	log.Debugf("count of proto files with package name %v is %v", gen.ProtoPackage, prFiles.NumFilesByPackage(protoreflect.FullName(gen.ProtoPackage)))
+	if gen.referenceReportEnabled() {
+		log.Warnf("reference report skipped as not all workbooks are generated")
+	}
+	if gen.localizationEnabled() {
+		log.Warnf("localization skipped as not all workbooks are generated")
+	}
 	bookIndexes, err := buildWorkbookIndex(gen.ProtoPackage, gen.InputDir, gen.InputOpt.Subdirs, gen.InputOpt.SubdirRewrites, prFiles)
 	if err != nil {
 		return xerrors.WrapKV(err, xerrors.KeyModule, xerrors.ModuleConf)
@@ -155,7 +221,27 @@ func (gen *Generator) GenWorkbook(bookSpecifiers ...string) error {
 			})
 		}
 	}
-	return g.Wait()
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	return gen.checkUniqueDomains()
+}
+
+// checkUniqueDomains reports values duplicated in uniqueness domains, which
+// is done after all sheets are parsed, so that the reported locations are
+// complete and sorted.
+func (gen *Generator) checkUniqueDomains() error {
+	collector := xerrors.NewCollector(maxErrors)
+	for _, dup := range gen.uniqueDomains.Duplicates() {
+		err := xerrors.WrapKV(xerrors.E2030(dup.Domain, dup.Value, strings.Join(dup.Locations, ", ")), xerrors.KeyModule, xerrors.ModuleConf)
+		if err := collector.Collect(err); err != nil {
+			return err
+		}
+	}
+	if collector.HasErrors() {
+		return collector.Join()
+	}
+	return nil
 }
 
 // convert a workbook related to parameter fd, and only convert the
@@ -184,35 +270,29 @@ func (gen *Generator) convert(prFiles *protoregistry.Files, fd protoreflect.File
 	log.Debugf("proto: %s, workbook options: %s", fd.Path(), workbook)
 
 	var sheetNames []string
-	var sheets []*SheetInfo
-	fileOpts := fd.Options().(*descriptorpb.FileOptions)
-	bookOpts := proto.GetExtension(fileOpts, tableaupb.E_Workbook).(*tableaupb.WorkbookOptions)
-	msgs := fd.Messages()
-	for i := 0; i < msgs.Len(); i++ {
-		md := msgs.Get(i)
-		opts := md.Options().(*descriptorpb.MessageOptions)
-		sheetOpts := proto.GetExtension(opts, tableaupb.E_Worksheet).(*tableaupb.WorksheetOptions)
-		if sheetOpts == nil {
-			continue // skip non-sheet
-		}
-		sheets = append(sheets, &SheetInfo{
-			ProtoPackage:    gen.ProtoPackage,
-			LocationName:    gen.LocationName,
-			PrimaryBookName: rewrittenWorkbookName,
-			MD:              md,
-			BookOpts:        bookOpts,
-			SheetOpts:       sheetOpts,
-			ExtInfo: &SheetParserExtInfo{
-				InputDir:       gen.InputDir,
-				SubdirRewrites: gen.InputOpt.SubdirRewrites,
-				PRFiles:        prFiles,
-				BookFormat:     workbookFormat,
-				DryRun:         gen.OutputOpt.DryRun,
-			},
-		})
+	sheets := gen.newSheetInfos(prFiles, fd, rewrittenWorkbookName, workbookFormat)
+	for _, sheetInfo := range sheets {
 		// NOTE: one sheet may be generated to multiple messages (e.g.: full version and lite version) in the same workbook.
-		if !slices.Contains(sheetNames, sheetOpts.Name) {
-			sheetNames = append(sheetNames, sheetOpts.Name)
+		if !slices.Contains(sheetNames, sheetInfo.SheetName()) {
+			sheetNames = append(sheetNames, sheetInfo.SheetName())
+		}
+	}
+
+	// NOTE: build cache is only used when converting the whole workbook, and
+	// workbooks with unique domains are always converted, as the values of
+	// skipped workbooks would not be added to the uniqueness domains.
+	var cacheInput string
+	var outputs *buildcache.Outputs
+	if gen.cache != nil && specifiedSheetName == "" && !requireUniqueDomains(sheets) {
+		cacheInput, err = gen.bookCacheInput(prFiles, fd, absWbPath, sheets)
+		if err != nil {
+			// just convert it, and the error will be reported if it matters
+			log.Debugf("failed to compute build cache input of %s: %s", workbook.Name, err)
+		} else if gen.cache.Hit(fd.Path(), cacheInput) {
+			log.Infof("%15s: %s", "unchanged book", workbook.Name)
+			return nil
+		} else {
+			outputs = &buildcache.Outputs{}
 		}
 	}
 
@@ -234,31 +314,27 @@ func (gen *Generator) convert(prFiles *protoregistry.Files, fd protoreflect.File
 		}
 		// log.Debugf("%s", md.FullName())
 		log.Infof("%15s: %s#%s (%s#%s)", "parsing sheet", fd.Path(), sheetInfo.MD.Name(), workbook.Name, sheetName)
+		gen.addReportSheet(workbook.Name, sheetName, string(sheetInfo.MD.Name()))
 
+		if sheetInfo.HasScatter() && sheetInfo.HasMerger() {
+			return xerrors.NewKV("option Scatter and Merger cannot be both set at one sheet",
+				xerrors.KeyModule, xerrors.ModuleConf, xerrors.KeyBookName, workbook.Name, xerrors.KeySheetName, sheetName)
+		}
+		var sheetErr error
 		if sheetInfo.HasScatter() {
-			if sheetInfo.HasMerger() {
-				return xerrors.NewKV("option Scatter and Merger cannot be both set at one sheet",
-					xerrors.KeyModule, xerrors.ModuleConf, xerrors.KeyBookName, workbook.Name, xerrors.KeySheetName, sheetName)
-			}
-			if err := gen.processScatter(imp, sheetInfo, bookCollector); err != nil {
-				err = xerrors.WrapKV(err, xerrors.KeyModule, xerrors.ModuleConf, xerrors.KeyBookName, workbook.Name, xerrors.KeySheetName, sheetName)
-				if err := bookCollector.Collect(err); err != nil {
-					return err
-				}
-				continue
-			}
+			sheetErr = gen.processScatter(imp, sheetInfo, bookCollector, outputs)
 		} else {
-			if err := gen.processMerger(imp, sheetInfo, bookCollector); err != nil {
-				err = xerrors.WrapKV(err, xerrors.KeyModule, xerrors.ModuleConf, xerrors.KeyBookName, workbook.Name, xerrors.KeySheetName, sheetName)
-				if err := bookCollector.Collect(err); err != nil {
-					return err
-				}
-				continue
-			}
+			sheetErr = gen.processMerger(imp, sheetInfo, bookCollector, outputs)
 		}
-
+		// NOTE: failed sheets are also timed for the JUnit report.
 		seconds := time.Since(sheetBeginTime).Milliseconds() + bookPrepareMilliseconds
 		gen.PerfStats.Store(sheetInfo.MD.Name(), seconds)
+		if sheetErr != nil {
+			err := xerrors.WrapKV(sheetErr, xerrors.KeyModule, xerrors.ModuleConf, xerrors.KeyBookName, workbook.Name, xerrors.KeySheetName, sheetName)
+			if err := bookCollector.Collect(err); err != nil {
+				return err
+			}
+		}
 	}
 	if specifiedSheetName != "" && !worksheetFound {
 		return xerrors.NewKV(fmt.Sprintf("worksheet not found: %s", specifiedSheetName),
@@ -269,29 +345,70 @@ func (gen *Generator) convert(prFiles *protoregistry.Files, fd protoreflect.File
 	if bookCollector.HasErrors() {
 		return bookCollector.Join()
 	}
+	if outputs != nil {
+		if err := gen.cache.Put(fd.Path(), cacheInput, nil, outputs.Paths()); err != nil {
+			return xerrors.WrapKV(err, xerrors.KeyModule, xerrors.ModuleConf, xerrors.KeyBookName, workbook.Name)
+		}
+	}
 	return nil
 }
 
-func (gen *Generator) processScatter(self importer.Importer, sheetInfo *SheetInfo, bookCollector *xerrors.Collector) error {
+// newSheetInfos returns the infos of all worksheets in the workbook proto file.
+func (gen *Generator) newSheetInfos(prFiles *protoregistry.Files, fd protoreflect.FileDescriptor, rewrittenWorkbookName string, workbookFormat format.Format) []*SheetInfo {
+	var sheets []*SheetInfo
+	fileOpts := fd.Options().(*descriptorpb.FileOptions)
+	bookOpts := proto.GetExtension(fileOpts, tableaupb.E_Workbook).(*tableaupb.WorkbookOptions)
+	msgs := fd.Messages()
+	for i := 0; i < msgs.Len(); i++ {
+		md := msgs.Get(i)
+		opts := md.Options().(*descriptorpb.MessageOptions)
+		sheetOpts := proto.GetExtension(opts, tableaupb.E_Worksheet).(*tableaupb.WorksheetOptions)
+		if sheetOpts == nil {
+			continue // skip non-sheet
+		}
+		sheets = append(sheets, &SheetInfo{
+			ProtoPackage:    gen.ProtoPackage,
+			LocationName:    gen.LocationName,
+			PrimaryBookName: rewrittenWorkbookName,
+			MD:              md,
+			BookOpts:        bookOpts,
+			SheetOpts:       sheetOpts,
+			ExtInfo: &SheetParserExtInfo{
+				InputDir:       gen.InputDir,
+				SubdirRewrites: gen.InputOpt.SubdirRewrites,
+				PRFiles:        prFiles,
+				BookFormat:     workbookFormat,
+				DryRun:         gen.OutputOpt.DryRun,
+				UniqueDomains:  gen.uniqueDomains,
+				Texts:          gen.texts,
+			},
+		})
+	}
+	return sheets
+}
+
+func (gen *Generator) processScatter(self importer.Importer, sheetInfo *SheetInfo, bookCollector *xerrors.Collector, outputs *buildcache.Outputs) error {
 	importers, err := importer.GetScatterImporters(gen.ctx, gen.InputDir, sheetInfo.BookName(), sheetInfo.SheetName(), sheetInfo.SheetOpts.Scatter, gen.InputOpt.SubdirRewrites)
 	if err != nil {
 		return err
 	}
 	mainImporter := importer.ImporterInfo{Importer: self}
 	exporter := NewSheetExporter(gen.OutputDir, gen.OutputOpt, gen.validator, bookCollector)
+	exporter.outputs = outputs
 	if err := exporter.ScatterAndExport(sheetInfo, mainImporter, importers...); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (gen *Generator) processMerger(self importer.Importer, sheetInfo *SheetInfo, bookCollector *xerrors.Collector) error {
+func (gen *Generator) processMerger(self importer.Importer, sheetInfo *SheetInfo, bookCollector *xerrors.Collector, outputs *buildcache.Outputs) error {
 	importers, err := importer.GetMergerImporters(gen.ctx, gen.InputDir, sheetInfo.BookName(), sheetInfo.SheetName(), sheetInfo.SheetOpts.Merger, gen.InputOpt.SubdirRewrites)
 	if err != nil {
 		return err
 	}
 	mainImporter := importer.ImporterInfo{Importer: self}
 	exporter := NewSheetExporter(gen.OutputDir, gen.OutputOpt, gen.validator, bookCollector)
+	exporter.outputs = outputs
 	if err := exporter.MergeAndExport(sheetInfo, mainImporter, importers...); err != nil {
 		return err
 	}
@@ -1,14 +1,21 @@
 package confgen
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
+	"github.com/tableauio/tableau/internal/x/xerrors"
 	"github.com/tableauio/tableau/internal/x/xfs"
 	"github.com/tableauio/tableau/log"
 	"github.com/tableauio/tableau/options"
+	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
 )
 
 func prepareOutput() error {
@@ -90,3 +97,97 @@ func TestGenerator_GenWorkbook(t *testing.T) {
 		})
 	}
 }
+
+func readTextTable(t *testing.T, path string) map[string]string {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	table := map[string]string{}
+	require.NoError(t, json.Unmarshal(content, &table))
+	return table
+}
+
+// newItemTextTable returns the text table with localized item names, and the
+// key func of the source text.
+func newItemTextTable(t *testing.T, names ...string) (*fieldprop.TextTable, func(string) string) {
+	fd := (&unittestpb.TextConf_Item{}).ProtoReflect().Descriptor().Fields().ByName("name")
+	table := fieldprop.NewTextTable()
+	for _, name := range names {
+		item := &unittestpb.TextConf_Item{Name: name}
+		require.NoError(t, table.LocalizeMessage(item.ProtoReflect()))
+	}
+	return table, func(source string) string {
+		return fieldprop.TextKey(fd, source)
+	}
+}
+
+func TestGenerator_writeTextTables(t *testing.T) {
+	texts, key := newItemTextTable(t, "金币", "钻石")
+	translated := "Key,Source,Translation\n" +
+		key("金币") + ",金币,Gold\n" +
+		key("钻石") + ",钻石,\n" +
+		key("元宝") + ",元宝,Ingot\n"
+	tests := []struct {
+		name         string
+		localization *options.LocalizationOption
+		errs         []error
+		contains     []string
+	}{
+		{
+			name:         "disabled",
+			localization: nil,
+		},
+		{
+			name:         "lenient",
+			localization: &options.LocalizationOption{SourceLang: "zh", TargetLangs: []string{"en"}, TranslationDir: "i18n"},
+		},
+		{
+			name:         "strict",
+			localization: &options.LocalizationOption{SourceLang: "zh", TargetLangs: []string{"en"}, TranslationDir: "i18n", Strict: true},
+			errs:         []error{xerrors.ErrE2047, xerrors.ErrE2048},
+			contains:     []string{"钻石", "元宝"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indir, outdir := t.TempDir(), t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(indir, "i18n"), 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(indir, "i18n", "Text_en.csv"), []byte(translated), 0644))
+			gen := &Generator{
+				InputDir:  indir,
+				OutputDir: outdir,
+				OutputOpt: &options.ConfOutputOption{Localization: tt.localization},
+				texts:     texts,
+			}
+			err := gen.writeTextTables()
+			if len(tt.errs) != 0 {
+				for _, e := range tt.errs {
+					assert.ErrorIs(t, err, e)
+				}
+				for _, s := range tt.contains {
+					assert.Contains(t, err.Error(), s)
+				}
+				return
+			}
+			require.NoError(t, err)
+			if tt.localization == nil {
+				assert.NoFileExists(t, filepath.Join(outdir, "Text_zh.json"))
+				return
+			}
+			assert.Equal(t, map[string]string{key("金币"): "金币", key("钻石"): "钻石"}, readTextTable(t, filepath.Join(outdir, "Text_zh.json")))
+			assert.Equal(t, map[string]string{key("金币"): "Gold"}, readTextTable(t, filepath.Join(outdir, "Text_en.json")))
+		})
+	}
+}
+
+func TestGenerator_newTextTable(t *testing.T) {
+	gen := &Generator{OutputOpt: &options.ConfOutputOption{}}
+	require.NoError(t, gen.newTextTable())
+	assert.Nil(t, gen.texts)
+
+	gen.OutputOpt.Localization = &options.LocalizationOption{}
+	require.Error(t, gen.newTextTable())
+
+	gen.OutputOpt.Localization.SourceLang = "zh"
+	require.NoError(t, gen.newTextTable())
+	assert.NotNil(t, gen.texts)
+}
@@ -0,0 +1,99 @@
+package confgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/options"
+)
+
+func TestGenerator_DateAndTimeOfDay(t *testing.T) {
+	const protoDir = "./testdata/date/proto"
+	const header = "ID,Date,OpenTime\nuint32,date,time\nEvent's ID,Event's date,Open time\n"
+	tests := []struct {
+		name     string
+		data     string
+		want     string
+		err      error
+		contains []string
+	}{
+		{
+			name: "valid",
+			data: header + "1,2024-01-01,10:30\n2,20240229,08:30:00.5\n3,2024-03-01,24:00:00\n",
+			want: `{"eventMap":{` +
+				`"1":{"id":1,"date":{"year":2024,"month":1,"day":1},"openTime":{"hours":10,"minutes":30}},` +
+				`"2":{"id":2,"date":{"year":2024,"month":2,"day":29},"openTime":{"hours":8,"minutes":30,"nanos":500000000}},` +
+				`"3":{"id":3,"date":{"year":2024,"month":3,"day":1},"openTime":{"hours":24}}}}`,
+		},
+		{
+			name:     "invalid-date",
+			data:     header + "1,2023-02-29,10:30\n",
+			err:      xerrors.ErrE2044,
+			contains: []string{"B4", "2023-02-29"},
+		},
+		{
+			name:     "invalid-time-of-day",
+			data:     header + "1,2024-01-01,10:60\n",
+			err:      xerrors.ErrE2045,
+			contains: []string{"C4", "10:60"},
+		},
+		{
+			name:     "date-out-of-range",
+			data:     header + "1,2031-01-01,10:30\n",
+			err:      xerrors.ErrE2004,
+			contains: []string{"B4", "2031-01-01"},
+		},
+		{
+			name:     "time-of-day-out-of-range",
+			data:     header + "1,2024-01-01,07:59\n",
+			err:      xerrors.ErrE2004,
+			contains: []string{"C4", "07:59:00"},
+		},
+		{
+			name:     "date-not-ordered",
+			data:     header + "1,2024-01-02,10:30\n2,2024-01-01,10:30\n",
+			err:      xerrors.ErrE2026,
+			contains: []string{"B5", "2024-01-02", "2024-01-01"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indir, outdir := t.TempDir(), t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(indir, "Event#EventConf.csv"), []byte(tt.data), 0644))
+			gen := NewGenerator("datetest", indir, outdir,
+				options.Conf(
+					&options.ConfOption{
+						Input: &options.ConfInputOption{
+							ProtoPaths: []string{protoDir},
+							ProtoFiles: []string{protoDir + "/*.proto"},
+							Formats:    []format.Format{format.CSV},
+						},
+						Output: &options.ConfOutputOption{
+							Formats: []format.Format{format.JSON},
+						},
+					},
+				),
+				// date and time of day are not affected by location
+				options.LocationName("Asia/Shanghai"),
+			)
+			err := gen.Generate()
+			if tt.err != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.err)
+				for _, s := range tt.contains {
+					assert.Contains(t, err.Error(), s)
+				}
+				return
+			}
+			require.NoError(t, err)
+			data, err := os.ReadFile(filepath.Join(outdir, "EventConf.json"))
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(data))
+		})
+	}
+}
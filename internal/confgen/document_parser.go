@@ -112,6 +112,20 @@ func (p *documentParser) parseMessage(parentField *Field, msg protoreflect.Messa
 	if messageCollector.HasErrors() {
 		return false, messageCollector.Join()
 	}
+	if present {
+		if name, err := p.computeFields(msg); err != nil {
+			if fieldNode := node.FindChild(name); fieldNode != nil {
+				return false, xerrors.WrapKV(err, fieldNode.DebugKV()...)
+			}
+			return false, xerrors.WrapKV(err, node.DebugKV()...)
+		}
+		if name, err := p.checkRules(msg); err != nil {
+			if fieldNode := node.FindChild(name); fieldNode != nil {
+				return false, xerrors.WrapKV(err, fieldNode.DebugKV()...)
+			}
+			return false, xerrors.WrapKV(err, node.DebugKV()...)
+		}
+	}
 	return present, nil
 }
 
@@ -355,7 +369,7 @@ func (p *documentParser) parseListField(field *Field, msg protoreflect.Message,
 				elemPresent, err = p.parseUnionMessage(field, elemValue.Message(), elemNode, newCardPrefix)
 			} else if field.fd.Kind() == protoreflect.MessageKind {
 				// cross-cell struct list
-				if types.IsWellKnownMessage(field.fd.Message().FullName()) {
+				if types.IsWellKnownMessage(field.fd.Message().FullName()) && !isCrossCellVector(field, elemNode) {
 					elemValue, elemPresent, err = p.parseFieldValue(field.fd, elemNode.Value, field.opts.Prop)
 				} else {
 					elemPresent, err = p.parseMessage(field, elemValue.Message(), elemNode, newCardPrefix)
@@ -403,7 +417,7 @@ func (p *documentParser) parseStructField(field *Field, msg protoreflect.Message
 	if field.opts.Span == tableaupb.Span_SPAN_INNER_CELL {
 		// incell struct
 		present, err = p.parseIncellStruct(field, structValue, node.ScalarValue(), field.sep)
-	} else if types.IsWellKnownMessage(field.fd.Message().FullName()) {
+	} else if types.IsWellKnownMessage(field.fd.Message().FullName()) && !isCrossCellVector(field, node) {
 		structValue, present, err = p.parseFieldValue(field.fd, node.ScalarValue(), field.opts.Prop)
 	} else {
 		// cross-cell struct
@@ -418,6 +432,14 @@ func (p *documentParser) parseStructField(field *Field, msg protoreflect.Message
 	return
 }
 
+// isCrossCellVector reports whether the well-known vector field is a map node
+// with component children (e.g.: "X", "Y", and "Z") instead of a scalar node,
+// which is parsed as a cross-cell struct as before vectors became well-known
+// types.
+func isCrossCellVector(field *Field, node *book.Node) bool {
+	return types.IsWellKnownVector(field.fd.Message().FullName()) && node.StructNode().Kind == book.MapNode
+}
+
 func (p *documentParser) parseScalarField(field *Field, msg protoreflect.Message, node *book.Node) (present bool, err error) {
 	var newValue protoreflect.Value
 	// FIXME(wenchy): treat any scalar field's present as true if this field's key exists?
@@ -0,0 +1,215 @@
+package fieldprop
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// computeVariable is the CEL variable name of the message which contains the
+// computed field.
+const computeVariable = "this"
+
+// RequireCompute checks whether the field's compute property is set explicitly.
+func RequireCompute(prop *tableaupb.FieldProp) bool {
+	return prop.GetCompute() != ""
+}
+
+// ComputedField is a compiled computed field (with field prop "compute"),
+// whose CEL expression is evaluated over the message containing the field.
+type ComputedField struct {
+	fd   protoreflect.FieldDescriptor
+	expr string
+	prg  cel.Program
+}
+
+// CompileCompute compiles the CEL expression of field prop "compute" of
+// field fd. The compiled field should be cached by the caller (e.g.: the
+// sheet parser), as it is bound to the descriptors of the proto registry.
+func CompileCompute(fd protoreflect.FieldDescriptor, prop *tableaupb.FieldProp) (*ComputedField, error) {
+	expr := prop.GetCompute()
+	if fd.IsList() || fd.IsMap() {
+		return nil, xerrors.E2029(expr, fmt.Errorf("list or map field is not supported"))
+	}
+	prg, err := compileExpr(fd.ContainingMessage(), expr)
+	if err != nil {
+		return nil, xerrors.E2029(expr, err)
+	}
+	return &ComputedField{fd: fd, expr: expr, prg: prg}, nil
+}
+
+// Compute evaluates the CEL expression over msg, which is referenced as
+// "this", and sets the result to the field of msg.
+func (c *ComputedField) Compute(msg protoreflect.Message) error {
+	out, _, err := c.prg.Eval(map[string]any{computeVariable: msg.Interface()})
+	if err != nil {
+		return xerrors.E2029(c.expr, err)
+	}
+	value, err := computedValue(msg, c.fd, out)
+	if err != nil {
+		return xerrors.E2029(c.expr, err)
+	}
+	msg.Set(c.fd, value)
+	return nil
+}
+
+// Compute compiles and evaluates the CEL expression of field prop "compute"
+// over msg, which is referenced as "this", and sets the result to the field
+// of msg.
+func Compute(prop *tableaupb.FieldProp, msg protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	if !RequireCompute(prop) {
+		return nil
+	}
+	computed, err := CompileCompute(fd, prop)
+	if err != nil {
+		return err
+	}
+	return computed.Compute(msg)
+}
+
+// compileExpr compiles the CEL expression with message md as variable
+// "this".
+func compileExpr(md protoreflect.MessageDescriptor, expr string) (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.TypeDescs(md.ParentFile()),
+		cel.Variable(computeVariable, cel.ObjectType(string(md.FullName()))),
+		// compare numeric values of different types by their exact values
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	return env.Program(ast)
+}
+
+// computedValue converts the CEL evaluation result to the value of field fd.
+func computedValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor, out ref.Val) (protoreflect.Value, error) {
+	convert := func(typ ref.Type) (ref.Val, error) {
+		val := out.ConvertToType(typ)
+		if celtypes.IsError(val) {
+			return nil, fmt.Errorf("cannot convert result %v (%s) to field type %s", out.Value(), out.Type().TypeName(), fd.Kind())
+		}
+		return val, nil
+	}
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		val, err := convert(celtypes.IntType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		n := int64(val.(celtypes.Int))
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return protoreflect.Value{}, fmt.Errorf("result %d overflows field type %s", n, fd.Kind())
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		val, err := convert(celtypes.IntType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt64(int64(val.(celtypes.Int))), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		val, err := convert(celtypes.UintType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		n := uint64(val.(celtypes.Uint))
+		if n > math.MaxUint32 {
+			return protoreflect.Value{}, fmt.Errorf("result %d overflows field type %s", n, fd.Kind())
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		val, err := convert(celtypes.UintType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint64(uint64(val.(celtypes.Uint))), nil
+	case protoreflect.FloatKind:
+		val, err := convert(celtypes.DoubleType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfFloat32(float32(val.(celtypes.Double))), nil
+	case protoreflect.DoubleKind:
+		val, err := convert(celtypes.DoubleType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfFloat64(float64(val.(celtypes.Double))), nil
+	case protoreflect.BoolKind:
+		val, err := convert(celtypes.BoolType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBool(bool(val.(celtypes.Bool))), nil
+	case protoreflect.StringKind:
+		val, err := convert(celtypes.StringType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfString(string(val.(celtypes.String))), nil
+	case protoreflect.BytesKind:
+		val, err := convert(celtypes.BytesType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBytes([]byte(val.(celtypes.Bytes))), nil
+	case protoreflect.EnumKind:
+		val, err := convert(celtypes.IntType)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		n := int64(val.(celtypes.Int))
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return protoreflect.Value{}, fmt.Errorf("enum value %d not defined in enum %s", n, fd.Enum().FullName())
+		}
+		num := protoreflect.EnumNumber(n)
+		if fd.Enum().Values().ByNumber(num) == nil {
+			return protoreflect.Value{}, fmt.Errorf("enum value %d not defined in enum %s", num, fd.Enum().FullName())
+		}
+		return protoreflect.ValueOfEnum(num), nil
+	case protoreflect.MessageKind:
+		result, ok := out.Value().(proto.Message)
+		if !ok {
+			// well-known types, e.g.: google.protobuf.Timestamp
+			mt, err := protoregistry.GlobalTypes.FindMessageByName(fd.Message().FullName())
+			if err != nil {
+				return protoreflect.Value{}, fmt.Errorf("cannot convert result %v (%s) to field type %s", out.Value(), out.Type().TypeName(), fd.Message().FullName())
+			}
+			native, err := out.ConvertToNative(reflect.TypeOf(mt.Zero().Interface()))
+			if err != nil {
+				return protoreflect.Value{}, fmt.Errorf("cannot convert result %v (%s) to field type %s", out.Value(), out.Type().TypeName(), fd.Message().FullName())
+			}
+			result = native.(proto.Message)
+		}
+		if result.ProtoReflect().Descriptor().FullName() != fd.Message().FullName() {
+			return protoreflect.Value{}, fmt.Errorf("cannot convert result %s to field type %s", result.ProtoReflect().Descriptor().FullName(), fd.Message().FullName())
+		}
+		// NOTE: the result message type may differ from the field message
+		// type (e.g.: generated vs dynamic), so copy it by wire format.
+		data, err := proto.Marshal(result)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		fieldValue := msg.NewField(fd)
+		if err := proto.Unmarshal(data, fieldValue.Message().Interface()); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return fieldValue, nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("field type %s is not supported", fd.Kind())
+	}
+}
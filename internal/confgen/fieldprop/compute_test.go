@@ -0,0 +1,183 @@
+package fieldprop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCompute(t *testing.T) {
+	type args struct {
+		prop      *tableaupb.FieldProp
+		msg       proto.Message
+		fieldName protoreflect.Name
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    proto.Message
+		wantErr bool
+		err     error
+	}{
+		{
+			name: "no-compute",
+			args: args{
+				prop:      &tableaupb.FieldProp{},
+				msg:       &unittestpb.Item{Id: 3, Num: 1},
+				fieldName: "num",
+			},
+			want: &unittestpb.Item{Id: 3, Num: 1},
+		},
+		{
+			name: "int32-from-uint32",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "int(this.id) * 2"},
+				msg:       &unittestpb.Item{Id: 3},
+				fieldName: "num",
+			},
+			want: &unittestpb.Item{Id: 3, Num: 6},
+		},
+		{
+			name: "uint32-from-int32",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "this.num + 1"},
+				msg:       &unittestpb.Item{Num: 9},
+				fieldName: "id",
+			},
+			want: &unittestpb.Item{Id: 10, Num: 9},
+		},
+		{
+			name: "int64-from-other-fields",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "this.type * int(this.health)"},
+				msg:       &unittestpb.Target_Pvp{Type: 2, Health: 50},
+				fieldName: "damage",
+			},
+			want: &unittestpb.Target_Pvp{Type: 2, Health: 50, Damage: 100},
+		},
+		{
+			name: "enum",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "has(this.pve) ? 2 : 1"},
+				msg:       &unittestpb.Target{Value: &unittestpb.Target_Pve_{Pve: &unittestpb.Target_Pve{}}},
+				fieldName: "type",
+			},
+			want: &unittestpb.Target{Type: unittestpb.Target_TYPE_PVE, Value: &unittestpb.Target_Pve_{Pve: &unittestpb.Target_Pve{}}},
+		},
+		{
+			name: "undefined-enum-value",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "5"},
+				msg:       &unittestpb.Target{},
+				fieldName: "type",
+			},
+			wantErr: true,
+			err:     xerrors.ErrE2029,
+		},
+		{
+			name: "timestamp-from-duration",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "timestamp('2026-01-01T00:00:00Z') + this.expiry"},
+				msg:       &unittestpb.PatchMergeConf_Time{Expiry: durationpb.New(time.Hour)},
+				fieldName: "start",
+			},
+			want: &unittestpb.PatchMergeConf_Time{
+				Start:  timestamppb.New(time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)),
+				Expiry: durationpb.New(time.Hour),
+			},
+		},
+		{
+			name: "mismatched-result-type",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "'abc'"},
+				msg:       &unittestpb.Item{},
+				fieldName: "num",
+			},
+			wantErr: true,
+			err:     xerrors.ErrE2029,
+		},
+		{
+			name: "int32-overflow",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "2147483647 + int(this.id)"},
+				msg:       &unittestpb.Item{Id: 1},
+				fieldName: "num",
+			},
+			wantErr: true,
+			err:     xerrors.ErrE2029,
+		},
+		{
+			name: "uint32-overflow",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "4294967295u + uint(this.num)"},
+				msg:       &unittestpb.Item{Num: 1},
+				fieldName: "id",
+			},
+			wantErr: true,
+			err:     xerrors.ErrE2029,
+		},
+		{
+			name: "undefined-field",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "this.unknown + 1"},
+				msg:       &unittestpb.Item{},
+				fieldName: "num",
+			},
+			wantErr: true,
+			err:     xerrors.ErrE2029,
+		},
+		{
+			name: "list-not-supported",
+			args: args{
+				prop:      &tableaupb.FieldProp{Compute: "[1, 2]"},
+				msg:       &unittestpb.Target_Pve{},
+				fieldName: "heros",
+			},
+			wantErr: true,
+			err:     xerrors.ErrE2029,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := tt.args.msg.ProtoReflect()
+			fd := msg.Descriptor().Fields().ByName(tt.args.fieldName)
+			require.NotNil(t, fd)
+			err := Compute(tt.args.prop, msg, fd)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.want, tt.args.msg), "got: %v", tt.args.msg)
+		})
+	}
+}
+
+func TestCompute_dynamic(t *testing.T) {
+	md := (&unittestpb.PatchMergeConf_Time{}).ProtoReflect().Descriptor()
+	msg := dynamicpb.NewMessage(md)
+	expiry := dynamicpb.NewMessage(md.Fields().ByName("expiry").Message())
+	expiry.Set(expiry.Descriptor().Fields().ByName("seconds"), protoreflect.ValueOfInt64(60))
+	msg.Set(md.Fields().ByName("expiry"), protoreflect.ValueOfMessage(expiry))
+
+	prop := &tableaupb.FieldProp{Compute: "timestamp('2026-01-01T00:00:00Z') + this.expiry"}
+	err := Compute(prop, msg, md.Fields().ByName("start"))
+	require.NoError(t, err)
+
+	got := &unittestpb.PatchMergeConf_Time{}
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(data, got))
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC), got.GetStart().AsTime())
+}
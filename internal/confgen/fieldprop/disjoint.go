@@ -0,0 +1,31 @@
+package fieldprop
+
+import (
+	"github.com/tableauio/tableau/internal/types"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RequireDisjoint checks whether the field's disjoint property is set
+// explicitly.
+func RequireDisjoint(prop *tableaupb.FieldProp) bool {
+	return prop.GetDisjoint()
+}
+
+// CheckDisjoint checks whether the well-known interval value overlaps with
+// any of the previous interval values in the same list or map.
+func CheckDisjoint(fd protoreflect.FieldDescriptor, prevValues []protoreflect.Value, value protoreflect.Value) error {
+	if fd.Kind() != protoreflect.MessageKind || !types.IsWellKnownInterval(fd.Message().FullName()) {
+		log.Warnf("not supported to check field prop disjoint of non-interval field: %s", fd.FullName())
+		return nil
+	}
+	for _, prevValue := range prevValues {
+		if xproto.IntervalsOverlap(prevValue.Message(), value.Message()) {
+			return xerrors.E2039(xproto.FormatInterval(value.Message()), xproto.FormatInterval(prevValue.Message()))
+		}
+	}
+	return nil
+}
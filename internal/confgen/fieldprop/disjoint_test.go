@@ -0,0 +1,104 @@
+package fieldprop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/internal/x/xproto/protoc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestCheckDisjoint(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	findFD := func(name protoreflect.FullName) protoreflect.FieldDescriptor {
+		desc, err := prFiles.FindDescriptorByName(name)
+		require.NoError(t, err)
+		return desc.(protoreflect.FieldDescriptor)
+	}
+	tests := []struct {
+		name       string
+		fd         protoreflect.FieldDescriptor
+		prevValues []string
+		value      string
+		err        error
+	}{
+		{
+			name:       "int-adjacent",
+			fd:         findFD("fieldproptest.BracketConf.Bracket.level"),
+			prevValues: []string{"[1,10)", "[20,30)"},
+			value:      "[10,20)",
+		},
+		{
+			name:       "int-shared-inclusive-bound",
+			fd:         findFD("fieldproptest.BracketConf.Bracket.level"),
+			prevValues: []string{"1~10"},
+			value:      "10~20",
+			err:        xerrors.ErrE2039,
+		},
+		{
+			name:       "int-contained",
+			fd:         findFD("fieldproptest.BracketConf.Bracket.level"),
+			prevValues: []string{"[1,100]"},
+			value:      "(10,20)",
+			err:        xerrors.ErrE2039,
+		},
+		{
+			name:       "double-exclusive-bounds",
+			fd:         findFD("fieldproptest.BracketConf.Bracket.ratio"),
+			prevValues: []string{"[0,0.5]"},
+			value:      "(0.5,1]",
+		},
+		{
+			name:       "double-overlapped",
+			fd:         findFD("fieldproptest.BracketConf.Bracket.ratio"),
+			prevValues: []string{"[0,0.5]"},
+			value:      "[0.25,1]",
+			err:        xerrors.ErrE2039,
+		},
+		{
+			name:       "timestamp-adjacent",
+			fd:         findFD("fieldproptest.BracketConf.Bracket.window"),
+			prevValues: []string{"[2024-01-01 00:00:00,2024-02-01 00:00:00)"},
+			value:      "[2024-02-01 00:00:00,2024-03-01 00:00:00)",
+		},
+		{
+			name:       "timestamp-overlapped",
+			fd:         findFD("fieldproptest.BracketConf.Bracket.window"),
+			prevValues: []string{"[2024-01-01 00:00:00,2024-02-01 00:00:00]"},
+			value:      "[2024-02-01 00:00:00,2024-03-01 00:00:00)",
+			err:        xerrors.ErrE2039,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parse := func(value string) protoreflect.Value {
+				v, _, err := xproto.ParseFieldValue(tt.fd, value, "UTC", nil)
+				require.NoError(t, err)
+				return v
+			}
+			var prevValues []protoreflect.Value
+			for _, value := range tt.prevValues {
+				prevValues = append(prevValues, parse(value))
+			}
+			err := CheckDisjoint(tt.fd, prevValues, parse(tt.value))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckDisjoint_nonInterval(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	desc, err := prFiles.FindDescriptorByName("fieldproptest.BracketConf.Bracket.id")
+	require.NoError(t, err)
+	fd := desc.(protoreflect.FieldDescriptor)
+	values := []protoreflect.Value{protoreflect.ValueOfUint32(1)}
+	require.NoError(t, CheckDisjoint(fd, values, protoreflect.ValueOfUint32(1)))
+}
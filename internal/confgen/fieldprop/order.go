@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/tableauio/tableau/internal/types"
+	"github.com/tableauio/tableau/internal/x/xproto"
 	"github.com/tableauio/tableau/log"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -55,6 +56,19 @@ func CheckOrder(fd protoreflect.FieldDescriptor, oldVal, newVal protoreflect.Val
 			oldDuration, newDuration := parseDuration(oldVal), parseDuration(newVal)
 			return oldDuration, newDuration, isOrdered(oldDuration, newDuration, order)
 
+		case types.WellKnownMessageDate:
+			oldDate, newDate := xproto.DateOf(oldVal.Message()), xproto.DateOf(newVal.Message())
+			return xproto.FormatDate(oldVal.Message()), xproto.FormatDate(newVal.Message()), isTimeOrdered(oldDate, newDate, order)
+
+		case types.WellKnownMessageTimeOfDay:
+			oldTime, newTime := xproto.TimeOfDayOf(oldVal.Message()), xproto.TimeOfDayOf(newVal.Message())
+			return xproto.FormatTimeOfDay(oldVal.Message()), xproto.FormatTimeOfDay(newVal.Message()), isOrdered(oldTime, newTime, order)
+
+		case types.WellKnownMessageDecimal:
+			oldDecimal, newDecimal := xproto.DecimalOf(oldVal.Message()), xproto.DecimalOf(newVal.Message())
+			// compare exactly: oldVal <op> newVal is same as Cmp(oldVal, newVal) <op> 0
+			return oldDecimal.Text(), newDecimal.Text(), isOrdered(oldDecimal.Cmp(newDecimal), 0, order)
+
 		default:
 			log.Warnf("not supported to check field prop order of message type: %s", msgName)
 			return nil, nil, true
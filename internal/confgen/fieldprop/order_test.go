@@ -4,6 +4,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/internal/x/xproto/protoc"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -565,3 +568,73 @@ func Test_isTimeOrdered(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckOrder_decimal(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	desc, err := prFiles.FindDescriptorByName("fieldproptest.ShopConf.Goods.price")
+	require.NoError(t, err)
+	decimalFD := desc.(protoreflect.FieldDescriptor)
+	newDecimal := func(value string) protoreflect.Value {
+		v, _, err := xproto.ParseFieldValue(decimalFD, value, "", nil)
+		require.NoError(t, err)
+		return v
+	}
+	tests := []struct {
+		name   string
+		oldVal string
+		newVal string
+		order  tableaupb.Order
+		want   bool
+	}{
+		{name: "asc decimal: equal with different scales", oldVal: "1.5", newVal: "1.50", order: tableaupb.Order_ORDER_ASC, want: true},
+		{name: "asc decimal: greater", oldVal: "0.1", newVal: "0.10001", order: tableaupb.Order_ORDER_ASC, want: true},
+		{name: "asc decimal: less", oldVal: "-0.05", newVal: "-0.5", order: tableaupb.Order_ORDER_ASC, want: false},
+		{name: "strictly asc decimal: equal", oldVal: "2", newVal: "2.00", order: tableaupb.Order_ORDER_STRICTLY_ASC, want: false},
+		{name: "desc decimal: less", oldVal: "99.99", newVal: "9.999", order: tableaupb.Order_ORDER_DESC, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, got := CheckOrder(decimalFD, newDecimal(tt.oldVal), newDecimal(tt.newVal), tt.order); got != tt.want {
+				t.Errorf("CheckOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckOrder_dateAndTimeOfDay(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	fieldOf := func(fieldName string) protoreflect.FieldDescriptor {
+		desc, err := prFiles.FindDescriptorByName(protoreflect.FullName("fieldproptest.EventConf.Event." + fieldName))
+		require.NoError(t, err)
+		return desc.(protoreflect.FieldDescriptor)
+	}
+	tests := []struct {
+		name      string
+		fieldName string
+		oldVal    string
+		newVal    string
+		order     tableaupb.Order
+		want      bool
+	}{
+		{name: "asc date: equal", fieldName: "date", oldVal: "2024-01-01", newVal: "20240101", order: tableaupb.Order_ORDER_ASC, want: true},
+		{name: "asc date: less", fieldName: "date", oldVal: "2024-01-02", newVal: "2024-01-01", order: tableaupb.Order_ORDER_ASC, want: false},
+		{name: "strictly asc date: equal", fieldName: "date", oldVal: "2024-01-01", newVal: "2024-01-01", order: tableaupb.Order_ORDER_STRICTLY_ASC, want: false},
+		{name: "desc date: less", fieldName: "date", oldVal: "2024-12-31", newVal: "2024-02-29", order: tableaupb.Order_ORDER_DESC, want: true},
+		{name: "asc time of day: greater", fieldName: "open_time", oldVal: "10:30", newVal: "10:30:00.5", order: tableaupb.Order_ORDER_ASC, want: true},
+		{name: "strictly desc time of day: greater", fieldName: "open_time", oldVal: "08:00", newVal: "24:00:00", order: tableaupb.Order_ORDER_STRICTLY_DESC, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fd := fieldOf(tt.fieldName)
+			oldVal, _, err := xproto.ParseFieldValue(fd, tt.oldVal, "", nil)
+			require.NoError(t, err)
+			newVal, _, err := xproto.ParseFieldValue(fd, tt.newVal, "", nil)
+			require.NoError(t, err)
+			if _, _, got := CheckOrder(fd, oldVal, newVal, tt.order); got != tt.want {
+				t.Errorf("CheckOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
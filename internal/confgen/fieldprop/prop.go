@@ -1,10 +1,13 @@
 package fieldprop
 
 import (
+	"cmp"
 	"strconv"
 	"strings"
 
+	"github.com/tableauio/tableau/internal/types"
 	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -108,12 +111,87 @@ func CheckInRange(prop *tableaupb.FieldProp, fieldKind protoreflect.Kind, value
 				return xerrors.E2004(v, prop.Range)
 			}
 		}
+	case protoreflect.MessageKind:
+		if !value.IsValid() {
+			return nil
+		}
+		msg := value.Message()
+		switch msg.Descriptor().FullName() {
+		case types.WellKnownMessageDecimal:
+			return checkDecimalInRange(prop, xproto.DecimalOf(msg), leftStr, rightStr)
+		case types.WellKnownMessageDate:
+			parseDate := func(s string) (int64, error) {
+				t, err := xproto.ParseDate(s)
+				return t.Unix(), err
+			}
+			return checkParsedInRange(prop, xproto.FormatDate(msg), xproto.DateOf(msg).Unix(), leftStr, rightStr, parseDate)
+		case types.WellKnownMessageTimeOfDay:
+			return checkParsedInRange(prop, xproto.FormatTimeOfDay(msg), xproto.TimeOfDayOf(msg), leftStr, rightStr, xproto.ParseTimeOfDay)
+		}
+		// range applies to each component of well-known vector
+		if !types.IsWellKnownVector(msg.Descriptor().FullName()) {
+			return xerrors.Newf("unsupported field type: %s", msg.Descriptor().FullName())
+		}
+		fields := msg.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if err := CheckInRange(prop, fd.Kind(), msg.Get(fd), true); err != nil {
+				return err
+			}
+		}
 	default:
 		return xerrors.Newf("unsupported field kind: %s", fieldKind)
 	}
 	return nil
 }
 
+// checkDecimalInRange checks whether the decimal is in the range exactly.
+func checkDecimalInRange(prop *tableaupb.FieldProp, v *tableaupb.Decimal, leftStr, rightStr string) error {
+	if leftStr != "~" {
+		left, err := tableaupb.ParseDecimal(leftStr)
+		if err != nil {
+			return xerrors.Newf("invalid range left: %s", prop.Range)
+		}
+		if v.Cmp(left) < 0 {
+			return xerrors.E2004(v.Text(), prop.Range)
+		}
+	}
+	if rightStr != "~" {
+		right, err := tableaupb.ParseDecimal(rightStr)
+		if err != nil {
+			return xerrors.Newf("invalid range right: %s", prop.Range)
+		}
+		if v.Cmp(right) > 0 {
+			return xerrors.E2004(v.Text(), prop.Range)
+		}
+	}
+	return nil
+}
+
+// checkParsedInRange checks whether the value v (formatted as text) is in
+// the range, whose bounds are parsed by parse.
+func checkParsedInRange[T cmp.Ordered](prop *tableaupb.FieldProp, text string, v T, leftStr, rightStr string, parse func(string) (T, error)) error {
+	if leftStr != "~" {
+		left, err := parse(leftStr)
+		if err != nil {
+			return xerrors.Newf("invalid range left: %s", prop.Range)
+		}
+		if v < left {
+			return xerrors.E2004(text, prop.Range)
+		}
+	}
+	if rightStr != "~" {
+		right, err := parse(rightStr)
+		if err != nil {
+			return xerrors.Newf("invalid range right: %s", prop.Range)
+		}
+		if v > right {
+			return xerrors.E2004(text, prop.Range)
+		}
+	}
+	return nil
+}
+
 // IsFixed check the horizontal list/map is fixed size or not.
 func IsFixed(prop *tableaupb.FieldProp) bool {
 	if prop != nil {
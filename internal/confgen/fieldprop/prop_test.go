@@ -3,6 +3,10 @@ package fieldprop
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/internal/x/xproto/protoc"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -279,6 +283,66 @@ func TestCheckInRange(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "vector-in-range",
+			args: args{
+				prop: &tableaupb.FieldProp{
+					Range: "-1,1",
+				},
+				fieldKind: protoreflect.MessageKind,
+				value:     protoreflect.ValueOfMessage((&tableaupb.Vector3{X: -1, Y: 0.5, Z: 1}).ProtoReflect()),
+				present:   true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "vector-component-out-of-range",
+			args: args{
+				prop: &tableaupb.FieldProp{
+					Range: "0,10",
+				},
+				fieldKind: protoreflect.MessageKind,
+				value:     protoreflect.ValueOfMessage((&tableaupb.Vector2I{X: 1, Y: 11}).ProtoReflect()),
+				present:   true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "decimal-in-range",
+			args: args{
+				prop: &tableaupb.FieldProp{
+					Range: "0.01,99.99",
+				},
+				fieldKind: protoreflect.MessageKind,
+				value:     protoreflect.ValueOfMessage(tableaupb.NewDecimal(9999, 2).ProtoReflect()),
+				present:   true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "decimal-out-of-range",
+			args: args{
+				prop: &tableaupb.FieldProp{
+					Range: "~,99.99",
+				},
+				fieldKind: protoreflect.MessageKind,
+				value:     protoreflect.ValueOfMessage(tableaupb.NewDecimal(100001, 3).ProtoReflect()),
+				present:   true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported-message",
+			args: args{
+				prop: &tableaupb.FieldProp{
+					Range: "0,10",
+				},
+				fieldKind: protoreflect.MessageKind,
+				value:     protoreflect.ValueOfMessage((&tableaupb.Fraction{Num: 1, Den: 2}).ProtoReflect()),
+				present:   true,
+			},
+			wantErr: true,
+		},
 		{
 			name: "unsupported-kind",
 			args: args{
@@ -300,3 +364,40 @@ func TestCheckInRange(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckInRange_dateAndTimeOfDay(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	newValue := func(fieldName, value string) protoreflect.Value {
+		desc, err := prFiles.FindDescriptorByName(protoreflect.FullName("fieldproptest.EventConf.Event." + fieldName))
+		require.NoError(t, err)
+		v, _, err := xproto.ParseFieldValue(desc.(protoreflect.FieldDescriptor), value, "", nil)
+		require.NoError(t, err)
+		return v
+	}
+	tests := []struct {
+		name      string
+		fieldName string
+		value     string
+		rng       string
+		wantErr   bool
+	}{
+		{name: "date-in-range", fieldName: "date", value: "2020-01-01", rng: "2020-01-01,2030-12-31"},
+		{name: "date-out-of-range", fieldName: "date", value: "2031-01-01", rng: "2020-01-01,2030-12-31", wantErr: true},
+		{name: "date-without-right-bound", fieldName: "date", value: "20991231", rng: "2020-01-01,~"},
+		{name: "time-of-day-in-range", fieldName: "open_time", value: "08:00", rng: "08:00,~"},
+		{name: "time-of-day-out-of-range", fieldName: "open_time", value: "07:59", rng: "08:00,~", wantErr: true},
+		{name: "time-of-day-closing-time", fieldName: "open_time", value: "24:00:00", rng: "~,24:00:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop := &tableaupb.FieldProp{Range: tt.rng}
+			err := CheckInRange(prop, protoreflect.MessageKind, newValue(tt.fieldName, tt.value), true)
+			if tt.wantErr {
+				require.ErrorIs(t, err, xerrors.ErrE2004)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,8 +2,11 @@ package fieldprop
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -11,9 +14,12 @@ import (
 	"github.com/tableauio/tableau/internal/importer"
 	"github.com/tableauio/tableau/internal/importer/book"
 	"github.com/tableauio/tableau/internal/importer/book/tableparser"
+	"github.com/tableauio/tableau/internal/types"
 	"github.com/tableauio/tableau/internal/x/xerrors"
 	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/tableauio/tableau/internal/x/xproto"
 	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
@@ -26,7 +32,9 @@ func init() {
 	// e.g.:
 	// - Item(ItemConf).ID
 	// - Item-(Award)(ItemConf).ID
-	referRegexp = regexp.MustCompile(`(?P<Sheet>.+?)` + `(\((?P<Alias>\w+)\))?` + `\.` + `(?P<Column>\w+)`)
+	// - Item(ItemConf).(ID,Quality): composite key
+	// - HeroConf.Hero.Skill.ID: nested field path
+	referRegexp = regexp.MustCompile(`^(?P<Sheet>.+?)` + `(\((?P<Alias>\w+)\))?` + `\.` + `(?P<Path>(\w+\.)*)` + `(?P<Column>\w+|\([\w\s,]+\))$`)
 
 	referredCache = NewReferredCache()
 }
@@ -36,6 +44,9 @@ var referredCache *ReferredCache
 type ReferredCache struct {
 	sync.RWMutex
 	references map[string]*ValueSpace // message name -> sheet column value space
+
+	referencedMu sync.Mutex
+	referenced   map[string]*ValueSpace // refer -> referenced values in value space
 }
 
 type ValueSpace struct {
@@ -59,9 +70,28 @@ func (v *ValueSpace) AddFromTable(header *tableparser.Header, table book.Tabler,
 	})
 }
 
+// AddTupleFromTable adds the composite key (tuple) of columns in each data
+// row. The cell data is normalized by the corresponding field descriptor in
+// fds if not nil, see [FormatTuple].
+func (v *ValueSpace) AddTupleFromTable(header *tableparser.Header, table book.Tabler, columnNames []string, fds []protoreflect.FieldDescriptor, bookName, sheetName string) error {
+	return tableparser.RangeDataRows(table, header, func(r *book.Row) error {
+		values := make([]string, len(columnNames))
+		for i, columnName := range columnNames {
+			cell, err := r.Cell(columnName, false)
+			if err != nil {
+				return xerrors.E2015(columnName, bookName, sheetName)
+			}
+			values[i] = normalizeReferValue(fds[i], cell.Data)
+		}
+		v.Add(FormatTuple(values))
+		return nil
+	})
+}
+
 func NewReferredCache() *ReferredCache {
 	return &ReferredCache{
 		references: make(map[string]*ValueSpace),
+		referenced: make(map[string]*ValueSpace),
 	}
 }
 
@@ -75,11 +105,25 @@ func (r *ReferredCache) Exists(refer string) bool {
 type loadValueSpaceFunc = func(refer string) (*ValueSpace, error)
 
 func (r *ReferredCache) ExistsValue(refer string, value string, loadFunc loadValueSpaceFunc) (bool, error) {
+	valueSpace, err := r.Load(refer, loadFunc)
+	if err != nil {
+		return false, err
+	}
+	if !valueSpace.Contains(value) {
+		return false, nil
+	}
+	r.markReferenced(refer, value)
+	return true, nil
+}
+
+// Load returns the value space of refer, which is loaded once by loadFunc
+// and then cached.
+func (r *ReferredCache) Load(refer string, loadFunc loadValueSpaceFunc) (*ValueSpace, error) {
 	r.RLock()
 	valueSpace, ok := r.references[refer]
 	r.RUnlock()
 	if ok {
-		return valueSpace.Contains(value), nil
+		return valueSpace, nil
 	}
 
 	// load value space once
@@ -87,14 +131,33 @@ func (r *ReferredCache) ExistsValue(refer string, value string, loadFunc loadVal
 	defer r.Unlock()
 	valueSpace, ok = r.references[refer]
 	if ok {
-		return valueSpace.Contains(value), nil
+		return valueSpace, nil
 	}
 	valueSpace, err := loadFunc(refer)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	r.references[refer] = valueSpace
-	return valueSpace.Contains(value), nil
+	return valueSpace, nil
+}
+
+// Referenced returns the values in the value space of refer which have been
+// referenced by refer checks, or nil if none.
+func (r *ReferredCache) Referenced(refer string) *ValueSpace {
+	r.referencedMu.Lock()
+	defer r.referencedMu.Unlock()
+	return r.referenced[refer]
+}
+
+func (r *ReferredCache) markReferenced(refer, value string) {
+	r.referencedMu.Lock()
+	defer r.referencedMu.Unlock()
+	valueSpace, ok := r.referenced[refer]
+	if !ok {
+		valueSpace = NewValueSpace()
+		r.referenced[refer] = valueSpace
+	}
+	valueSpace.Add(value)
 }
 
 func (r *ReferredCache) Put(refer string, valueSpace *ValueSpace) {
@@ -106,7 +169,16 @@ func (r *ReferredCache) Put(refer string, valueSpace *ValueSpace) {
 type ReferDesc struct {
 	Sheet  string // sheet name in workbook.
 	Alias  string // sheet alias: if set, used as protobuf message name.
-	Column string // sheet column name in name row.
+	Column string // sheet column name in name row, empty if composite.
+	// Path is the field path (field names) before the column, e.g.:
+	// ["Hero", "Skill"] of "HeroConf.Hero.Skill.ID". For table sheets,
+	// the column name is the concatenation of path and column, e.g.:
+	// "HeroSkillID"; for document sheets, it is the path of nested map
+	// keys or struct fields.
+	Path []string
+	// Columns is the composite key (tuple) of columns, e.g.:
+	// ["ID", "Quality"] of "Item.(ID,Quality)".
+	Columns []string
 }
 
 func (r *ReferDesc) GetMessageName() string {
@@ -116,8 +188,42 @@ func (r *ReferDesc) GetMessageName() string {
 	return r.Sheet
 }
 
-func parseRefer(text string) (*ReferDesc, error) {
-	match := referRegexp.FindStringSubmatch(text)
+// Target returns the normalized referred target, which is independent of the
+// sheet name and whitespaces, e.g.: "ItemConf.ID" of "Item(ItemConf).ID", and
+// "ItemConf.(ID,Quality)" of "Item(ItemConf).(ID, Quality)".
+func (r *ReferDesc) Target() string {
+	column := r.Column
+	if r.IsComposite() {
+		column = "(" + strings.Join(r.Columns, ",") + ")"
+	}
+	elems := append([]string{r.GetMessageName()}, r.Path...)
+	return strings.Join(append(elems, column), ".")
+}
+
+// IsComposite checks whether this refer is a composite key (tuple) of
+// multiple columns.
+func (r *ReferDesc) IsComposite() bool {
+	return len(r.Columns) != 0
+}
+
+// GetColumns returns the referred column names in table sheet, with path
+// concatenated, e.g.: ["HeroSkillID"] of "HeroConf.Hero.Skill.ID".
+func (r *ReferDesc) GetColumns() []string {
+	prefix := strings.Join(r.Path, "")
+	if !r.IsComposite() {
+		return []string{prefix + r.Column}
+	}
+	columns := make([]string, len(r.Columns))
+	for i, column := range r.Columns {
+		columns[i] = prefix + column
+	}
+	return columns
+}
+
+// ParseRefer parses the refer text, e.g.: "Item(ItemConf).ID",
+// "Item(ItemConf).(ID,Quality)", or "HeroConf.Hero.Skill.ID".
+func ParseRefer(text string) (*ReferDesc, error) {
+	match := referRegexp.FindStringSubmatch(strings.TrimSpace(text))
 	if match == nil {
 		return nil, xerrors.Newf("invalid refer pattern: %s", text)
 	}
@@ -129,13 +235,106 @@ func parseRefer(text string) (*ReferDesc, error) {
 			desc.Sheet = value
 		case "Alias":
 			desc.Alias = value
+		case "Path":
+			if value != "" {
+				desc.Path = strings.Split(strings.TrimSuffix(value, "."), ".")
+			}
 		case "Column":
-			desc.Column = value
+			if strings.HasPrefix(value, "(") {
+				for _, column := range strings.Split(strings.Trim(value, "()"), ",") {
+					column = strings.TrimSpace(column)
+					if column == "" {
+						return nil, xerrors.Newf("invalid refer pattern: %s, empty column in composite key", text)
+					}
+					desc.Columns = append(desc.Columns, column)
+				}
+			} else {
+				desc.Column = value
+			}
 		}
 	}
 	return desc, nil
 }
 
+// SplitRefers splits the comma separated refers, and the commas in
+// parentheses (sheet alias or composite key) are not treated as separators,
+// e.g.: "Item.(ID,Quality),Equip.(ID,Quality)".
+func SplitRefers(text string) []string {
+	var refers []string
+	depth, start := 0, 0
+	for i, r := range text {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				refers = append(refers, text[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(refers, text[start:])
+}
+
+// FormatTuple formats the values of composite key, e.g.: "(1001,3)".
+func FormatTuple(values []string) string {
+	return "(" + strings.Join(values, ",") + ")"
+}
+
+// FormatReferValue formats the field value as a normalized string for
+// comparison in composite key, e.g.: enum value is formatted as the enum
+// value name.
+func FormatReferValue(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	if fd.Kind() == protoreflect.EnumKind {
+		if evd := fd.Enum().Values().ByNumber(value.Enum()); evd != nil {
+			return string(evd.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	}
+	if fd.Kind() == protoreflect.MessageKind {
+		if types.IsWellKnownVector(fd.Message().FullName()) {
+			return xproto.FormatVector(value.Message())
+		}
+		if fd.Message().FullName() == types.WellKnownMessageDecimal {
+			return xproto.FormatDecimal(value.Message())
+		}
+		if fd.Message().FullName() == types.WellKnownMessageColor {
+			return xproto.FormatColor(value.Message())
+		}
+		if types.IsWellKnownInterval(fd.Message().FullName()) {
+			return xproto.FormatInterval(value.Message())
+		}
+		if fd.Message().FullName() == types.WellKnownMessageDate {
+			return xproto.FormatDate(value.Message())
+		}
+		if fd.Message().FullName() == types.WellKnownMessageTimeOfDay {
+			return xproto.FormatTimeOfDay(value.Message())
+		}
+		if fd.Message().FullName() == types.WellKnownMessageSchedule {
+			return xproto.FormatSchedule(value.Message())
+		}
+		return prototext.MarshalOptions{}.Format(value.Message().Interface())
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// normalizeReferValue parses the cell data by field descriptor fd (if not
+// nil) and formats it by [FormatReferValue], so that the same value in
+// different forms (e.g.: "1" and "1.0", enum value name and alias) are
+// treated as equal in composite key.
+func normalizeReferValue(fd protoreflect.FieldDescriptor, data string) string {
+	if fd == nil {
+		return data
+	}
+	value, _, err := xproto.ParseFieldValue(fd, data, "", nil)
+	if err != nil {
+		return data
+	}
+	return FormatReferValue(fd, value)
+}
+
 type Input struct {
 	ProtoPackage   string
 	InputDir       string
@@ -145,7 +344,7 @@ type Input struct {
 }
 
 func loadValueSpace(ctx context.Context, refer string, input *Input) (*ValueSpace, error) {
-	referInfo, err := parseRefer(refer)
+	referInfo, err := ParseRefer(refer)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +353,10 @@ func loadValueSpace(ctx context.Context, refer string, input *Input) (*ValueSpac
 	if err != nil {
 		return nil, xerrors.E2001(refer, referInfo.GetMessageName())
 	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, xerrors.E2001(refer, referInfo.GetMessageName())
+	}
 
 	// get workbook name and worksheet name
 	fileOpts := desc.ParentFile().Options().(*descriptorpb.FileOptions)
@@ -195,10 +398,26 @@ func loadValueSpace(ctx context.Context, refer string, input *Input) (*ValueSpac
 			return nil, xerrors.WrapKV(err, xerrors.KeySheetName, sheetName, xerrors.KeyBookName, impInfo.Filename())
 		}
 
+		if sheet.Document != nil {
+			err = valueSpace.AddFromDocument(ctx, sheet.Document, md, referInfo, bookName, sheetName)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		var table book.Tabler = sheet.Table
 		if sheetOpts.Transpose {
-			err = valueSpace.AddFromTable(header, sheet.Table.Transpose(), referInfo.Column, bookName, sheetName)
+			table = sheet.Table.Transpose()
+		}
+		columns := referInfo.GetColumns()
+		if referInfo.IsComposite() {
+			fds := make([]protoreflect.FieldDescriptor, len(columns))
+			for i, column := range columns {
+				fds[i] = findTableField(ctx, md, column)
+			}
+			err = valueSpace.AddTupleFromTable(header, table, columns, fds, bookName, sheetName)
 		} else {
-			err = valueSpace.AddFromTable(header, sheet.Table, referInfo.Column, bookName, sheetName)
+			err = valueSpace.AddFromTable(header, table, columns[0], bookName, sheetName)
 		}
 		if err != nil {
 			return nil, err
@@ -212,6 +431,51 @@ func loadValueSpace(ctx context.Context, refer string, input *Input) (*ValueSpac
 // column value space (aka message's field value space). prop.Refer is comma separated,
 // e.g.: "SheetName(SheetAlias).ColumnName[,SheetName(SheetAlias).ColumnName]..."
 func InReferredSpace(ctx context.Context, prop *tableaupb.FieldProp, cellData string, input *Input) (bool, error) {
+	return inReferredSpace(ctx, prop, cellData, input, false)
+}
+
+// RequireCompositeRefer checks whether the field's refer property contains
+// composite key refer, e.g.: "Item(ItemConf).(ID,Quality)".
+func RequireCompositeRefer(prop *tableaupb.FieldProp) bool {
+	if strings.TrimSpace(prop.GetRefer()) == "" {
+		return false
+	}
+	for _, refer := range SplitRefers(prop.GetRefer()) {
+		if referInfo, err := ParseRefer(refer); err == nil && referInfo.IsComposite() {
+			return true
+		}
+	}
+	return false
+}
+
+// InReferredTupleSpace checks whether the composite key (tuple) formed by
+// the struct field values is at least in one of the other sheets' composite
+// key value space. prop.Refer is comma separated, and each refer should be a
+// composite key, e.g.: "Item(ItemConf).(ID,Quality)".
+func InReferredTupleSpace(ctx context.Context, prop *tableaupb.FieldProp, msg protoreflect.Message, input *Input) (bool, string, error) {
+	md := msg.Descriptor()
+	fields := md.Fields()
+	values := make([]string, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		values[i] = FormatReferValue(fd, msg.Get(fd))
+	}
+	tuple := FormatTuple(values)
+	for _, refer := range SplitRefers(prop.GetRefer()) {
+		referInfo, err := ParseRefer(refer)
+		if err != nil {
+			return false, tuple, err
+		}
+		if len(referInfo.Columns) != 0 && len(referInfo.Columns) != fields.Len() {
+			return false, tuple, xerrors.Newf("composite key refer %q has %d columns, but struct %s has %d fields",
+				refer, len(referInfo.Columns), md.FullName(), fields.Len())
+		}
+	}
+	ok, err := inReferredSpace(ctx, prop, tuple, input, true)
+	return ok, tuple, err
+}
+
+func inReferredSpace(ctx context.Context, prop *tableaupb.FieldProp, cellData string, input *Input, composite bool) (bool, error) {
 	if prop == nil || strings.TrimSpace(prop.Refer) == "" {
 		return true, nil
 	}
@@ -225,7 +489,17 @@ func InReferredSpace(ctx context.Context, prop *tableaupb.FieldProp, cellData st
 	}
 
 	// NOTE: prop.Refer is comma separated, e.g.: "SheetName(SheetAlias).ColumnName[,SheetName(SheetAlias).ColumnName]..."
-	for _, refer := range strings.Split(prop.Refer, ",") {
+	for _, refer := range SplitRefers(prop.Refer) {
+		referInfo, err := ParseRefer(refer)
+		if err != nil {
+			return false, err
+		}
+		if referInfo.IsComposite() != composite {
+			if composite {
+				return false, xerrors.Newf("refer %q is not a composite key, but set on struct field", refer)
+			}
+			return false, xerrors.Newf("composite key refer %q should be set on struct field", refer)
+		}
 		ok, err := referredCache.ExistsValue(refer, cellData, loadFunc)
 		if err != nil {
 			return false, err
@@ -236,3 +510,52 @@ func InReferredSpace(ctx context.Context, prop *tableaupb.FieldProp, cellData st
 	}
 	return false, nil
 }
+
+// ReferredValues returns the sorted values in the referred value spaces of
+// prop.Refer, e.g.: for completion of refer targets.
+func ReferredValues(ctx context.Context, prop *tableaupb.FieldProp, input *Input) ([]string, error) {
+	if prop == nil || strings.TrimSpace(prop.Refer) == "" {
+		return nil, nil
+	}
+	set := hashset.New()
+	for _, refer := range SplitRefers(prop.Refer) {
+		valueSpace, err := loadValueSpace(ctx, refer, input)
+		if err != nil {
+			return nil, err
+		}
+		set.Add(valueSpace.Values()...)
+	}
+	values := make([]string, 0, set.Size())
+	for _, v := range set.Values() {
+		values = append(values, v.(string))
+	}
+	sort.Strings(values)
+	return values, nil
+}
+
+// ClearReferredCache clears the loaded referred value spaces, so that the
+// referred workbooks are reloaded in the next refer check, e.g.: by the
+// long-running language server after workbooks changed.
+func ClearReferredCache() {
+	referredCache.Lock()
+	defer referredCache.Unlock()
+	referredCache.references = make(map[string]*ValueSpace)
+	referredCache.referencedMu.Lock()
+	defer referredCache.referencedMu.Unlock()
+	referredCache.referenced = make(map[string]*ValueSpace)
+}
+
+// ReferredValueSpace returns the value space of refer, which is loaded once
+// and then cached until [ClearReferredCache].
+func ReferredValueSpace(ctx context.Context, refer string, input *Input) (*ValueSpace, error) {
+	return referredCache.Load(refer, func(refer string) (*ValueSpace, error) {
+		return loadValueSpace(ctx, refer, input)
+	})
+}
+
+// ReferencedValues returns the values in the value space of refer which
+// have been referenced by refer checks since the last [ClearReferredCache],
+// or nil if none.
+func ReferencedValues(refer string) *ValueSpace {
+	return referredCache.Referenced(refer)
+}
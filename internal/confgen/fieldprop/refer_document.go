@@ -0,0 +1,220 @@
+package fieldprop
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/importer/book"
+	"github.com/tableauio/tableau/internal/strcase"
+	"github.com/tableauio/tableau/internal/types"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AddFromDocument adds the values of the field path in document sheet (e.g.:
+// YAML or XML). The path can refer to:
+//   - map field: all map keys, e.g.: "HeroConf.Hero"
+//   - list field: all list elements, e.g.: "HeroConf.Tags"
+//   - scalar field: field value, e.g.: "HeroConf.Hero.Skill.ID"
+//   - composite key: field values of struct, e.g.: "HeroConf.Hero.(ID,Level)"
+func (v *ValueSpace) AddFromDocument(ctx context.Context, doc *book.Node, md protoreflect.MessageDescriptor, refer *ReferDesc, bookName, sheetName string) error {
+	if len(doc.Children) != 1 {
+		return xerrors.NewKV("document should have and only have one child (map node)",
+			xerrors.KeySheetName, sheetName)
+	}
+	w := &documentWalker{
+		ctx:        ctx,
+		valueSpace: v,
+		refer:      refer,
+		bookName:   bookName,
+		sheetName:  sheetName,
+	}
+	path := refer.Path
+	if !refer.IsComposite() {
+		path = append(path[:len(path):len(path)], refer.Column)
+	}
+	return w.walk(doc.Children[0], md, path, nil)
+}
+
+// documentWalker walks the document nodes along the refer path.
+type documentWalker struct {
+	ctx        context.Context
+	valueSpace *ValueSpace
+	refer      *ReferDesc
+	bookName   string
+	sheetName  string
+}
+
+// mapKey is the key field name and value of the map entry being walked,
+// which is the map node name instead of a child node in document.
+type mapKey struct {
+	name  string
+	value string
+}
+
+func (w *documentWalker) walk(node *book.Node, md protoreflect.MessageDescriptor, path []string, key *mapKey) error {
+	if len(path) == 0 {
+		// composite key: field values of struct
+		values := make([]string, len(w.refer.Columns))
+		for i, column := range w.refer.Columns {
+			fd, _ := findFieldByName(w.ctx, md, column)
+			if fd == nil {
+				return xerrors.E2015(w.columnPath(column), w.bookName, w.sheetName)
+			}
+			values[i] = normalizeReferValue(fd, w.scalarValue(node, column, key))
+		}
+		w.valueSpace.Add(FormatTuple(values))
+		return nil
+	}
+	name, rest := path[0], path[1:]
+	fd, opts := findFieldByName(w.ctx, md, name)
+	if fd == nil {
+		return xerrors.E2015(w.columnPath(name), w.bookName, w.sheetName)
+	}
+	fieldNode := node.FindChild(name)
+	if fieldNode == nil {
+		if key != nil && key.name == name {
+			if len(rest) == 0 && !w.refer.IsComposite() {
+				w.valueSpace.Add(key.value)
+			}
+		}
+		// field absent
+		return nil
+	}
+	switch {
+	case fd.IsMap():
+		for _, elemNode := range fieldNode.Children {
+			elemKey := &mapKey{name: mapKeyName(opts), value: elemNode.Name}
+			if fieldNode.Kind == book.ListNode {
+				elemKey.value = elemNode.FindChild(elemKey.name).GetValue()
+			}
+			if len(rest) == 0 && !w.refer.IsComposite() {
+				w.valueSpace.Add(elemKey.value)
+				continue
+			}
+			if fd.MapValue().Kind() != protoreflect.MessageKind {
+				return xerrors.E2015(w.columnPath(rest[0]), w.bookName, w.sheetName)
+			}
+			if err := w.walk(elemNode, fd.MapValue().Message(), rest, elemKey); err != nil {
+				return err
+			}
+		}
+	case fd.IsList():
+		for _, elemNode := range fieldNode.Children {
+			if fd.Kind() != protoreflect.MessageKind {
+				if len(rest) == 0 && !w.refer.IsComposite() {
+					w.valueSpace.Add(elemNode.Value)
+					continue
+				}
+				return xerrors.E2015(w.columnPath(name), w.bookName, w.sheetName)
+			}
+			if err := w.walk(elemNode, fd.Message(), rest, nil); err != nil {
+				return err
+			}
+		}
+	case fd.Kind() == protoreflect.MessageKind:
+		if len(rest) == 0 && !w.refer.IsComposite() {
+			// struct field itself cannot be referred
+			return xerrors.E2015(w.columnPath(name), w.bookName, w.sheetName)
+		}
+		return w.walk(fieldNode.StructNode(), fd.Message(), rest, nil)
+	default:
+		if len(rest) != 0 || w.refer.IsComposite() {
+			return xerrors.E2015(w.columnPath(name), w.bookName, w.sheetName)
+		}
+		w.valueSpace.Add(fieldNode.ScalarValue())
+	}
+	return nil
+}
+
+// scalarValue returns the scalar value of the named child of struct node, or
+// the map key value if the child is the map key field.
+func (w *documentWalker) scalarValue(node *book.Node, name string, key *mapKey) string {
+	if child := node.FindChild(name); child != nil {
+		return child.ScalarValue()
+	}
+	if key != nil && key.name == name {
+		return key.value
+	}
+	return ""
+}
+
+// columnPath returns the dot separated field path ending with name for
+// error reporting, e.g.: "Hero.Skill.ID".
+func (w *documentWalker) columnPath(name string) string {
+	return strings.Join(append(w.refer.Path[:len(w.refer.Path):len(w.refer.Path)], name), ".")
+}
+
+// findFieldByName finds the field by the name (see [fieldName]) in message.
+func findFieldByName(ctx context.Context, md protoreflect.MessageDescriptor, name string) (protoreflect.FieldDescriptor, *tableaupb.FieldOptions) {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		opts, _ := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		if fieldName(ctx, fd, opts) == name {
+			return fd, opts
+		}
+	}
+	return nil, nil
+}
+
+// findTableField finds the field by the column name in table sheet. The
+// column name of a nested field is the concatenation of the names of all
+// its ancestors (with card index of list or map) and itself. It returns nil
+// if not found.
+func findTableField(ctx context.Context, md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if name == "" {
+		return nil
+	}
+	if fd, _ := findFieldByName(ctx, md, name); fd != nil {
+		return fd
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		opts, _ := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		prefix := fieldName(ctx, fd, opts)
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		// strip card index of list or map, e.g.: "Item1ID" -> "ID"
+		rest := strings.TrimLeft(name[len(prefix):], "0123456789")
+		sub := fd.Message()
+		if fd.IsMap() {
+			sub = fd.MapValue().Message()
+		}
+		if rest == "" || sub == nil {
+			continue
+		}
+		if subField := findTableField(ctx, sub, rest); subField != nil {
+			return subField
+		}
+	}
+	return nil
+}
+
+// fieldName returns the option "name" of field, or the default name deduced
+// from field name if field options not set.
+func fieldName(ctx context.Context, fd protoreflect.FieldDescriptor, opts *tableaupb.FieldOptions) string {
+	if opts != nil {
+		return opts.GetName()
+	}
+	name := strcase.FromContext(ctx).ToCamel(string(fd.Name()))
+	if fd.IsList() {
+		return strings.TrimSuffix(name, types.DefaultListFieldOptNameSuffix)
+	} else if fd.IsMap() {
+		return strings.TrimSuffix(name, types.DefaultMapFieldOptNameSuffix)
+	}
+	return name
+}
+
+// mapKeyName returns the option "key" of map field, or the default key name
+// if field options not set.
+func mapKeyName(opts *tableaupb.FieldOptions) string {
+	if opts != nil {
+		return opts.GetKey()
+	}
+	return types.DefaultMapKeyOptName
+}
@@ -5,12 +5,17 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto/protoc"
 	"github.com/tableauio/tableau/proto/tableaupb"
-	_ "github.com/tableauio/tableau/proto/tableaupb/unittestpb"
+	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
-func Test_parseRefer(t *testing.T) {
+func TestParseRefer(t *testing.T) {
 	type args struct {
 		text string
 	}
@@ -25,32 +30,67 @@ func Test_parseRefer(t *testing.T) {
 			args: args{
 				text: "Item.ID",
 			},
-			want: &ReferDesc{"Item", "", "ID"},
+			want: &ReferDesc{Sheet: "Item", Column: "ID"},
 		},
 		{
 			name: "with alias",
 			args: args{
 				text: "Item(ItemConf).ID",
 			},
-			want: &ReferDesc{"Item", "ItemConf", "ID"},
+			want: &ReferDesc{Sheet: "Item", Alias: "ItemConf", Column: "ID"},
 		},
 		{
 			name: "special-sheet-name-and-with-alias",
 			args: args{
 				text: "Item-(Award)(ItemConf).ID",
 			},
-			want: &ReferDesc{"Item-(Award)", "ItemConf", "ID"},
+			want: &ReferDesc{Sheet: "Item-(Award)", Alias: "ItemConf", Column: "ID"},
+		},
+		{
+			name: "composite-key",
+			args: args{
+				text: "Item(ItemConf).(ID, Quality)",
+			},
+			want: &ReferDesc{Sheet: "Item", Alias: "ItemConf", Columns: []string{"ID", "Quality"}},
+		},
+		{
+			name: "nested-path",
+			args: args{
+				text: "HeroConf.Hero.Skill.ID",
+			},
+			want: &ReferDesc{Sheet: "HeroConf", Column: "ID", Path: []string{"Hero", "Skill"}},
+		},
+		{
+			name: "nested-path-and-composite-key",
+			args: args{
+				text: "HeroConf.Hero.Skill.(ID,Level)",
+			},
+			want: &ReferDesc{Sheet: "HeroConf", Path: []string{"Hero", "Skill"}, Columns: []string{"ID", "Level"}},
+		},
+		{
+			name: "empty-column-in-composite-key",
+			args: args{
+				text: "Item.(ID,)",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid",
+			args: args{
+				text: "Item",
+			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parseRefer(tt.args.text)
+			got, err := ParseRefer(tt.args.text)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("parseRefer() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ParseRefer() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("parseRefer() = %v, want %v", got, tt.want)
+				t.Errorf("ParseRefer() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -212,3 +252,180 @@ func TestInReferredSpace(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitRefers(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "single",
+			text: "Item.ID",
+			want: []string{"Item.ID"},
+		},
+		{
+			name: "multiple",
+			text: "Item(ItemConf).ID,Equip.ID",
+			want: []string{"Item(ItemConf).ID", "Equip.ID"},
+		},
+		{
+			name: "composite-keys",
+			text: "Item.(ID,Quality),Equip(EquipConf).(ID,Quality)",
+			want: []string{"Item.(ID,Quality)", "Equip(EquipConf).(ID,Quality)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SplitRefers(tt.text))
+		})
+	}
+}
+
+func TestReferDesc_Target(t *testing.T) {
+	tests := []struct {
+		refer string
+		want  string
+	}{
+		{refer: "Item.ID", want: "Item.ID"},
+		{refer: "Item(ItemConf).ID", want: "ItemConf.ID"},
+		{refer: "Item(ItemConf).( ID, Quality )", want: "ItemConf.(ID,Quality)"},
+		{refer: "HeroConf.Hero.Skill.ID", want: "HeroConf.Hero.Skill.ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.refer, func(t *testing.T) {
+			desc, err := ParseRefer(tt.refer)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, desc.Target())
+		})
+	}
+}
+
+func TestInReferredTupleSpace(t *testing.T) {
+	input := &Input{
+		ProtoPackage: "unittest",
+		InputDir:     "../../../testdata",
+		PRFiles:      protoregistry.GlobalFiles,
+		Present:      true,
+	}
+	tests := []struct {
+		name      string
+		prop      *tableaupb.FieldProp
+		msg       proto.Message
+		want      bool
+		wantTuple string
+		wantErr   bool
+	}{
+		{
+			name:      "in referred tuple space",
+			prop:      &tableaupb.FieldProp{Refer: "ItemConf.(ID,Num)"},
+			msg:       &unittestpb.Item{Id: 1, Num: 100},
+			want:      true,
+			wantTuple: "(1,100)",
+		},
+		{
+			name:      "not in referred tuple space",
+			prop:      &tableaupb.FieldProp{Refer: "ItemConf(ItemConf).(ID,Num)"},
+			msg:       &unittestpb.Item{Id: 1, Num: 200},
+			want:      false,
+			wantTuple: "(1,200)",
+		},
+		{
+			name:      "in one of referred tuple spaces",
+			prop:      &tableaupb.FieldProp{Refer: "ItemConf(ItemConf).(ID,Num),ItemConf.(Num,ID)"},
+			msg:       &unittestpb.Item{Id: 200, Num: 2},
+			want:      true,
+			wantTuple: "(200,2)",
+		},
+		{
+			name:      "mismatched column count",
+			prop:      &tableaupb.FieldProp{Refer: "ItemConf.(ID,Num,ID)"},
+			msg:       &unittestpb.Item{Id: 1, Num: 100},
+			wantTuple: "(1,100)",
+			wantErr:   true,
+		},
+		{
+			name:      "not composite key",
+			prop:      &tableaupb.FieldProp{Refer: "ItemConf.(ID,Num),ItemConf.ID"},
+			msg:       &unittestpb.Item{Id: 1, Num: 200},
+			wantTuple: "(1,200)",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, tuple, err := InReferredTupleSpace(context.Background(), tt.prop, tt.msg.ProtoReflect(), input)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTuple, tuple)
+		})
+	}
+}
+
+func TestReferredValues_document(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	input := &Input{
+		ProtoPackage: "fieldproptest",
+		InputDir:     "testdata/workbook",
+		PRFiles:      prFiles,
+	}
+	tests := []struct {
+		name    string
+		refer   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "map-keys",
+			refer: "HeroConf.Hero",
+			want:  []string{"1", "2"},
+		},
+		{
+			name:  "map-key-field",
+			refer: "HeroConf.Hero.ID",
+			want:  []string{"1", "2"},
+		},
+		{
+			name:  "nested-map-keys",
+			refer: "HeroConf.Hero.Skill",
+			want:  []string{"101", "102", "201"},
+		},
+		{
+			name:  "nested-struct-field",
+			refer: "HeroConf.Hero.Skill.Level",
+			want:  []string{"1", "2", "3"},
+		},
+		{
+			name:  "list-elements",
+			refer: "HeroConf.Hero.Tag",
+			want:  []string{"Brave", "Fast"},
+		},
+		{
+			name:  "composite-key",
+			refer: "HeroConf.Hero.Skill.(ID,Level)",
+			want:  []string{"(101,1)", "(102,2)", "(201,3)"},
+		},
+		{
+			name:    "field-not-found",
+			refer:   "HeroConf.Hero.Weapon",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReferredValues(context.Background(), &tableaupb.FieldProp{Refer: tt.refer}, input)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, xerrors.ErrE2015)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package fieldprop
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// compareOperators are the supported operators of field prop "compare",
+// longer operators first for prefix matching.
+var compareOperators = []string{"<=", ">=", "==", "!=", "<", ">"}
+
+// RequireRules checks whether any cross-field rule property (required_if,
+// exclusive_with, or compare) of the field is set explicitly.
+func RequireRules(prop *tableaupb.FieldProp) bool {
+	return prop.GetRequiredIf() != "" || prop.GetExclusiveWith() != "" || prop.GetCompare() != ""
+}
+
+// Rule is a compiled cross-field rule of a field, which is evaluated as a
+// CEL expression over the message containing the field.
+type Rule struct {
+	expr     string
+	prg      cel.Program
+	newError func(msg protoreflect.Message) error // error of a broken rule
+}
+
+// Check evaluates the rule over msg, and returns the localized error if the
+// rule is broken.
+func (r *Rule) Check(msg protoreflect.Message) error {
+	out, _, err := r.prg.Eval(map[string]any{computeVariable: msg.Interface()})
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to evaluate rule: %s", r.expr)
+	}
+	if ok, _ := out.Value().(bool); !ok {
+		return r.newError(msg)
+	}
+	return nil
+}
+
+// CompileRules compiles the cross-field rules of field fd, which is named
+// name (option "name"), by its props:
+//   - required_if: "Column=Value" or "Column!=Value"
+//   - exclusive_with: "Column1,Column2"
+//   - compare: "<=Column", and operators: <, <=, >, >=, ==, !=
+//
+// Columns are the sibling fields' names (option "name") in the same message.
+func CompileRules(ctx context.Context, fd protoreflect.FieldDescriptor, name string, prop *tableaupb.FieldProp) ([]*Rule, error) {
+	md := fd.ContainingMessage()
+	var rules []*Rule
+	if text := prop.GetRequiredIf(); text != "" {
+		rule, err := compileRequiredIf(ctx, md, fd, name, text)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if text := prop.GetExclusiveWith(); text != "" {
+		for _, column := range strings.Split(text, ",") {
+			rule, err := compileExclusiveWith(ctx, md, fd, name, strings.TrimSpace(column))
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+	}
+	if text := prop.GetCompare(); text != "" {
+		rule, err := compileCompare(ctx, md, fd, name, text)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func compileRequiredIf(ctx context.Context, md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor, name, text string) (*Rule, error) {
+	op := "=="
+	column, value, ok := strings.Cut(text, "!=")
+	if ok {
+		op = "!="
+	} else if column, value, ok = strings.Cut(text, "="); !ok {
+		return nil, xerrors.Newf("invalid required_if pattern: %s, should be \"Column=Value\" or \"Column!=Value\"", text)
+	}
+	column, value = strings.TrimSpace(column), strings.TrimSpace(value)
+	condFd, err := findRuleField(ctx, md, column)
+	if err != nil {
+		return nil, err
+	}
+	literal, err := celLiteral(condFd, value)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "invalid required_if pattern: %s", text)
+	}
+	expr := fmt.Sprintf("%s.%s %s %s ? has(%s.%s) : true", computeVariable, condFd.Name(), op, literal, computeVariable, fd.Name())
+	condition := column + strings.TrimPrefix(op, "=") + value
+	return newRule(md, expr, func(protoreflect.Message) error {
+		return xerrors.E2031(name, condition)
+	})
+}
+
+func compileExclusiveWith(ctx context.Context, md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor, name, column string) (*Rule, error) {
+	otherFd, err := findRuleField(ctx, md, column)
+	if err != nil {
+		return nil, err
+	}
+	expr := fmt.Sprintf("!(has(%s.%s) && has(%s.%s))", computeVariable, fd.Name(), computeVariable, otherFd.Name())
+	return newRule(md, expr, func(protoreflect.Message) error {
+		return xerrors.E2032(name, column)
+	})
+}
+
+func compileCompare(ctx context.Context, md protoreflect.MessageDescriptor, fd protoreflect.FieldDescriptor, name, text string) (*Rule, error) {
+	text = strings.TrimSpace(text)
+	var op string
+	for _, operator := range compareOperators {
+		if strings.HasPrefix(text, operator) {
+			op = operator
+			break
+		}
+	}
+	if op == "" {
+		return nil, xerrors.Newf("invalid compare pattern: %s, should be \"<Operator>Column\", e.g.: \"<=MaxLevel\"", text)
+	}
+	column := strings.TrimSpace(text[len(op):])
+	otherFd, err := findRuleField(ctx, md, column)
+	if err != nil {
+		return nil, err
+	}
+	lhsField := computeVariable + "." + string(fd.Name())
+	rhsField := computeVariable + "." + string(otherFd.Name())
+	cmp := fmt.Sprintf("%s %s %s", lhsField, op, rhsField)
+	switch {
+	case fd.Kind() == otherFd.Kind():
+		if fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() != otherFd.Message().FullName() {
+			return nil, newIncomparableError(text, fd, otherFd)
+		}
+	case isNumericKind(fd.Kind()) && isNumericKind(otherFd.Kind()):
+		// Compare different numeric types (e.g.: int64 and uint64) by their
+		// exact values, instead of converting them to double, which loses
+		// precision above 2^53. Only ordering operators are supported across
+		// numeric types, so "==" and "!=" are composed of them.
+		switch op {
+		case "==":
+			cmp = fmt.Sprintf("(%s <= %s && %s >= %s)", lhsField, rhsField, lhsField, rhsField)
+		case "!=":
+			cmp = fmt.Sprintf("(%s < %s || %s > %s)", lhsField, rhsField, lhsField, rhsField)
+		}
+	default:
+		return nil, newIncomparableError(text, fd, otherFd)
+	}
+	// only compare when both fields are present
+	expr := fmt.Sprintf("!has(%s) || !has(%s) || %s", lhsField, rhsField, cmp)
+	return newRule(md, expr, func(msg protoreflect.Message) error {
+		return xerrors.E2033(name, FormatReferValue(fd, msg.Get(fd)), op, column, FormatReferValue(otherFd, msg.Get(otherFd)))
+	})
+}
+
+func newIncomparableError(text string, fd, otherFd protoreflect.FieldDescriptor) error {
+	return xerrors.Newf("invalid compare pattern: %s, field type %s is not comparable with %s",
+		text, xproto.GetFieldTypeName(fd), xproto.GetFieldTypeName(otherFd))
+}
+
+func newRule(md protoreflect.MessageDescriptor, expr string, newError func(msg protoreflect.Message) error) (*Rule, error) {
+	prg, err := compileExpr(md, expr)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to compile rule: %s", expr)
+	}
+	return &Rule{expr: expr, prg: prg, newError: newError}, nil
+}
+
+// findRuleField finds the sibling field referred by column name in rules,
+// which should be a singular field.
+func findRuleField(ctx context.Context, md protoreflect.MessageDescriptor, column string) (protoreflect.FieldDescriptor, error) {
+	fd, _ := findFieldByName(ctx, md, column)
+	if fd == nil {
+		return nil, xerrors.Newf("column %q not found in message %s", column, md.FullName())
+	}
+	if fd.IsList() || fd.IsMap() {
+		return nil, xerrors.Newf("column %q of list or map is not supported in rules", column)
+	}
+	return fd, nil
+}
+
+// celLiteral converts the text value of field fd to a CEL literal, e.g.:
+// enum value name "TYPE_PVE" to its number "2", and uint "5" to "5u".
+func celLiteral(fd protoreflect.FieldDescriptor, text string) (string, error) {
+	if fd.Kind() == protoreflect.MessageKind {
+		return "", xerrors.Newf("field type %s is not supported", xproto.GetFieldTypeName(fd))
+	}
+	v, _, err := xproto.ParseFieldValue(fd, text, "", nil)
+	if err != nil {
+		return "", err
+	}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool()), nil
+	case protoreflect.EnumKind:
+		return strconv.FormatInt(int64(v.Enum()), 10), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10) + "u", nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "double('" + strconv.FormatFloat(v.Float(), 'g', -1, 64) + "')", nil
+	case protoreflect.StringKind:
+		return strconv.Quote(v.String()), nil
+	default:
+		return "", xerrors.Newf("field type %s is not supported", xproto.GetFieldTypeName(fd))
+	}
+}
+
+func isNumericKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.BoolKind, protoreflect.EnumKind, protoreflect.StringKind,
+		protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	default:
+		return true
+	}
+}
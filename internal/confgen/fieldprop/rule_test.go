@@ -0,0 +1,175 @@
+package fieldprop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto/protoc"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRule_Check(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	desc, err := prFiles.FindDescriptorByName("fieldproptest.RewardConf.Reward")
+	require.NoError(t, err)
+	md := desc.(protoreflect.MessageDescriptor)
+	fields := md.Fields()
+
+	newReward := func(values map[protoreflect.Name]any) protoreflect.Message {
+		msg := dynamicpb.NewMessage(md)
+		for name, v := range values {
+			fd := fields.ByName(name)
+			switch v := v.(type) {
+			case *timestamppb.Timestamp:
+				value := msg.NewField(fd)
+				data, err := proto.Marshal(v)
+				require.NoError(t, err)
+				require.NoError(t, proto.Unmarshal(data, value.Message().Interface()))
+				msg.Set(fd, value)
+			case protoreflect.EnumNumber:
+				msg.Set(fd, protoreflect.ValueOfEnum(v))
+			default:
+				msg.Set(fd, protoreflect.ValueOf(v))
+			}
+		}
+		return msg
+	}
+	check := func(fieldName protoreflect.Name, msg protoreflect.Message) error {
+		fd := fields.ByName(fieldName)
+		opts := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		rules, err := CompileRules(context.Background(), fd, opts.GetName(), opts.GetProp())
+		require.NoError(t, err)
+		for _, rule := range rules {
+			if err := rule.Check(msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	begin := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		fieldName protoreflect.Name
+		values    map[protoreflect.Name]any
+		err       error
+	}{
+		{
+			name:      "required-if-met",
+			fieldName: "item_id",
+			values:    map[protoreflect.Name]any{"type": protoreflect.EnumNumber(1), "item_id": uint32(1001)},
+		},
+		{
+			name:      "required-if-broken",
+			fieldName: "item_id",
+			values:    map[protoreflect.Name]any{"type": protoreflect.EnumNumber(1)},
+			err:       xerrors.ErrE2031,
+		},
+		{
+			name:      "required-if-condition-not-met",
+			fieldName: "item_id",
+			values:    map[protoreflect.Name]any{"type": protoreflect.EnumNumber(2), "equip_id": uint32(2001)},
+		},
+		{
+			name:      "required-if-not-equal-broken",
+			fieldName: "equip_id",
+			values:    map[protoreflect.Name]any{"type": protoreflect.EnumNumber(2)},
+			err:       xerrors.ErrE2031,
+		},
+		{
+			name:      "exclusive-with-broken",
+			fieldName: "item_id",
+			values:    map[protoreflect.Name]any{"type": protoreflect.EnumNumber(1), "item_id": uint32(1001), "equip_id": uint32(2001)},
+			err:       xerrors.ErrE2032,
+		},
+		{
+			name:      "compare-different-numeric-types",
+			fieldName: "min_level",
+			values:    map[protoreflect.Name]any{"min_level": int32(10), "max_level": uint32(10)},
+		},
+		{
+			name:      "compare-broken",
+			fieldName: "min_level",
+			values:    map[protoreflect.Name]any{"min_level": int32(11), "max_level": uint32(10)},
+			err:       xerrors.ErrE2033,
+		},
+		{
+			name:      "compare-absent",
+			fieldName: "min_level",
+			values:    map[protoreflect.Name]any{"min_level": int32(11)},
+		},
+		{
+			name:      "compare-equal-int64-and-uint64",
+			fieldName: "exp",
+			values:    map[protoreflect.Name]any{"exp": int64(1<<53 + 1), "target_exp": uint64(1<<53 + 1)},
+		},
+		{
+			name:      "compare-equal-int64-and-uint64-broken-above-2^53",
+			fieldName: "exp",
+			values:    map[protoreflect.Name]any{"exp": int64(1<<53 + 1), "target_exp": uint64(1 << 53)},
+			err:       xerrors.ErrE2033,
+		},
+		{
+			name:      "compare-equal-negative-int64-and-uint64-broken",
+			fieldName: "exp",
+			values:    map[protoreflect.Name]any{"exp": int64(-1), "target_exp": uint64(1<<64 - 1)},
+			err:       xerrors.ErrE2033,
+		},
+		{
+			name:      "compare-timestamp",
+			fieldName: "begin",
+			values:    map[protoreflect.Name]any{"begin": timestamppb.New(begin), "end": timestamppb.New(begin.Add(time.Hour))},
+		},
+		{
+			name:      "compare-timestamp-broken",
+			fieldName: "begin",
+			values:    map[protoreflect.Name]any{"begin": timestamppb.New(begin), "end": timestamppb.New(begin)},
+			err:       xerrors.ErrE2033,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := check(tt.fieldName, newReward(tt.values))
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCompileRules_invalid(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	desc, err := prFiles.FindDescriptorByName("fieldproptest.RewardConf.Reward")
+	require.NoError(t, err)
+	fd := desc.(protoreflect.MessageDescriptor).Fields().ByName("min_level")
+	tests := []struct {
+		name string
+		prop *tableaupb.FieldProp
+	}{
+		{name: "required-if-no-operator", prop: &tableaupb.FieldProp{RequiredIf: "Type"}},
+		{name: "required-if-column-not-found", prop: &tableaupb.FieldProp{RequiredIf: "Kind=1"}},
+		{name: "required-if-invalid-value", prop: &tableaupb.FieldProp{RequiredIf: "Type=TYPE_NONE"}},
+		{name: "exclusive-with-column-not-found", prop: &tableaupb.FieldProp{ExclusiveWith: "ItemID,Kind"}},
+		{name: "compare-no-operator", prop: &tableaupb.FieldProp{Compare: "MaxLevel"}},
+		{name: "compare-incomparable-types", prop: &tableaupb.FieldProp{Compare: "<Begin"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CompileRules(context.Background(), fd, "MinLevel", tt.prop)
+			require.Error(t, err)
+		})
+	}
+}
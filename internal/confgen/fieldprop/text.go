@@ -0,0 +1,134 @@
+package fieldprop
+
+import (
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"sync"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RequireText checks whether the field's text property is set explicitly.
+func RequireText(prop *tableaupb.FieldProp) bool {
+	return prop.GetText()
+}
+
+// TextTable records the localized texts (key -> source text) across sheets,
+// which is safe for concurrent use.
+type TextTable struct {
+	mu    sync.Mutex
+	texts map[string]string // key -> source text
+}
+
+// NewTextTable creates a new empty TextTable.
+func NewTextTable() *TextTable {
+	return &TextTable{
+		texts: make(map[string]string),
+	}
+}
+
+// LocalizeMessage replaces the source texts of string fields with prop "text"
+// in the parsed message recursively by their keys, and adds the source texts
+// to the table. It should be called after all field props (e.g.: compute,
+// rules, and unique_domain) are checked against the source texts.
+func (t *TextTable) LocalizeMessage(msg protoreflect.Message) error {
+	if t == nil {
+		return nil
+	}
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		opts, _ := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		prop := opts.GetProp()
+		switch {
+		case fd.IsMap():
+			valueFd := fd.MapValue()
+			value.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+				if isStructField(valueFd) {
+					err = t.LocalizeMessage(val.Message())
+				} else if RequireText(prop) {
+					if val, err = t.localize(valueFd, val); err == nil {
+						value.Map().Set(key, val)
+					}
+				}
+				return err == nil
+			})
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				if isStructField(fd) {
+					err = t.LocalizeMessage(list.Get(i).Message())
+				} else if RequireText(prop) {
+					var val protoreflect.Value
+					if val, err = t.localize(fd, list.Get(i)); err == nil {
+						list.Set(i, val)
+					}
+				}
+			}
+		default:
+			if isStructField(fd) {
+				err = t.LocalizeMessage(value.Message())
+			} else if RequireText(prop) {
+				if value, err = t.localize(fd, value); err == nil {
+					msg.Set(fd, value)
+				}
+			}
+		}
+		if err != nil {
+			err = xerrors.WrapKV(err,
+				xerrors.KeyPBMessage, string(msg.Descriptor().Name()),
+				xerrors.KeyPBFieldName, string(fd.Name()))
+		}
+		return err == nil
+	})
+	return err
+}
+
+// localize adds the source text to the table, and returns its key as the
+// new value. Empty text is returned as is.
+func (t *TextTable) localize(fd protoreflect.FieldDescriptor, value protoreflect.Value) (protoreflect.Value, error) {
+	if fd.Kind() != protoreflect.StringKind {
+		return value, xerrors.Newf("field type %s is not supported by prop text", fd.Kind())
+	}
+	source := value.String()
+	if source == "" {
+		return value, nil
+	}
+	key := TextKey(fd, source)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if prev, ok := t.texts[key]; ok && prev != source {
+		return value, xerrors.E2052(key, source, prev)
+	}
+	t.texts[key] = source
+	return protoreflect.ValueOfString(key), nil
+}
+
+// Texts returns a copy of the recorded texts: key -> source text.
+func (t *TextTable) Texts() map[string]string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return maps.Clone(t.texts)
+}
+
+// TextKey returns the stable key of the source text, which is the name of
+// the top-level message (worksheet) containing the field and the FNV-1a hash
+// of the source text, e.g.: "ItemConf_af63bd4c8601b7df". So the same text in
+// one worksheet shares one key, and the key is changed only if the text is
+// changed.
+func TextKey(fd protoreflect.FieldDescriptor, source string) string {
+	var md protoreflect.Descriptor = fd.ContainingMessage()
+	for {
+		parent, ok := md.Parent().(protoreflect.MessageDescriptor)
+		if !ok {
+			break
+		}
+		md = parent
+	}
+	h := fnv.New64a()
+	h.Write([]byte(source))
+	return fmt.Sprintf("%s_%016x", md.Name(), h.Sum64())
+}
@@ -0,0 +1,88 @@
+package fieldprop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto/protoc"
+	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestTextKey(t *testing.T) {
+	activityFd := (&unittestpb.ActivityConf_Activity{}).ProtoReflect().Descriptor().Fields().ByName("activity_name")
+	sectionFd := (&unittestpb.ActivityConf_Activity_Chapter_Section{}).ProtoReflect().Descriptor().Fields().ByName("section_name")
+
+	key := TextKey(activityFd, "新手活动")
+	assert.Regexp(t, `^ActivityConf_[0-9a-f]{16}$`, key)
+	// stable
+	assert.Equal(t, key, TextKey(activityFd, "新手活动"))
+	// same text in the same worksheet shares one key
+	assert.Equal(t, key, TextKey(sectionFd, "新手活动"))
+	// different text
+	assert.NotEqual(t, key, TextKey(activityFd, "新手活动2"))
+	// different worksheet
+	nameFd := (&unittestpb.ValidateConf{}).ProtoReflect().Descriptor().Fields().ByName("name")
+	assert.Regexp(t, `^ValidateConf_[0-9a-f]{16}$`, TextKey(nameFd, "新手活动"))
+}
+
+func TestTextTable_LocalizeMessage(t *testing.T) {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	desc, err := prFiles.FindDescriptorByName("fieldproptest.EventConf")
+	require.NoError(t, err)
+	md := desc.(protoreflect.MessageDescriptor)
+	eventMd := md.Fields().ByName("event_list").Message()
+	titleFd := eventMd.Fields().ByName("title")
+	newMessage := func() protoreflect.Message {
+		msg := dynamicpb.NewMessage(md)
+		list := msg.Mutable(md.Fields().ByName("event_list")).List()
+		for _, texts := range [][]string{{"新手活动", "开始", "新手活动"}, {"", "", "备注"}} {
+			event := dynamicpb.NewMessage(eventMd)
+			event.Set(titleFd, protoreflect.ValueOfString(texts[0]))
+			tips := event.Mutable(eventMd.Fields().ByName("tip_list")).List()
+			tips.Append(protoreflect.ValueOfString(texts[1]))
+			event.Set(eventMd.Fields().ByName("remark"), protoreflect.ValueOfString(texts[2]))
+			list.Append(protoreflect.ValueOfMessage(event))
+		}
+		return msg
+	}
+	key := func(source string) string {
+		return TextKey(titleFd, source)
+	}
+
+	t.Run("localized", func(t *testing.T) {
+		table := NewTextTable()
+		msg := newMessage()
+		require.NoError(t, table.LocalizeMessage(msg))
+		list := msg.Get(md.Fields().ByName("event_list")).List()
+		first, second := list.Get(0).Message(), list.Get(1).Message()
+		assert.Equal(t, key("新手活动"), first.Get(titleFd).String())
+		assert.Equal(t, key("开始"), first.Get(eventMd.Fields().ByName("tip_list")).List().Get(0).String())
+		// field without prop text
+		assert.Equal(t, "新手活动", first.Get(eventMd.Fields().ByName("remark")).String())
+		// empty text
+		assert.Equal(t, "", second.Get(titleFd).String())
+		assert.Equal(t, "", second.Get(eventMd.Fields().ByName("tip_list")).List().Get(0).String())
+		assert.Equal(t, map[string]string{key("新手活动"): "新手活动", key("开始"): "开始"}, table.Texts())
+	})
+
+	t.Run("key-collision", func(t *testing.T) {
+		table := NewTextTable()
+		table.texts[key("新手活动")] = "老手活动"
+		err := table.LocalizeMessage(newMessage())
+		require.ErrorIs(t, err, xerrors.ErrE2052)
+		assert.Contains(t, err.Error(), "老手活动")
+	})
+
+	t.Run("nil-table", func(t *testing.T) {
+		var table *TextTable
+		msg := newMessage()
+		require.NoError(t, table.LocalizeMessage(msg))
+		first := msg.Get(md.Fields().ByName("event_list")).List().Get(0).Message()
+		assert.Equal(t, "新手活动", first.Get(titleFd).String())
+	})
+}
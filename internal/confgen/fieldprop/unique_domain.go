@@ -0,0 +1,166 @@
+package fieldprop
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/tableauio/tableau/internal/types"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RequireUniqueDomain checks whether the field's unique_domain property is
+// set explicitly.
+func RequireUniqueDomain(prop *tableaupb.FieldProp) bool {
+	return prop.GetUniqueDomain() != ""
+}
+
+// UniqueDomains records the values of named uniqueness domains across
+// sheets, which is safe for concurrent use.
+type UniqueDomains struct {
+	mu      sync.Mutex
+	domains map[string]map[string][]string // domain -> value -> locations
+}
+
+// NewUniqueDomains creates a new empty UniqueDomains.
+func NewUniqueDomains() *UniqueDomains {
+	return &UniqueDomains{
+		domains: make(map[string]map[string][]string),
+	}
+}
+
+// DuplicateValue is a value which exists in more than one location of a
+// uniqueness domain.
+type DuplicateValue struct {
+	Domain    string
+	Value     string
+	Locations []string // sorted
+}
+
+// AddMessage adds the values of all fields (including nested fields) with
+// prop unique_domain in the parsed message to their uniqueness domains.
+// Values of a map field are its keys, and values of a list field are its
+// elements. The location is the source of the message, e.g.:
+// "Reward.xlsx#Reward", which is suffixed with the field path of each value,
+// e.g.: "Reward.xlsx#Reward:reward_map[1001]".
+//
+// NOTE: the parsed message is checked instead of cells, as the same map key
+// or field value may span multiple rows.
+func (d *UniqueDomains) AddMessage(msg protoreflect.Message, location string) {
+	if d == nil {
+		return
+	}
+	d.addMessage(msg, location+":")
+}
+
+func (d *UniqueDomains) addMessage(msg protoreflect.Message, prefix string) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		opts, _ := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		prop := opts.GetProp()
+		path := prefix + string(fd.Name())
+		switch {
+		case fd.IsMap():
+			value.Map().Range(func(key protoreflect.MapKey, val protoreflect.Value) bool {
+				keyStr := FormatReferValue(fd.MapKey(), key.Value())
+				elemPath := fmt.Sprintf("%s[%s]", path, keyStr)
+				if RequireUniqueDomain(prop) {
+					d.add(prop.GetUniqueDomain(), keyStr, elemPath)
+				}
+				if isStructField(fd.MapValue()) {
+					d.addMessage(val.Message(), elemPath+".")
+				}
+				return true
+			})
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				elemPath := fmt.Sprintf("%s[%d]", path, i)
+				if isStructField(fd) {
+					d.addMessage(list.Get(i).Message(), elemPath+".")
+				} else if RequireUniqueDomain(prop) {
+					d.add(prop.GetUniqueDomain(), FormatReferValue(fd, list.Get(i)), elemPath)
+				}
+			}
+		default:
+			if isStructField(fd) {
+				d.addMessage(value.Message(), path+".")
+			} else if RequireUniqueDomain(prop) {
+				d.add(prop.GetUniqueDomain(), FormatReferValue(fd, value), path)
+			}
+		}
+		return true
+	})
+}
+
+// isStructField reports whether the field (or map value) is a message but
+// not a well-known type, which is parsed from one cell.
+func isStructField(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && !types.IsWellKnownMessage(fd.Message().FullName())
+}
+
+func (d *UniqueDomains) add(domain, value, location string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	values := d.domains[domain]
+	if values == nil {
+		values = make(map[string][]string)
+		d.domains[domain] = values
+	}
+	values[value] = append(values[value], location)
+}
+
+// Duplicates returns the values which exist in more than one location,
+// sorted by domain and value, so the result is deterministic regardless of
+// the order in which sheets are parsed.
+func (d *UniqueDomains) Duplicates() []*DuplicateValue {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var duplicates []*DuplicateValue
+	for _, domain := range slices.Sorted(maps.Keys(d.domains)) {
+		values := d.domains[domain]
+		for _, value := range slices.Sorted(maps.Keys(values)) {
+			if locations := values[value]; len(locations) > 1 {
+				duplicates = append(duplicates, &DuplicateValue{
+					Domain:    domain,
+					Value:     value,
+					Locations: slices.Sorted(slices.Values(locations)),
+				})
+			}
+		}
+	}
+	return duplicates
+}
+
+// RequireUniqueDomains reports whether any field (including nested fields)
+// of the message has prop unique_domain.
+func RequireUniqueDomains(md protoreflect.MessageDescriptor) bool {
+	return requireUniqueDomains(md, map[protoreflect.FullName]bool{})
+}
+
+func requireUniqueDomains(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if visited[md.FullName()] {
+		return false
+	}
+	visited[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		opts, _ := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		if RequireUniqueDomain(opts.GetProp()) {
+			return true
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if isStructField(fd) && requireUniqueDomains(fd.Message(), visited) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package fieldprop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniqueDomains_Duplicates(t *testing.T) {
+	domains := NewUniqueDomains()
+	// added in any order, e.g.: sheets are parsed concurrently
+	domains.add("ItemID", "1", "B.xlsx#Item:item_map[1]")
+	domains.add("ItemID", "2", "B.xlsx#Item:item_map[2]")
+	domains.add("ItemID", "1", "A.xlsx#Item:item_map[1]")
+	domains.add("RewardID", "1", "A.xlsx#Reward:reward_list[0].id")
+	domains.add("RewardID", "1", "A.xlsx#Reward:reward_list[1].id")
+	domains.add("Other", "1", "C.xlsx#Other:id")
+
+	assert.Equal(t, []*DuplicateValue{
+		{Domain: "ItemID", Value: "1", Locations: []string{"A.xlsx#Item:item_map[1]", "B.xlsx#Item:item_map[1]"}},
+		{Domain: "RewardID", Value: "1", Locations: []string{"A.xlsx#Reward:reward_list[0].id", "A.xlsx#Reward:reward_list[1].id"}},
+	}, domains.Duplicates())
+
+	// nil domains are not checked
+	var nilDomains *UniqueDomains
+	assert.Nil(t, nilDomains.Duplicates())
+}
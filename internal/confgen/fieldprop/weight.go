@@ -0,0 +1,232 @@
+package fieldprop
+
+import (
+	"context"
+	"math"
+	"math/bits"
+	"strconv"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RequireWeight checks whether the field's weight property is set explicitly.
+func RequireWeight(prop *tableaupb.FieldProp) bool {
+	return prop.GetWeight() != nil
+}
+
+// CheckWeight checks whether the weight value is not negative.
+func CheckWeight(fd protoreflect.FieldDescriptor, value protoreflect.Value) error {
+	if !isWeightKind(fd.Kind()) {
+		return xerrors.Newf("field type %s is not supported by prop weight", xproto.GetFieldTypeName(fd))
+	}
+	switch fd.Kind() {
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if v := value.Float(); v < 0 {
+			return xerrors.E2040(formatWeight(v))
+		}
+	default:
+		if v := value.Int(); v < 0 {
+			return xerrors.E2040(strconv.FormatInt(v, 10))
+		}
+	}
+	return nil
+}
+
+// ComputeWeights checks the total of weights of field fd (named name by option
+// "name") over elems, which are the list elements or map values in order. The
+// cumulative weights and normalized probabilities are stored into the sibling
+// fields specified by prop weight.
+func ComputeWeights(ctx context.Context, fd protoreflect.FieldDescriptor, name string, prop *tableaupb.FieldProp, elems []protoreflect.Message) error {
+	if !isWeightKind(fd.Kind()) {
+		return xerrors.Newf("field type %s is not supported by prop weight", xproto.GetFieldTypeName(fd))
+	}
+	md := fd.ContainingMessage()
+	cumulativeFd, err := findWeightField(ctx, md, prop.GetWeight().GetCumulative())
+	if err != nil {
+		return err
+	}
+	probabilityFd, err := findWeightField(ctx, md, prop.GetWeight().GetProbability())
+	if err != nil {
+		return err
+	}
+	if probabilityFd != nil && probabilityFd.Kind() != protoreflect.FloatKind && probabilityFd.Kind() != protoreflect.DoubleKind {
+		return xerrors.Newf("probability column %q should be float or double", prop.GetWeight().GetProbability())
+	}
+	cumulatives, err := accumulateWeights(fd, elems)
+	if err != nil {
+		return err
+	}
+	var total weight
+	if len(cumulatives) != 0 {
+		total = cumulatives[len(cumulatives)-1]
+	}
+	want := prop.GetWeight().GetTotal()
+	if !total.positive() {
+		return xerrors.E2041(name, total.String(), "")
+	}
+	if want != 0 && !total.equal(want) {
+		return xerrors.E2041(name, total.String(), formatWeight(want))
+	}
+	for i, elem := range elems {
+		if cumulativeFd != nil {
+			value, err := weightValue(cumulativeFd, cumulatives[i])
+			if err != nil {
+				return err
+			}
+			elem.Set(cumulativeFd, value)
+		}
+		if probabilityFd != nil {
+			prob := weightOf(fd, elem.Get(fd)).float() / total.float()
+			value, err := weightValue(probabilityFd, weight{f: prob, isFloat: true})
+			if err != nil {
+				return err
+			}
+			elem.Set(probabilityFd, value)
+		}
+	}
+	return nil
+}
+
+// findWeightField finds the sibling field referred by column name in prop
+// weight, which should be a singular numeric field. It returns nil if column
+// is empty.
+func findWeightField(ctx context.Context, md protoreflect.MessageDescriptor, column string) (protoreflect.FieldDescriptor, error) {
+	if column == "" {
+		return nil, nil
+	}
+	fd, _ := findFieldByName(ctx, md, column)
+	if fd == nil {
+		return nil, xerrors.Newf("column %q not found in message %s", column, md.FullName())
+	}
+	if fd.IsList() || fd.IsMap() || !isWeightKind(fd.Kind()) {
+		return nil, xerrors.Newf("column %q should be a singular integer or floating-point field", column)
+	}
+	return fd, nil
+}
+
+func isWeightKind(kind protoreflect.Kind) bool {
+	return isNumericKind(kind) && kind != protoreflect.BoolKind && kind != protoreflect.EnumKind
+}
+
+// weight is an integer or floating-point weight. Integer weights are
+// accumulated in uint64 exactly, as float64 loses precision above 2^53.
+type weight struct {
+	u       uint64  // integer weight, which is not negative
+	f       float64 // floating-point weight
+	isFloat bool
+}
+
+func weightOf(fd protoreflect.FieldDescriptor, value protoreflect.Value) weight {
+	switch fd.Kind() {
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return weight{u: value.Uint()}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return weight{f: value.Float(), isFloat: true}
+	default:
+		// negative weights are rejected by CheckWeight
+		return weight{u: uint64(max(value.Int(), 0))}
+	}
+}
+
+// accumulateWeights returns the cumulative weights of field fd over elems,
+// and the last one is the total.
+func accumulateWeights(fd protoreflect.FieldDescriptor, elems []protoreflect.Message) ([]weight, error) {
+	cumulatives := make([]weight, 0, len(elems))
+	var cumulative weight
+	for _, elem := range elems {
+		w := weightOf(fd, elem.Get(fd))
+		if w.isFloat {
+			cumulative = weight{f: cumulative.f + w.f, isFloat: true}
+		} else {
+			sum, carry := bits.Add64(cumulative.u, w.u, 0)
+			if carry != 0 {
+				return nil, xerrors.Newf("total weight of field %s overflows uint64", fd.FullName())
+			}
+			cumulative = weight{u: sum}
+		}
+		cumulatives = append(cumulatives, cumulative)
+	}
+	return cumulatives, nil
+}
+
+func (w weight) float() float64 {
+	if w.isFloat {
+		return w.f
+	}
+	return float64(w.u)
+}
+
+func (w weight) positive() bool {
+	if w.isFloat {
+		return w.f > 0
+	}
+	return w.u > 0
+}
+
+// equal reports whether the weight equals to the required total, tolerating
+// the rounding errors of floating-point weights, e.g.: 33.3+33.3+33.4.
+func (w weight) equal(total float64) bool {
+	if !w.isFloat {
+		return total >= 0 && total < math.MaxUint64 && total == math.Trunc(total) && uint64(total) == w.u
+	}
+	return math.Abs(w.f-total) <= 1e-9*math.Max(1, math.Max(math.Abs(w.f), math.Abs(total)))
+}
+
+func (w weight) String() string {
+	if w.isFloat {
+		return formatWeight(w.f)
+	}
+	return strconv.FormatUint(w.u, 10)
+}
+
+// weightValue converts the weight to the value of field fd, and returns an
+// error if the weight overflows the field type.
+func weightValue(fd protoreflect.FieldDescriptor, w weight) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(w.float())), nil
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(w.float()), nil
+	}
+	u := w.u
+	if w.isFloat {
+		f := math.Round(w.f)
+		if f >= math.MaxUint64 {
+			return protoreflect.Value{}, newWeightOverflowError(fd, w)
+		}
+		u = uint64(f)
+	}
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if u > math.MaxInt32 {
+			return protoreflect.Value{}, newWeightOverflowError(fd, w)
+		}
+		return protoreflect.ValueOfInt32(int32(u)), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if u > math.MaxUint32 {
+			return protoreflect.Value{}, newWeightOverflowError(fd, w)
+		}
+		return protoreflect.ValueOfUint32(uint32(u)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(u), nil
+	default:
+		if u > math.MaxInt64 {
+			return protoreflect.Value{}, newWeightOverflowError(fd, w)
+		}
+		return protoreflect.ValueOfInt64(int64(u)), nil
+	}
+}
+
+func newWeightOverflowError(fd protoreflect.FieldDescriptor, w weight) error {
+	return xerrors.Newf("weight %s overflows field %s of type %s", w, fd.FullName(), xproto.GetFieldTypeName(fd))
+}
+
+func formatWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'f', -1, 64)
+}
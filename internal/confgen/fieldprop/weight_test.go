@@ -0,0 +1,119 @@
+package fieldprop
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto/protoc"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestCheckWeight(t *testing.T) {
+	md := findDropMessage(t)
+	weightFd := md.Fields().ByName("weight")
+	percentFd := md.Fields().ByName("percent")
+	noteFd := md.Fields().ByName("note")
+	bigWeightFd := md.Fields().ByName("big_weight")
+	require.NoError(t, CheckWeight(weightFd, protoreflect.ValueOfUint32(0)))
+	require.NoError(t, CheckWeight(percentFd, protoreflect.ValueOfFloat64(12.5)))
+	require.ErrorIs(t, CheckWeight(percentFd, protoreflect.ValueOfFloat64(-0.5)), xerrors.ErrE2040)
+	require.ErrorIs(t, CheckWeight(bigWeightFd, protoreflect.ValueOfInt64(-1)), xerrors.ErrE2040)
+	require.Error(t, CheckWeight(noteFd, protoreflect.ValueOfString("1")))
+}
+
+func TestComputeWeights(t *testing.T) {
+	md := findDropMessage(t)
+	newElems := func(field string, values ...protoreflect.Value) []protoreflect.Message {
+		var elems []protoreflect.Message
+		for _, v := range values {
+			msg := dynamicpb.NewMessage(md)
+			msg.Set(md.Fields().ByName(protoreflect.Name(field)), v)
+			elems = append(elems, msg)
+		}
+		return elems
+	}
+	propOf := func(field string) *tableaupb.FieldProp {
+		opts, _ := proto.GetExtension(md.Fields().ByName(protoreflect.Name(field)).Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		return opts.GetProp()
+	}
+
+	t.Run("cumulative-and-probability", func(t *testing.T) {
+		elems := newElems("weight", protoreflect.ValueOfUint32(1), protoreflect.ValueOfUint32(0), protoreflect.ValueOfUint32(3))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("weight"), "Weight", propOf("weight"), elems)
+		require.NoError(t, err)
+		var cumWeights []uint64
+		var probs []float64
+		for _, elem := range elems {
+			cumWeights = append(cumWeights, elem.Get(md.Fields().ByName("cum_weight")).Uint())
+			probs = append(probs, elem.Get(md.Fields().ByName("prob")).Float())
+		}
+		assert.Equal(t, []uint64{1, 1, 4}, cumWeights)
+		assert.Equal(t, []float64{0.25, 0, 0.75}, probs)
+	})
+	t.Run("zero-total", func(t *testing.T) {
+		elems := newElems("weight", protoreflect.ValueOfUint32(0), protoreflect.ValueOfUint32(0))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("weight"), "Weight", propOf("weight"), elems)
+		require.ErrorIs(t, err, xerrors.ErrE2041)
+	})
+	t.Run("empty", func(t *testing.T) {
+		err := ComputeWeights(context.Background(), md.Fields().ByName("weight"), "Weight", propOf("weight"), nil)
+		require.ErrorIs(t, err, xerrors.ErrE2041)
+	})
+	t.Run("integer-accumulation", func(t *testing.T) {
+		// 1<<53+1 can not be represented exactly by float64
+		elems := newElems("big_weight", protoreflect.ValueOfInt64(1<<53+1), protoreflect.ValueOfInt64(2))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("big_weight"), "BigWeight", propOf("big_weight"), elems)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1<<53+1), elems[0].Get(md.Fields().ByName("big_cum_weight")).Int())
+		assert.Equal(t, int64(1<<53+3), elems[1].Get(md.Fields().ByName("big_cum_weight")).Int())
+	})
+	t.Run("integer-overflow", func(t *testing.T) {
+		elems := newElems("big_weight", protoreflect.ValueOfInt64(math.MaxInt64), protoreflect.ValueOfInt64(math.MaxInt64), protoreflect.ValueOfInt64(math.MaxInt64))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("big_weight"), "BigWeight", propOf("big_weight"), elems)
+		require.Error(t, err)
+	})
+	t.Run("cumulative-uint32-overflow", func(t *testing.T) {
+		elems := newElems("weight", protoreflect.ValueOfUint32(math.MaxUint32), protoreflect.ValueOfUint32(1))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("weight"), "Weight", propOf("weight"), elems)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "4294967296")
+	})
+	t.Run("cumulative-int64-overflow", func(t *testing.T) {
+		// the total fits in uint64, but the cumulative weight overflows int64
+		elems := newElems("big_weight", protoreflect.ValueOfInt64(math.MaxInt64), protoreflect.ValueOfInt64(1))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("big_weight"), "BigWeight", propOf("big_weight"), elems)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "9223372036854775808")
+	})
+	t.Run("exact-total", func(t *testing.T) {
+		elems := newElems("percent", protoreflect.ValueOfFloat64(33.3), protoreflect.ValueOfFloat64(33.3), protoreflect.ValueOfFloat64(33.4))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("percent"), "Percent", propOf("percent"), elems)
+		require.NoError(t, err)
+	})
+	t.Run("total-mismatch", func(t *testing.T) {
+		elems := newElems("percent", protoreflect.ValueOfFloat64(50), protoreflect.ValueOfFloat64(40))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("percent"), "Percent", propOf("percent"), elems)
+		require.ErrorIs(t, err, xerrors.ErrE2041)
+		assert.Contains(t, err.Error(), "100")
+	})
+	t.Run("unsupported-type", func(t *testing.T) {
+		elems := newElems("note", protoreflect.ValueOfString("1"))
+		err := ComputeWeights(context.Background(), md.Fields().ByName("note"), "Note", propOf("note"), elems)
+		require.Error(t, err)
+	})
+}
+
+func findDropMessage(t *testing.T) protoreflect.MessageDescriptor {
+	prFiles, err := protoc.NewFiles([]string{"testdata/proto"}, []string{"testdata/proto/*.proto"})
+	require.NoError(t, err)
+	desc, err := prFiles.FindDescriptorByName("fieldproptest.DropConf.Drop")
+	require.NoError(t, err)
+	return desc.(protoreflect.MessageDescriptor)
+}
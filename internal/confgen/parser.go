@@ -11,6 +11,7 @@ import (
 
 	"buf.build/go/protovalidate"
 	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/buildcache"
 	"github.com/tableauio/tableau/internal/confgen/fieldprop"
 	"github.com/tableauio/tableau/internal/importer"
 	"github.com/tableauio/tableau/internal/importer/book"
@@ -18,6 +19,7 @@ import (
 	"github.com/tableauio/tableau/internal/x/xerrors"
 	"github.com/tableauio/tableau/internal/x/xfs"
 	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/log"
 	"github.com/tableauio/tableau/options"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -34,6 +36,7 @@ type sheetExporter struct {
 	OutputOpt *options.ConfOutputOption // output settings.
 	validator protovalidate.Validator   // validator with extension type resolver.
 	collector *xerrors.Collector        // concurrent error collector shared from Generator.
+	outputs   *buildcache.Outputs       // output files recorded for build cache, nil if not enabled.
 }
 
 // NewSheetExporter creates a new sheet exporter.
@@ -77,6 +80,7 @@ func (x *sheetExporter) ScatterAndExport(info *SheetInfo,
 	if err != nil {
 		return err
 	}
+	x.recordOutputs(mainMsg, mainName)
 
 	g := x.collector.NewGroup(context.Background())
 	for _, impInfo := range impInfos {
@@ -95,10 +99,18 @@ func (x *sheetExporter) ScatterAndExport(info *SheetInfo,
 					}
 					msg = clonedMainMsg
 				} else {
-					return storePatchMergeMessage(msg, name, info.LocationName, x.OutputDir, x.OutputOpt)
+					if err := storePatchMergeMessage(msg, name, info.LocationName, x.OutputDir, x.OutputOpt); err != nil {
+						return err
+					}
+					x.recordOutputs(msg, name)
+					return nil
 				}
 			}
-			return storeMessage(msg, name, info.LocationName, x.OutputDir, x.OutputOpt, x.validator)
+			if err := storeMessage(msg, name, info.LocationName, x.OutputDir, x.OutputOpt, x.validator); err != nil {
+				return err
+			}
+			x.recordOutputs(msg, name)
+			return nil
 		})
 	}
 	return g.Wait()
@@ -126,7 +138,23 @@ func (x *sheetExporter) MergeAndExport(info *SheetInfo,
 		return filename
 	}
 	name := getExportedConfName(info, mainImpInfo)
-	return storeMessage(protomsg, name, info.LocationName, x.OutputDir, x.OutputOpt, x.validator)
+	if err := storeMessage(protomsg, name, info.LocationName, x.OutputDir, x.OutputOpt, x.validator); err != nil {
+		return err
+	}
+	x.recordOutputs(protomsg, name)
+	return nil
+}
+
+// recordOutputs records the output files of the stored message for build
+// cache.
+func (x *sheetExporter) recordOutputs(msg proto.Message, name string) {
+	if x.outputs == nil {
+		return
+	}
+	outputDir := filepath.Join(x.OutputDir, x.OutputOpt.Subdir)
+	for _, outputFormat := range parseOutputFormats(msg, x.OutputOpt) {
+		x.outputs.Add(filepath.Join(outputDir, name+format.Format2Ext(outputFormat)))
+	}
 }
 
 type oneMsg struct {
@@ -220,19 +248,43 @@ func parseMessageFromOneImporter(info *SheetInfo, collector *xerrors.Collector,
 		err := xerrors.E0001(sheetName, bookName)
 		return nil, xerrors.WrapKV(err, xerrors.KeyBookName, bookName, xerrors.KeySheetName, sheetName, xerrors.KeyPBMessage, string(info.MD.Name()))
 	}
+	bookName := getRelBookName(info.ExtInfo.InputDir, impInfo.Filename())
+	protomsg, err := parseSheet(info, collector, sheet, bookName)
+	if err != nil {
+		return nil, xerrors.WrapKV(err,
+			xerrors.KeyModule, xerrors.ModuleConf,
+			xerrors.KeyBookName, bookName,
+			xerrors.KeySheetName, sheetName,
+			xerrors.KeyPBMessage, string(info.MD.Name()))
+	}
+	return protomsg, nil
+}
+
+// ParseSheet parses the worksheet into a new message of info.MD, and
+// collects at most maxErrorsPerSheet errors into a child of collector.
+func ParseSheet(info *SheetInfo, collector *xerrors.Collector, sheet *book.Sheet) (proto.Message, error) {
+	return parseSheet(info, collector, sheet, info.PrimaryBookName)
+}
+
+// parseSheet parses the worksheet of the workbook bookName, which may be a
+// scatter or merger workbook of the primary workbook.
+func parseSheet(info *SheetInfo, collector *xerrors.Collector, sheet *book.Sheet, bookName string) (proto.Message, error) {
 	parser := NewExtendedSheetParser(context.Background(), info.ProtoPackage, info.LocationName, info.BookOpts, info.SheetOpts, info.ExtInfo)
 	// Overwrite the default single-error collector (set by NewExtendedSheetParser for
 	// fail-fast use) with a child collector scoped to this sheet and capped at
 	// maxErrorsPerSheet, so one sheet cannot exhaust the parent book-level collector.
 	parser.sheetCollector = collector.NewChild(maxErrorsPerSheet)
-	bookName := getRelBookName(info.ExtInfo.InputDir, impInfo.Filename())
 	protomsg := dynamicpb.NewMessage(info.MD)
 	if err := parser.Parse(protomsg, sheet); err != nil {
-		return nil, xerrors.WrapKV(err,
-			xerrors.KeyModule, xerrors.ModuleConf,
-			xerrors.KeyBookName, bookName,
-			xerrors.KeySheetName, sheetName,
-			xerrors.KeyPBMessage, string(info.MD.Name()))
+		return nil, err
+	}
+	if info.ExtInfo != nil {
+		// source of the parsed sheet, e.g.: "Reward.xlsx#Reward"
+		info.ExtInfo.UniqueDomains.AddMessage(protomsg, bookName+"#"+sheet.Name)
+		// replace localized texts by their keys after all field props are checked
+		if err := info.ExtInfo.Texts.LocalizeMessage(protomsg); err != nil {
+			return nil, err
+		}
 	}
 	return protomsg, nil
 }
@@ -275,6 +327,10 @@ type sheetParser struct {
 
 	// cached maps and lists with cardinality
 	cards map[string]*cardInfo // map/list field card prefix -> cardInfo
+	// cached fields with cross-field rules of messages, in field declaration order
+	ruleFields map[protoreflect.FullName][]*ruleField
+	// cached computed fields of messages, in field declaration order
+	computedFields map[protoreflect.FullName][]*computedField
 }
 
 type cardInfo struct {
@@ -284,6 +340,10 @@ type cardInfo struct {
 	sequenceFields map[string]*sequenceField
 	// option field name -> orderField
 	orderFields map[string]*orderField
+	// option field name -> disjointField
+	disjointFields map[string]*disjointField
+	// option field name -> weight field descriptor
+	weightFields map[string]protoreflect.FieldDescriptor
 }
 
 type uniqueField struct {
@@ -293,6 +353,18 @@ type uniqueField struct {
 	optional bool
 }
 
+type ruleField struct {
+	name  string // option field name
+	rules []*fieldprop.Rule
+}
+
+type computedField struct {
+	name     string // option field name
+	fd       protoreflect.FieldDescriptor
+	prop     *tableaupb.FieldProp
+	computed *fieldprop.ComputedField
+}
+
 type sequenceField struct {
 	fd         protoreflect.FieldDescriptor
 	sequence   int64
@@ -305,6 +377,11 @@ type orderField struct {
 	currValue protoreflect.Value
 }
 
+type disjointField struct {
+	fd     protoreflect.FieldDescriptor
+	values []protoreflect.Value // previous interval values
+}
+
 // SheetParserExtInfo is the extended info for refer check and so on.
 type SheetParserExtInfo struct {
 	InputDir       string
@@ -312,6 +389,12 @@ type SheetParserExtInfo struct {
 	PRFiles        *protoregistry.Files
 	BookFormat     format.Format // workbook format
 	DryRun         options.DryRun
+	// UniqueDomains records values of uniqueness domains across sheets, and
+	// nil means the field prop "unique_domain" is not checked.
+	UniqueDomains *fieldprop.UniqueDomains
+	// Texts records localized texts across sheets, and nil means the source
+	// texts of fields with prop "text" are not localized.
+	Texts *fieldprop.TextTable
 }
 
 // NewSheetParser creates a new sheet parser.
@@ -339,6 +422,8 @@ func NewExtendedSheetParser(ctx context.Context, protoPackage, locationName stri
 // reset resets the runtime data of sheet parser for reuse
 func (p *sheetParser) reset() {
 	p.cards = map[string]*cardInfo{}
+	p.ruleFields = map[protoreflect.FullName][]*ruleField{}
+	p.computedFields = map[protoreflect.FullName][]*computedField{}
 }
 
 // GetSep returns sheet-level separator.
@@ -398,10 +483,203 @@ func (p *sheetParser) Parse(protomsg proto.Message, sheet *book.Sheet) (err erro
 	}()
 	if sheet.Document != nil {
 		docParser := &documentParser{sheetParser: p}
-		return docParser.Parse(protomsg, sheet)
+		err = docParser.Parse(protomsg, sheet)
+	} else {
+		tableParser := &tableParser{sheetParser: p}
+		err = tableParser.Parse(protomsg, sheet)
+	}
+	if err != nil {
+		return err
+	}
+	if err := p.computeWeights(protomsg.ProtoReflect()); err != nil {
+		return err
+	}
+	if err := p.checkCompositeRefers(protomsg.ProtoReflect()); err != nil {
+		return err
+	}
+	p.warnDeprecatedFields(protomsg.ProtoReflect(), map[protoreflect.FullName]bool{})
+	return nil
+}
+
+// computeFields evaluates the CEL expressions of computed fields (with field
+// prop "compute") of the parsed message, and checks the field props (e.g.:
+// range and refer) of the computed values. It returns the field name (option
+// "name") of the failed field. It should be called on every present message
+// the parser finishes before checking the cross-field rules and the sub-field
+// props (e.g.: unique and order) of the message, so nested messages are
+// computed before the enclosing message, which can refer to the computed
+// fields of nested messages.
+func (p *sheetParser) computeFields(msg protoreflect.Message) (string, error) {
+	md := msg.Descriptor()
+	fields, ok := p.computedFields[md.FullName()]
+	if !ok {
+		// compile computed fields of message only once, and cache it for later use
+		for i := 0; i < md.Fields().Len(); i++ {
+			field := p.parseFieldDescriptor(md.Fields().Get(i))
+			name, prop := field.opts.GetName(), field.opts.GetProp()
+			if fieldprop.RequireCompute(prop) {
+				computed, err := fieldprop.CompileCompute(field.fd, prop)
+				if err != nil {
+					field.release()
+					return name, err
+				}
+				fields = append(fields, &computedField{name: name, fd: field.fd, prop: prop, computed: computed})
+			}
+			field.release()
+		}
+		p.computedFields[md.FullName()] = fields
+	}
+	for _, field := range fields {
+		if err := field.computed.Compute(msg); err != nil {
+			return field.name, err
+		}
+		value := msg.Get(field.fd)
+		if err := p.checkFieldValue(field.fd, value, true, fieldprop.FormatReferValue(field.fd, value), field.prop); err != nil {
+			return field.name, err
+		}
+	}
+	return "", nil
+}
+
+// computeWeights checks the total weights (with field prop "weight") of list
+// elements and map values recursively, and stores the cumulative weights and
+// normalized probabilities. Map values are weighted in ascending key order.
+// Empty lists and maps are also checked, as their total weight is 0.
+func (p *sheetParser) computeWeights(msg protoreflect.Message) error {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		var elemMd protoreflect.MessageDescriptor
+		var elems []protoreflect.Message
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			elemMd = fd.Message()
+			list := msg.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				elems = append(elems, list.Get(j).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Kind() == protoreflect.MessageKind:
+			elemMd = fd.MapValue().Message()
+			m := msg.Get(fd).Map()
+			for _, key := range xproto.SortedMapKeys(m) {
+				elems = append(elems, m.Get(key).Message())
+			}
+		case !fd.IsList() && !fd.IsMap() && fd.Kind() == protoreflect.MessageKind:
+			if msg.Has(fd) {
+				if err := p.computeWeights(msg.Get(fd).Message()); err != nil {
+					return err
+				}
+			}
+			continue
+		default:
+			continue
+		}
+		for _, elem := range elems {
+			if err := p.computeWeights(elem); err != nil {
+				return err
+			}
+		}
+		elemFields := elemMd.Fields()
+		for j := 0; j < elemFields.Len(); j++ {
+			subFd := elemFields.Get(j)
+			opts, _ := proto.GetExtension(subFd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+			if !fieldprop.RequireWeight(opts.GetProp()) {
+				continue
+			}
+			if err := fieldprop.ComputeWeights(p.ctx, subFd, opts.GetName(), opts.GetProp(), elems); err != nil {
+				return xerrors.WrapKV(err,
+					xerrors.KeyPBMessage, string(msg.Descriptor().Name()),
+					xerrors.KeyPBFieldName, string(fd.Name()))
+			}
+		}
+	}
+	return nil
+}
+
+// checkCompositeRefers checks the composite key refers (e.g.:
+// "Item.(ID,Quality)") of struct fields recursively. The composite key is
+// formed by the struct's field values in declaration order.
+func (p *sheetParser) checkCompositeRefers(msg protoreflect.Message) error {
+	// NOTE: if use NewSheetParser, sp.extInfo is nil, which means SheetParserExtInfo is not provided.
+	if p.extInfo == nil {
+		return nil
 	}
-	tableParser := &tableParser{sheetParser: p}
-	return tableParser.Parse(protomsg, sheet)
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		valueFd := fd
+		if fd.IsMap() {
+			valueFd = fd.MapValue()
+		}
+		if valueFd.Kind() != protoreflect.MessageKind || types.IsWellKnownMessage(valueFd.Message().FullName()) {
+			return true
+		}
+		opts, _ := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		prop := opts.GetProp()
+		check := func(elem protoreflect.Message) error {
+			if fieldprop.RequireCompositeRefer(prop) {
+				input := &fieldprop.Input{
+					ProtoPackage:   p.ProtoPackage,
+					InputDir:       p.extInfo.InputDir,
+					SubdirRewrites: p.extInfo.SubdirRewrites,
+					PRFiles:        p.extInfo.PRFiles,
+					Present:        true,
+				}
+				ok, tuple, err := fieldprop.InReferredTupleSpace(p.ctx, prop, elem, input)
+				if err == nil && !ok {
+					err = xerrors.E2002(tuple, prop.GetRefer())
+				}
+				if err != nil {
+					return xerrors.WrapKV(err,
+						xerrors.KeyPBMessage, string(msg.Descriptor().Name()),
+						xerrors.KeyPBFieldName, string(fd.Name()))
+				}
+			}
+			return p.checkCompositeRefers(elem)
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = check(list.Get(i).Message())
+			}
+		case fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				err = check(v.Message())
+				return err == nil
+			})
+		default:
+			err = check(v.Message())
+		}
+		return err == nil
+	})
+	return err
+}
+
+// warnDeprecatedFields emits a warning (only once per field) if a deprecated
+// field still contains non-default data.
+func (p *sheetParser) warnDeprecatedFields(msg protoreflect.Message, warned map[protoreflect.FullName]bool) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Options().(*descriptorpb.FieldOptions).GetDeprecated() && !warned[fd.FullName()] {
+			warned[fd.FullName()] = true
+			log.Warnf("%s#%s: deprecated field %s still contains non-default data, please clear the column data before deleting it",
+				p.bookOpts.GetName(), p.sheetOpts.GetName(), fd.FullName())
+		}
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				p.warnDeprecatedFields(list.Get(i).Message(), warned)
+			}
+		case fd.IsMap() && fd.MapValue().Kind() == protoreflect.MessageKind:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				p.warnDeprecatedFields(v.Message(), warned)
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Kind() == protoreflect.MessageKind:
+			p.warnDeprecatedFields(v.Message(), warned)
+		}
+		return true
+	})
 }
 
 func (p *sheetParser) parseIncellMap(field *Field, reflectMap protoreflect.Map, cellData string) (err error) {
@@ -669,8 +947,8 @@ func (p *sheetParser) checkKeySequence(md protoreflect.MessageDescriptor, fdOpts
 }
 
 // checkSubFieldProp checks whether the map value's or list element's sub-field value
-// meets its unique or sequence conditions. The uniqueness check ignores optional
-// fields with default value.
+// meets its unique, sequence, order, disjoint or weight conditions. The uniqueness
+// check ignores optional fields with default value.
 //
 // If an error occured, it will return the field option name which fails the condition.
 //
@@ -700,6 +978,8 @@ func (p *sheetParser) checkSubFieldProp(field *Field, cardPrefix string, newValu
 			uniqueFields:   map[string]*uniqueField{},
 			sequenceFields: map[string]*sequenceField{},
 			orderFields:    map[string]*orderField{},
+			disjointFields: map[string]*disjointField{},
+			weightFields:   map[string]protoreflect.FieldDescriptor{},
 		}
 		for i := 0; i < md.Fields().Len(); i++ {
 			fd := md.Fields().Get(i)
@@ -707,11 +987,11 @@ func (p *sheetParser) checkSubFieldProp(field *Field, cardPrefix string, newValu
 			subField.mergeParentFieldProp(field)
 			defer subField.release()
 			name := subField.opts.GetName()
+			prop := subField.opts.GetProp()
 			if name == field.opts.GetKey() {
 				// key field not checked
 				continue
 			}
-			prop := subField.opts.GetProp()
 			if fieldprop.RequireUnique(prop) {
 				info.uniqueFields[name] = &uniqueField{
 					fd:       subField.fd,
@@ -731,6 +1011,14 @@ func (p *sheetParser) checkSubFieldProp(field *Field, cardPrefix string, newValu
 					order: prop.GetOrder(),
 				}
 			}
+			if fieldprop.RequireDisjoint(prop) {
+				info.disjointFields[name] = &disjointField{
+					fd: subField.fd,
+				}
+			}
+			if fieldprop.RequireWeight(prop) {
+				info.weightFields[name] = subField.fd
+			}
 		}
 		// add new unique value
 		p.cards[cardPrefix] = info
@@ -766,6 +1054,57 @@ func (p *sheetParser) checkSubFieldProp(field *Field, cardPrefix string, newValu
 		}
 		field.currValue = val
 	}
+	for name, field := range info.disjointFields {
+		if !newValue.Message().Has(field.fd) {
+			// ignore intervals not present
+			continue
+		}
+		val := newValue.Message().Get(field.fd)
+		if err := fieldprop.CheckDisjoint(field.fd, field.values, val); err != nil {
+			return name, err
+		}
+		field.values = append(field.values, val)
+	}
+	for name, fd := range info.weightFields {
+		if err := fieldprop.CheckWeight(fd, newValue.Message().Get(fd)); err != nil {
+			return name, err
+		}
+	}
+	return "", nil
+}
+
+// checkRules checks the cross-field rules (field props required_if,
+// exclusive_with, and compare) of the parsed message, and returns the field
+// name (option "name") of the broken rule. It should be called on every
+// present message the parser finishes: top-level messages, struct fields,
+// map values, and list elements.
+func (p *sheetParser) checkRules(msg protoreflect.Message) (string, error) {
+	md := msg.Descriptor()
+	fields, ok := p.ruleFields[md.FullName()]
+	if !ok {
+		// compile rules of message only once, and cache it for later use
+		for i := 0; i < md.Fields().Len(); i++ {
+			field := p.parseFieldDescriptor(md.Fields().Get(i))
+			name, prop := field.opts.GetName(), field.opts.GetProp()
+			if fieldprop.RequireRules(prop) {
+				rules, err := fieldprop.CompileRules(p.ctx, field.fd, name, prop)
+				if err != nil {
+					field.release()
+					return name, err
+				}
+				fields = append(fields, &ruleField{name: name, rules: rules})
+			}
+			field.release()
+		}
+		p.ruleFields[md.FullName()] = fields
+	}
+	for _, field := range fields {
+		for _, rule := range field.rules {
+			if err := rule.Check(msg); err != nil {
+				return field.name, err
+			}
+		}
+	}
 	return "", nil
 }
 
@@ -827,6 +1166,16 @@ func (p *sheetParser) parseIncellList(field *Field, list protoreflect.List, card
 				}
 			}
 		}
+		if fieldprop.RequireDisjoint(fdopts.Prop) {
+			// check overlapped intervals for incell interval list
+			prevValues := make([]protoreflect.Value, 0, list.Len())
+			for j := range list.Len() {
+				prevValues = append(prevValues, list.Get(j))
+			}
+			if err := fieldprop.CheckDisjoint(fd, prevValues, elemValue); err != nil {
+				return err
+			}
+		}
 		// In addition to the key-based dedup above, validate sub-field
 		// props (unique / sequence / order) on the new element. For scalar or
 		// non-message lists, checkSubFieldProp early-returns and is a no-op.
@@ -881,6 +1230,14 @@ func (p *sheetParser) parseIncellStruct(field *Field, structValue protoreflect.V
 				return false, err
 			}
 		}
+		if present {
+			if _, err := p.computeFields(structValue.Message()); err != nil {
+				return false, err
+			}
+			if _, err := p.checkRules(structValue.Message()); err != nil {
+				return false, err
+			}
+		}
 		return present, nil
 	}
 }
@@ -954,36 +1311,43 @@ func (p *sheetParser) parseFieldValue(fd protoreflect.FieldDescriptor, rawValue
 		return v, present, err
 	}
 
-	if fprop != nil {
-		// check presence
-		if err := fieldprop.CheckPresence(fprop, present); err != nil {
-			return v, present, err
-		}
-		// check range
-		if err := fieldprop.CheckInRange(fprop, fd.Kind(), v, present); err != nil {
-			return v, present, err
-		}
-		// check refer
-		// NOTE: if use NewSheetParser, sp.extInfo is nil, which means SheetParserExtInfo is not provided.
-		if fprop.Refer != "" && p.extInfo != nil {
-			input := &fieldprop.Input{
-				ProtoPackage:   p.ProtoPackage,
-				InputDir:       p.extInfo.InputDir,
-				SubdirRewrites: p.extInfo.SubdirRewrites,
-				PRFiles:        p.extInfo.PRFiles,
-				Present:        present,
-			}
-			ok, err := fieldprop.InReferredSpace(p.ctx, fprop, rawValue, input)
-			if err != nil {
-				return v, present, err
-			}
-			if !ok {
-				return v, present, xerrors.E2002(rawValue, fprop.Refer)
-			}
+	return v, present, p.checkFieldValue(fd, v, present, rawValue, fprop)
+}
+
+// checkFieldValue checks the parsed (or computed) field value by
+// [tableaupb.FieldProp]: presence, range, and refer. The refer is checked
+// by refValue, which is the raw cell value if parsed.
+func (p *sheetParser) checkFieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, present bool, refValue string, fprop *tableaupb.FieldProp) error {
+	if fprop == nil {
+		return nil
+	}
+	// check presence
+	if err := fieldprop.CheckPresence(fprop, present); err != nil {
+		return err
+	}
+	// check range
+	if err := fieldprop.CheckInRange(fprop, fd.Kind(), v, present); err != nil {
+		return err
+	}
+	// check refer
+	// NOTE: if use NewSheetParser, sp.extInfo is nil, which means SheetParserExtInfo is not provided.
+	if fprop.Refer != "" && p.extInfo != nil {
+		input := &fieldprop.Input{
+			ProtoPackage:   p.ProtoPackage,
+			InputDir:       p.extInfo.InputDir,
+			SubdirRewrites: p.extInfo.SubdirRewrites,
+			PRFiles:        p.extInfo.PRFiles,
+			Present:        present,
+		}
+		ok, err := fieldprop.InReferredSpace(p.ctx, fprop, refValue, input)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return xerrors.E2002(refValue, fprop.Refer)
 		}
 	}
-
-	return v, present, err
+	return nil
 }
 
 func (p *sheetParser) findFieldByName(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
@@ -0,0 +1,250 @@
+package confgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// referenceReport is the reverse reference report, which lists the values
+// of each referred target not referred by any field.
+type referenceReport struct {
+	Targets []*referenceTarget `json:"targets"`
+}
+
+// referenceTarget is a referred target, e.g.: "ItemConf.ID".
+type referenceTarget struct {
+	Target       string   `json:"target"`
+	Workbook     string   `json:"workbook"`
+	Worksheet    string   `json:"worksheet"`
+	ReferencedBy []string `json:"referencedBy"` // referrer fields, e.g.: "HeroConf.Hero.item_id"
+	Total        int      `json:"total"`        // count of values in the target's value space
+	Unreferenced []string `json:"unreferenced"`
+
+	refers []string // raw refer texts, which key the referred cache
+}
+
+// referenceReportEnabled checks whether the reverse reference report
+// should be generated.
+func (gen *Generator) referenceReportEnabled() bool {
+	return gen.OutputOpt != nil && gen.OutputOpt.ReferenceReport != ""
+}
+
+// collectReferenceTargets collects the referred targets of all fields
+// with prop "refer" in messages of the proto package, sorted by target.
+func (gen *Generator) collectReferenceTargets(prFiles *protoregistry.Files) ([]*referenceTarget, error) {
+	targets := map[string]*referenceTarget{}
+	var walk func(md protoreflect.MessageDescriptor) error
+	walk = func(md protoreflect.MessageDescriptor) error {
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			opts, _ := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+			refer := opts.GetProp().GetRefer()
+			if strings.TrimSpace(refer) == "" {
+				continue
+			}
+			referrer := strings.TrimPrefix(string(fd.FullName()), gen.ProtoPackage+".")
+			for _, text := range fieldprop.SplitRefers(refer) {
+				referInfo, err := fieldprop.ParseRefer(text)
+				if err != nil {
+					return xerrors.WrapKV(err, xerrors.KeyPBMessage, string(md.Name()), xerrors.KeyPBFieldName, string(fd.Name()))
+				}
+				target := targets[referInfo.Target()]
+				if target == nil {
+					target = &referenceTarget{Target: referInfo.Target()}
+					targets[target.Target] = target
+					if err := gen.setReferenceTargetSheet(prFiles, target, referInfo); err != nil {
+						return err
+					}
+				}
+				if !slices.Contains(target.refers, text) {
+					target.refers = append(target.refers, text)
+				}
+				if !slices.Contains(target.ReferencedBy, referrer) {
+					target.ReferencedBy = append(target.ReferencedBy, referrer)
+				}
+			}
+		}
+		msgs := md.Messages()
+		for i := 0; i < msgs.Len(); i++ {
+			if err := walk(msgs.Get(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var err error
+	prFiles.RangeFilesByPackage(
+		protoreflect.FullName(gen.ProtoPackage),
+		func(fd protoreflect.FileDescriptor) bool {
+			msgs := fd.Messages()
+			for i := 0; i < msgs.Len(); i++ {
+				if err = walk(msgs.Get(i)); err != nil {
+					return false
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*referenceTarget, 0, len(targets))
+	for _, target := range targets {
+		sort.Strings(target.ReferencedBy)
+		result = append(result, target)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Target < result[j].Target
+	})
+	return result, nil
+}
+
+// setReferenceTargetSheet sets the workbook and worksheet name of the
+// referred message.
+func (gen *Generator) setReferenceTargetSheet(prFiles *protoregistry.Files, target *referenceTarget, referInfo *fieldprop.ReferDesc) error {
+	fullName := protoreflect.FullName(gen.ProtoPackage + "." + referInfo.GetMessageName())
+	desc, err := prFiles.FindDescriptorByName(fullName)
+	if err != nil {
+		return xerrors.E2001(target.Target, referInfo.GetMessageName())
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return xerrors.E2001(target.Target, referInfo.GetMessageName())
+	}
+	_, workbook := ParseFileOptions(md.ParentFile())
+	_, worksheet := ParseMessageOptions(md)
+	target.Workbook = workbook.GetName()
+	target.Worksheet = worksheet.GetName()
+	return nil
+}
+
+// newReferenceReport builds the reverse reference report from the value
+// spaces and referenced values recorded by refer checks.
+func (gen *Generator) newReferenceReport(prFiles *protoregistry.Files) (*referenceReport, error) {
+	targets, err := gen.collectReferenceTargets(prFiles)
+	if err != nil {
+		return nil, err
+	}
+	input := &fieldprop.Input{
+		ProtoPackage:   gen.ProtoPackage,
+		InputDir:       gen.InputDir,
+		SubdirRewrites: gen.InputOpt.SubdirRewrites,
+		PRFiles:        prFiles,
+	}
+	report := &referenceReport{Targets: []*referenceTarget{}}
+	for _, target := range targets {
+		if gen.referenceAllowed(target.Target, "") {
+			continue
+		}
+		values := map[string]bool{} // value -> referenced
+		for _, refer := range target.refers {
+			valueSpace, err := fieldprop.ReferredValueSpace(gen.ctx, refer, input)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range valueSpace.Values() {
+				if _, ok := values[v.(string)]; !ok {
+					values[v.(string)] = false
+				}
+			}
+			if referenced := fieldprop.ReferencedValues(refer); referenced != nil {
+				for _, v := range referenced.Values() {
+					values[v.(string)] = true
+				}
+			}
+		}
+		target.Total = len(values)
+		target.Unreferenced = []string{}
+		for value, referenced := range values {
+			if referenced || value == "" || gen.referenceAllowed(target.Target, value) {
+				continue
+			}
+			target.Unreferenced = append(target.Unreferenced, value)
+		}
+		sort.Strings(target.Unreferenced)
+		report.Targets = append(report.Targets, target)
+	}
+	return report, nil
+}
+
+// referenceAllowed checks whether the target (if value is empty) or the
+// value of target matches any pattern in the reference allowlist.
+func (gen *Generator) referenceAllowed(target, value string) bool {
+	name := target
+	if value != "" {
+		name = target + ":" + value
+	}
+	for _, pattern := range gen.OutputOpt.ReferenceAllowlist {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// writeReferenceReport writes the reverse reference report if the report
+// path is specified.
+func (gen *Generator) writeReferenceReport(prFiles *protoregistry.Files) error {
+	if !gen.referenceReportEnabled() {
+		return nil
+	}
+	report, err := gen.newReferenceReport(prFiles)
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to build reference report")
+	}
+	var data []byte
+	reportPath := gen.OutputOpt.ReferenceReport
+	if strings.EqualFold(filepath.Ext(reportPath), ".json") {
+		data, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to marshal reference report")
+		}
+		data = append(data, '\n')
+	} else {
+		data = []byte(report.String())
+	}
+	if err := os.MkdirAll(filepath.Dir(reportPath), 0755); err != nil {
+		return xerrors.Wrapf(err, "failed to create dir of reference report: %s", reportPath)
+	}
+	if err := os.WriteFile(reportPath, data, 0644); err != nil {
+		return xerrors.Wrapf(err, "failed to write reference report: %s", reportPath)
+	}
+	unreferenced := 0
+	for _, target := range report.Targets {
+		unreferenced += len(target.Unreferenced)
+	}
+	log.Infof("%15s: %d unreferenced value(s) of %d target(s), see %s", "reference report", unreferenced, len(report.Targets), reportPath)
+	return nil
+}
+
+// String returns the plain text report, e.g.:
+//
+//	ItemConf.ID (Item.xlsx#Item): 2 of 100 unreferenced
+//	  referenced by: HeroConf.Hero.item_id
+//	  - 1003
+//	  - 1004
+func (r *referenceReport) String() string {
+	var sb strings.Builder
+	for _, target := range r.Targets {
+		fmt.Fprintf(&sb, "%s (%s#%s): %d of %d unreferenced\n", target.Target, target.Workbook, target.Worksheet, len(target.Unreferenced), target.Total)
+		fmt.Fprintf(&sb, "  referenced by: %s\n", strings.Join(target.ReferencedBy, ", "))
+		for _, value := range target.Unreferenced {
+			fmt.Fprintf(&sb, "  - %s\n", value)
+		}
+	}
+	return sb.String()
+}
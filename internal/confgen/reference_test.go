@@ -0,0 +1,109 @@
+package confgen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/options"
+)
+
+func newReferenceReportGenerator(t *testing.T, reportPath string, allowlist []string) *Generator {
+	const protoDir = "./testdata/cache/proto"
+	return NewGenerator("cachetest", "./testdata/cache/csv", t.TempDir(),
+		options.Conf(
+			&options.ConfOption{
+				Input: &options.ConfInputOption{
+					ProtoPaths: []string{protoDir},
+					ProtoFiles: []string{protoDir + "/*.proto"},
+					Formats:    []format.Format{format.CSV},
+				},
+				Output: &options.ConfOutputOption{
+					Formats:            []format.Format{format.JSON},
+					ReferenceReport:    reportPath,
+					ReferenceAllowlist: allowlist,
+				},
+			},
+		),
+		options.Cache(&options.CacheOption{Enable: true, Dir: t.TempDir()}),
+	)
+}
+
+func TestGenerator_ReferenceReport(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "report", "reference.json")
+	gen := newReferenceReportGenerator(t, reportPath, nil)
+	require.NoError(t, gen.Generate())
+	// build cache is disabled
+	assert.Nil(t, gen.cache)
+
+	data, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	var report referenceReport
+	require.NoError(t, json.Unmarshal(data, &report))
+	require.Len(t, report.Targets, 1)
+	target := report.Targets[0]
+	assert.Equal(t, "ItemConf.ID", target.Target)
+	assert.Equal(t, "Item#*.csv", target.Workbook)
+	assert.Equal(t, "ItemConf", target.Worksheet)
+	assert.Equal(t, []string{"HeroConf.Hero.item_id"}, target.ReferencedBy)
+	assert.Equal(t, 3, target.Total)
+	assert.Equal(t, []string{"2"}, target.Unreferenced)
+}
+
+func TestGenerator_ReferenceReport_text(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "reference.txt")
+	gen := newReferenceReportGenerator(t, reportPath, nil)
+	require.NoError(t, gen.Generate())
+
+	data, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	want := "ItemConf.ID (Item#*.csv#ItemConf): 1 of 3 unreferenced\n" +
+		"  referenced by: HeroConf.Hero.item_id\n" +
+		"  - 2\n"
+	assert.Equal(t, want, string(data))
+}
+
+func TestGenerator_ReferenceReport_allowlist(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowlist []string
+		want      []*referenceTarget
+	}{
+		{
+			name:      "allow-value",
+			allowlist: []string{"ItemConf.ID:2"},
+			want: []*referenceTarget{
+				{
+					Target:       "ItemConf.ID",
+					Workbook:     "Item#*.csv",
+					Worksheet:    "ItemConf",
+					ReferencedBy: []string{"HeroConf.Hero.item_id"},
+					Total:        3,
+					Unreferenced: []string{},
+				},
+			},
+		},
+		{
+			name:      "allow-target",
+			allowlist: []string{"Item*.ID"},
+			want:      []*referenceTarget{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportPath := filepath.Join(t.TempDir(), "reference.json")
+			gen := newReferenceReportGenerator(t, reportPath, tt.allowlist)
+			require.NoError(t, gen.Generate())
+
+			data, err := os.ReadFile(reportPath)
+			require.NoError(t, err)
+			var report referenceReport
+			require.NoError(t, json.Unmarshal(data, &report))
+			assert.Equal(t, tt.want, report.Targets)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package confgen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+)
+
+// reportSheet is a worksheet converted by the generator, which is a test case
+// in the JUnit report.
+type reportSheet struct {
+	book    string // workbook name
+	sheet   string // worksheet name
+	message string // protobuf message name, the key of PerfStats
+}
+
+// addReportSheet records a converted worksheet for the JUnit report.
+func (gen *Generator) addReportSheet(book, sheet, message string) {
+	gen.reportMu.Lock()
+	defer gen.reportMu.Unlock()
+	gen.reportSheets = append(gen.reportSheets, &reportSheet{book: book, sheet: sheet, message: message})
+}
+
+// JUnit XML report, see https://github.com/testmoapp/junitxml
+type junitTestSuites struct {
+	XMLName    xml.Name          `xml:"testsuites"`
+	Name       string            `xml:"name,attr"`
+	Tests      int               `xml:"tests,attr"`
+	Failures   int               `xml:"failures,attr"`
+	Time       string            `xml:"time,attr"`
+	TestSuites []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Time      string           `xml:"time,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+
+	milliseconds int64
+}
+
+type junitTestCase struct {
+	Name      string          `xml:"name,attr"`
+	ClassName string          `xml:"classname,attr"`
+	Time      string          `xml:"time,attr"`
+	Failures  []*junitFailure `xml:"failure"`
+
+	milliseconds int64
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// newJUnitReport builds the JUnit report: each workbook is a test suite and
+// each worksheet is a test case. The failures are the errors (nil means no
+// errors) collected by the generator, and the timings come from PerfStats.
+//
+// Errors not related to any worksheet are reported as failures of a test
+// case named after the workbook, or after the proto package if not related
+// to any workbook.
+func (gen *Generator) newJUnitReport(err error) *junitTestSuites {
+	report := &junitTestSuites{Name: gen.ProtoPackage}
+	suites := map[string]*junitTestSuite{}
+	cases := map[[2]string]*junitTestCase{}
+	getCase := func(book, sheet string) *junitTestCase {
+		if tc := cases[[2]string{book, sheet}]; tc != nil {
+			return tc
+		}
+		suite := suites[book]
+		if suite == nil {
+			suite = &junitTestSuite{Name: book}
+			suites[book] = suite
+			report.TestSuites = append(report.TestSuites, suite)
+		}
+		tc := &junitTestCase{Name: sheet, ClassName: book}
+		cases[[2]string{book, sheet}] = tc
+		suite.TestCases = append(suite.TestCases, tc)
+		return tc
+	}
+
+	gen.reportMu.Lock()
+	sheets := gen.reportSheets
+	gen.reportMu.Unlock()
+	for _, rs := range sheets {
+		tc := getCase(rs.book, rs.sheet)
+		// NOTE: one sheet may be generated to multiple messages.
+		if value, ok := gen.PerfStats.Load(rs.message); ok {
+			tc.milliseconds += value.(int64)
+		}
+	}
+	for _, diag := range xerrors.NewDesc(err).Diagnostics() {
+		book, sheet := diag.Book, diag.Sheet
+		if book == "" {
+			book = gen.ProtoPackage
+		}
+		if sheet == "" {
+			sheet = book
+		}
+		tc := getCase(book, sheet)
+		tc.Failures = append(tc.Failures, newJUnitFailure(diag))
+	}
+
+	var milliseconds int64
+	for _, suite := range report.TestSuites {
+		for _, tc := range suite.TestCases {
+			suite.Tests++
+			if len(tc.Failures) != 0 {
+				suite.Failures++
+			}
+			suite.milliseconds += tc.milliseconds
+			tc.Time = formatSeconds(tc.milliseconds)
+		}
+		suite.Time = formatSeconds(suite.milliseconds)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		milliseconds += suite.milliseconds
+	}
+	report.Time = formatSeconds(milliseconds)
+	return report
+}
+
+// newJUnitFailure converts a diagnostic to a JUnit failure, typed with the
+// error code and located by the cell position.
+func newJUnitFailure(diag *xerrors.Diagnostic) *junitFailure {
+	var locs []string
+	if diag.CellPos != "" {
+		locs = append(locs, diag.CellPos)
+	}
+	if diag.Column != "" {
+		locs = append(locs, diag.Column)
+	}
+	message := diag.Reason
+	if message == "" {
+		message = strings.TrimSpace(diag.Message)
+	}
+	if diag.Code != "" {
+		message = fmt.Sprintf("%s: %s", diag.Code, message)
+	}
+	if len(locs) != 0 {
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(locs, ", "))
+	}
+	return &junitFailure{
+		Message: message,
+		Type:    diag.Code,
+		Text:    diag.Message,
+	}
+}
+
+func formatSeconds(milliseconds int64) string {
+	return fmt.Sprintf("%.3f", float64(milliseconds)/1000)
+}
+
+// writeJUnitReport writes the JUnit report if the report path is specified.
+func (gen *Generator) writeJUnitReport(err error) error {
+	if gen.OutputOpt == nil || gen.OutputOpt.JUnitReport == "" {
+		return nil
+	}
+	data, merr := xml.MarshalIndent(gen.newJUnitReport(err), "", "  ")
+	if merr != nil {
+		return xerrors.Wrapf(merr, "failed to marshal JUnit report")
+	}
+	path := gen.OutputOpt.JUnitReport
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return xerrors.Wrapf(err, "failed to create dir of JUnit report: %s", path)
+	}
+	data = append([]byte(xml.Header), append(data, '\n')...)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return xerrors.Wrapf(err, "failed to write JUnit report: %s", path)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package confgen
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/options"
+)
+
+func TestGenerator_JUnitReport(t *testing.T) {
+	const protoDir = "./testdata/cache/proto"
+	indir := t.TempDir()
+	outdir := t.TempDir()
+	reportPath := filepath.Join(t.TempDir(), "report", "junit.xml")
+	require.NoError(t, os.CopyFS(indir, os.DirFS("./testdata/cache/csv")))
+	// invalid uint32 value of SkillConf
+	skillData := "ID,Name\nuint32,string\nSkill's ID,Skill's name\n-1,Fire\n"
+	require.NoError(t, os.WriteFile(filepath.Join(indir, "Skill#SkillConf.csv"), []byte(skillData), 0644))
+
+	gen := NewGenerator("cachetest", indir, outdir,
+		options.Conf(
+			&options.ConfOption{
+				Input: &options.ConfInputOption{
+					ProtoPaths: []string{protoDir},
+					ProtoFiles: []string{protoDir + "/*.proto"},
+					Formats:    []format.Format{format.CSV},
+				},
+				Output: &options.ConfOutputOption{
+					Formats:     []format.Format{format.JSON},
+					JUnitReport: reportPath,
+				},
+			},
+		),
+	)
+	require.Error(t, gen.Generate())
+
+	data, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	var report junitTestSuites
+	require.NoError(t, xml.Unmarshal(data, &report))
+	assert.Equal(t, "cachetest", report.Name)
+	assert.Equal(t, 3, report.Tests)
+	assert.Equal(t, 1, report.Failures)
+	require.Len(t, report.TestSuites, 3)
+	suites := map[string]*junitTestSuite{}
+	for _, suite := range report.TestSuites {
+		require.Len(t, suite.TestCases, 1)
+		suites[suite.Name] = suite
+	}
+	for _, name := range []string{"Item#*.csv", "Hero#*.csv"} {
+		require.Contains(t, suites, name)
+		assert.Equal(t, 0, suites[name].Failures)
+		assert.Empty(t, suites[name].TestCases[0].Failures)
+	}
+	require.Contains(t, suites, "Skill#*.csv")
+	tc := suites["Skill#*.csv"].TestCases[0]
+	assert.Equal(t, "SkillConf", tc.Name)
+	assert.Equal(t, "Skill#*.csv", tc.ClassName)
+	require.Len(t, tc.Failures, 1)
+	assert.NotEmpty(t, tc.Failures[0].Type)
+	assert.Contains(t, tc.Failures[0].Message, "A4")
+	assert.Contains(t, tc.Failures[0].Text, "-1")
+}
@@ -97,6 +97,14 @@ func (p *tableParser) parseMessage(parentField *Field, msg protoreflect.Message,
 	if messageCollector.HasErrors() {
 		return false, messageCollector.Join()
 	}
+	if present {
+		if name, err := p.computeFields(msg); err != nil {
+			return false, xerrors.WrapKV(err, r.CellDebugKV(prefix+name)...)
+		}
+		if name, err := p.checkRules(msg); err != nil {
+			return false, xerrors.WrapKV(err, r.CellDebugKV(prefix+name)...)
+		}
+	}
 	return present, nil
 }
 
@@ -504,7 +512,7 @@ func (p *tableParser) parseHorizontalListField(field *Field, msg protoreflect.Me
 		newCardPrefix := cardPrefix + "." + strconv.Itoa(list.Len())
 		var cell *book.Cell
 		if field.fd.Kind() == protoreflect.MessageKind {
-			if types.IsWellKnownMessage(field.fd.Message().FullName()) {
+			if types.IsWellKnownMessage(field.fd.Message().FullName()) && !p.isCrossCellVector(field, r, elemPrefix) {
 				// horizontal well-known list
 				if cell, err = r.Cell(elemPrefix, p.IsFieldOptional(field)); err != nil {
 					return false, err
@@ -682,7 +690,7 @@ func (p *tableParser) parseStructField(field *Field, msg protoreflect.Message, r
 
 	var cell *book.Cell
 	newPrefix := prefix + field.opts.Name
-	if types.IsWellKnownMessage(field.fd.Message().FullName()) {
+	if types.IsWellKnownMessage(field.fd.Message().FullName()) && !p.isCrossCellVector(field, r, newPrefix) {
 		// well-known struct
 		if cell, err = r.Cell(newPrefix, p.IsFieldOptional(field)); err != nil {
 			return false, err
@@ -716,6 +724,24 @@ func (p *tableParser) parseStructField(field *Field, msg protoreflect.Message, r
 	return
 }
 
+// isCrossCellVector reports whether the well-known vector field is laid out
+// in multiple cells, e.g.: columns "PosX", "PosY", and "PosZ" instead of one
+// column "Pos". Vectors were parsed as ordinary structs before they became
+// well-known types, so this layout is still parsed as a cross-cell struct.
+func (p *tableParser) isCrossCellVector(field *Field, r *book.Row, name string) bool {
+	md := field.fd.Message()
+	if !types.IsWellKnownVector(md.FullName()) {
+		return false
+	}
+	if _, err := r.Cell(name, false); err == nil {
+		return false
+	}
+	component := p.parseFieldDescriptor(md.Fields().Get(0))
+	defer component.release()
+	_, err := r.Cell(name+component.opts.Name, false)
+	return err == nil
+}
+
 func (p *tableParser) parseUnionField(field *Field, msg protoreflect.Message, r *book.Row, prefix, cardPrefix string) (present bool, err error) {
 	structValue := msg.NewField(field.fd)
 
@@ -837,6 +863,14 @@ func (p *tableParser) parseScalarField(field *Field, msg protoreflect.Message, r
 	if cell, err = r.Cell(colName, p.IsFieldOptional(field)); err != nil {
 		return false, err
 	}
+	if cell.Data == "" && field.fd.HasPresence() && xproto.GetFieldDefaultValue(field.fd) == "" {
+		// NOTE: empty cell of field with explicit presence is left unpopulated,
+		// so that loaders can tell "missing" from "zero".
+		if err := fieldprop.CheckPresence(field.opts.Prop, false); err != nil {
+			return false, xerrors.WrapKV(err, r.CellDebugKV(colName)...)
+		}
+		return false, nil
+	}
 	newValue, present, err = p.parseFieldValue(field.fd, cell.Data, field.opts.Prop)
 	if err != nil {
 		return false, xerrors.WrapKV(err, r.CellDebugKV(colName)...)
@@ -3,16 +3,20 @@ package confgen
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
 	"github.com/tableauio/tableau/internal/importer/book"
 	"github.com/tableauio/tableau/internal/x/xerrors"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"github.com/tableauio/tableau/proto/tableaupb/internalpb"
 	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func newTableParserForTest() *sheetParser {
@@ -1774,3 +1778,949 @@ func TestTableParser_parseIncellConsistencyWithoutAggregate(t *testing.T) {
 		})
 	}
 }
+
+func TestTableParser_parseExplicitFieldPresence(t *testing.T) {
+	newParser := func() *sheetParser {
+		sheetOpts := book.MetasheetOptions(context.Background())
+		sheetOpts.Optional = true // other columns can be absent
+		return NewExtendedSheetParser(context.Background(), "protoconf", "Asia/Shanghai",
+			book.MetabookOptions(), sheetOpts,
+			&SheetParserExtInfo{
+				SubdirRewrites: map[string]string{},
+				BookFormat:     format.CSV,
+			})
+	}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+	}{
+		{
+			name: "empty-cell-left-unpopulated",
+			sheet: book.NewTableSheet(
+				"PatchMergeConf",
+				[][]string{
+					{"Name", "Name2", "Name3"},
+					{"apple", "", ""},
+				}),
+			want: &unittestpb.PatchMergeConf{
+				Name: "apple",
+			},
+		},
+		{
+			name: "non-empty-cell-populated",
+			sheet: book.NewTableSheet(
+				"PatchMergeConf",
+				[][]string{
+					{"Name", "Name2", "Name3"},
+					{"apple", "", "apple3"},
+				}),
+			want: &unittestpb.PatchMergeConf{
+				Name:  "apple",
+				Name3: proto.String("apple3"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &unittestpb.PatchMergeConf{}
+			err := newParser().Parse(msg, tt.sheet)
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseRules(t *testing.T) {
+	header := []string{"ID", "Type", "ItemID", "EquipID", "LevelMin", "LevelMax"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		msg   proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("RuleConf", [][]string{
+				header,
+				{"1", "1", "1001", "", "1", "10"},
+				{"2", "2", "", "2001", "1", "10"},
+			}),
+			msg: &unittestpb.RuleConf{},
+		},
+		{
+			name: "map-value-required-if",
+			sheet: book.NewTableSheet("RuleConf", [][]string{
+				header,
+				{"1", "1", "1001", "", "1", "10"},
+				{"2", "1", "", "", "1", "10"},
+			}),
+			msg: &unittestpb.RuleConf{},
+			err: xerrors.ErrE2031,
+			pos: "C3",
+		},
+		{
+			name: "map-value-exclusive-with",
+			sheet: book.NewTableSheet("RuleConf", [][]string{
+				header,
+				{"1", "1", "1001", "2001", "1", "10"},
+			}),
+			msg: &unittestpb.RuleConf{},
+			err: xerrors.ErrE2032,
+			pos: "C2",
+		},
+		{
+			name: "top-level-struct-compare",
+			sheet: book.NewTableSheet("RuleConf", [][]string{
+				header,
+				{"1", "1", "1001", "", "11", "10"},
+			}),
+			msg: &unittestpb.RuleConf{},
+			err: xerrors.ErrE2033,
+			pos: "E2",
+		},
+		{
+			name: "kv-sheet-compare",
+			sheet: book.NewTableSheet("RuleKVConf", [][]string{
+				{"MinLevel", "MaxLevel"},
+				{"11", "10"},
+			}),
+			msg: &unittestpb.RuleKVConf{},
+			err: xerrors.ErrE2033,
+			pos: "A2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newTableParserForTest().Parse(tt.msg, tt.sheet)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+		})
+	}
+}
+
+func TestTableParser_parseCompute(t *testing.T) {
+	header := []string{"ID", "Base", "Bonus", "Total", "MaxTotal"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("ComputeConf", [][]string{
+				header,
+				{"1", "10", "5", "", "20"},
+				{"2", "30", "-10", "", ""},
+			}),
+			want: &unittestpb.ComputeConf{
+				ItemMap: map[uint32]*unittestpb.ComputeConf_Item{
+					1: {Id: 1, Base: 10, Bonus: 5, Total: 15, MaxTotal: 20},
+					2: {Id: 2, Base: 30, Bonus: -10, Total: 20},
+				},
+			},
+		},
+		{
+			name: "computed-value-out-of-range",
+			sheet: book.NewTableSheet("ComputeConf", [][]string{
+				header,
+				{"1", "90", "20", "", ""},
+			}),
+			err: xerrors.ErrE2004,
+			pos: "D2",
+		},
+		{
+			name: "computed-value-not-unique",
+			sheet: book.NewTableSheet("ComputeConf", [][]string{
+				header,
+				{"1", "10", "5", "", ""},
+				{"2", "5", "10", "", ""},
+			}),
+			err: xerrors.ErrE2022,
+			pos: "D3",
+		},
+		{
+			name: "rule-on-computed-value",
+			sheet: book.NewTableSheet("ComputeConf", [][]string{
+				header,
+				{"1", "10", "5", "", "12"},
+			}),
+			err: xerrors.ErrE2033,
+			pos: "E2",
+		},
+		{
+			name: "computed-value-overflow",
+			sheet: book.NewTableSheet("ComputeConf", [][]string{
+				header,
+				{"1", "2147483647", "1", "", ""},
+			}),
+			err: xerrors.ErrE2029,
+			pos: "D2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &unittestpb.ComputeConf{}
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseInterval(t *testing.T) {
+	header := []string{"ID", "Level", "Roll", "Window"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("IntervalConf", [][]string{
+				header,
+				{"1", "[1,10)", "[0,0.5];(0.5,1]", "[2024-01-01 00:00:00,2024-02-01 00:00:00)"},
+				{"2", "10~20", "", ""},
+			}),
+			want: &unittestpb.IntervalConf{
+				BracketMap: map[uint32]*unittestpb.IntervalConf_Bracket{
+					1: {
+						Id:    1,
+						Level: &tableaupb.Interval{Lower: 1, Upper: 10, UpperExclusive: true},
+						RollList: []*tableaupb.DoubleInterval{
+							{Lower: 0, Upper: 0.5},
+							{Lower: 0.5, Upper: 1, LowerExclusive: true},
+						},
+						// parsed in location "Asia/Shanghai" (UTC+8)
+						Window: &tableaupb.TimestampInterval{
+							Lower:          timestamppb.New(time.Date(2023, 12, 31, 16, 0, 0, 0, time.UTC)),
+							Upper:          timestamppb.New(time.Date(2024, 1, 31, 16, 0, 0, 0, time.UTC)),
+							UpperExclusive: true,
+						},
+					},
+					2: {
+						Id:    2,
+						Level: &tableaupb.Interval{Lower: 10, Upper: 20},
+					},
+				},
+			},
+		},
+		{
+			name: "lower-greater-than-upper",
+			sheet: book.NewTableSheet("IntervalConf", [][]string{
+				header,
+				{"1", "20~10", "", ""},
+			}),
+			err: xerrors.ErrE2038,
+			pos: "B2",
+		},
+		{
+			name: "overlapped-map-values",
+			sheet: book.NewTableSheet("IntervalConf", [][]string{
+				header,
+				{"1", "1~10", "", ""},
+				{"2", "10~20", "", ""},
+			}),
+			err: xerrors.ErrE2039,
+			pos: "B3",
+		},
+		{
+			name: "overlapped-incell-list-elements",
+			sheet: book.NewTableSheet("IntervalConf", [][]string{
+				header,
+				{"1", "1~10", "[0,0.5];[0.5,1]", ""},
+			}),
+			err: xerrors.ErrE2039,
+			pos: "C2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := dynamicpb.NewMessage((&unittestpb.IntervalConf{}).ProtoReflect().Descriptor())
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseWeights(t *testing.T) {
+	header := []string{"ID", "Weight", "CumWeight", "Prob"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("WeightConf", [][]string{
+				header,
+				{"3", "50", "", ""},
+				{"1", "20", "", ""},
+				{"2", "30", "", ""},
+			}),
+			want: &unittestpb.WeightConf{
+				DropMap: map[uint32]*unittestpb.WeightConf_Drop{
+					1: {Id: 1, Weight: 20, CumWeight: 20, Prob: 0.2},
+					2: {Id: 2, Weight: 30, CumWeight: 50, Prob: 0.3},
+					3: {Id: 3, Weight: 50, CumWeight: 100, Prob: 0.5},
+				},
+			},
+		},
+		{
+			name: "negative-weight",
+			sheet: book.NewTableSheet("WeightConf", [][]string{
+				header,
+				{"1", "110", "", ""},
+				{"2", "-10", "", ""},
+			}),
+			err: xerrors.ErrE2040,
+			pos: "B3",
+		},
+		{
+			name: "total-mismatch",
+			sheet: book.NewTableSheet("WeightConf", [][]string{
+				header,
+				{"1", "20", "", ""},
+				{"2", "30", "", ""},
+			}),
+			err: xerrors.ErrE2041,
+		},
+		{
+			name: "empty",
+			sheet: book.NewTableSheet("WeightConf", [][]string{
+				header,
+				{"", "", "", ""},
+			}),
+			err: xerrors.ErrE2041,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &unittestpb.WeightConf{}
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				if tt.pos != "" {
+					assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				}
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseFlags(t *testing.T) {
+	header := []string{"ID", "Platforms"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("FlagsConf", [][]string{
+				header,
+				{"1", "iOS|Android"},
+				{"2", "PLATFORM_TYPE_IOS, 0"},
+				{"3", ""},
+			}),
+			want: &unittestpb.FlagsConf{
+				ActivityMap: map[uint32]*unittestpb.FlagsConf_Activity{
+					1: {Id: 1, Platforms: 1<<1 | 1<<2},
+					2: {Id: 2, Platforms: 1<<1 | 1<<0},
+					3: {Id: 3},
+				},
+			},
+		},
+		{
+			name: "duplicate-flag",
+			sheet: book.NewTableSheet("FlagsConf", [][]string{
+				header,
+				{"1", "iOS|PLATFORM_TYPE_IOS"},
+			}),
+			err: xerrors.ErrE2042,
+			pos: "B2",
+		},
+		{
+			name: "flag-out-of-bit-range",
+			sheet: book.NewTableSheet("FlagsConf", [][]string{
+				header,
+				{"1", "iOS|Web"},
+			}),
+			err: xerrors.ErrE2043,
+			pos: "B2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &unittestpb.FlagsConf{}
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseSchedule(t *testing.T) {
+	header := []string{"ID", "Reset", "Open"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("ScheduleConf", [][]string{
+				header,
+				{"1", "@daily", "0 5 * * MON"},
+				{"2", "0 0 4 * * *", ""},
+			}),
+			want: &unittestpb.ScheduleConf{
+				EventMap: map[uint32]*unittestpb.ScheduleConf_Event{
+					1: {
+						Id:     1,
+						Reset_: &tableaupb.Schedule{Cron: "0 0 0 * * *", Timezone: "Asia/Shanghai"},
+						Open: &tableaupb.Schedule{
+							Cron:     "0 0 5 * * MON",
+							Timezone: "Asia/Shanghai",
+							Occurrences: []*timestamppb.Timestamp{
+								timestamppb.New(time.Date(2023, 12, 31, 21, 0, 0, 0, time.UTC)),
+								timestamppb.New(time.Date(2024, 1, 7, 21, 0, 0, 0, time.UTC)),
+							},
+						},
+					},
+					2: {
+						Id:     2,
+						Reset_: &tableaupb.Schedule{Cron: "0 0 4 * * *", Timezone: "Asia/Shanghai"},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid-cron",
+			sheet: book.NewTableSheet("ScheduleConf", [][]string{
+				header,
+				{"1", "0 0 25 * * *", ""},
+			}),
+			err: xerrors.ErrE2046,
+			pos: "B2",
+		},
+		{
+			name: "never-occurs",
+			sheet: book.NewTableSheet("ScheduleConf", [][]string{
+				header,
+				{"1", "@daily", "0 0 31 4 *"},
+			}),
+			err: xerrors.ErrE2046,
+			pos: "C2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := dynamicpb.NewMessage((&unittestpb.ScheduleConf{}).ProtoReflect().Descriptor())
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseStringFormat(t *testing.T) {
+	header := []string{"ID", "GUID", "Pattern", "Link"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("StringFormatConf", [][]string{
+				header,
+				{"1", " {6BA7B810-9DAD-11D1-80B4-00C04FD430C8} ", "^(foo|bar) a{1,}$", "HTTPS://Example.com:443/help"},
+				{"2", "", "", ""},
+			}),
+			want: &unittestpb.StringFormatConf{
+				FilterMap: map[uint32]*unittestpb.StringFormatConf_Filter{
+					1: {
+						Id:      1,
+						Guid:    "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+						Pattern: "^(foo|bar) a{1,}$",
+						Link:    "https://example.com/help",
+					},
+					2: {Id: 2},
+				},
+			},
+		},
+		{
+			name: "invalid-uuid",
+			sheet: book.NewTableSheet("StringFormatConf", [][]string{
+				header,
+				{"1", "6ba7b810-9dad", "", ""},
+			}),
+			err: xerrors.ErrE2049,
+			pos: "B2",
+		},
+		{
+			name: "invalid-regexp",
+			sheet: book.NewTableSheet("StringFormatConf", [][]string{
+				header,
+				{"1", "", "(foo", ""},
+			}),
+			err: xerrors.ErrE2050,
+			pos: "C2",
+		},
+		{
+			name: "url-scheme-not-allowed",
+			sheet: book.NewTableSheet("StringFormatConf", [][]string{
+				header,
+				{"1", "", "", "http://example.com/help"},
+			}),
+			err: xerrors.ErrE2051,
+			pos: "D2",
+		},
+		{
+			name: "url-with-spaces",
+			sheet: book.NewTableSheet("StringFormatConf", [][]string{
+				header,
+				{"1", "", "", " https://example.com/help"},
+			}),
+			err: xerrors.ErrE2051,
+			pos: "D2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &unittestpb.StringFormatConf{}
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseVector(t *testing.T) {
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "incell",
+			sheet: book.NewTableSheet("VectorConf", [][]string{
+				{"ID", "Pos", "Point1", "Point2", "Offset"},
+				{"1", "(1.5, 2, 3)", "1,2", "3 4", "(1 2),(3 4)"},
+				{"2", "(-1.5, 0, 100)", "", "", ""},
+			}),
+			want: &unittestpb.VectorConf{
+				SpawnMap: map[uint32]*unittestpb.VectorConf_Spawn{
+					1: {
+						Id:         1,
+						Pos:        &tableaupb.Vector3{X: 1.5, Y: 2, Z: 3},
+						PointList:  []*tableaupb.Vector2I{{X: 1, Y: 2}, {X: 3, Y: 4}},
+						OffsetList: []*tableaupb.Vector2I{{X: 1, Y: 2}, {X: 3, Y: 4}},
+					},
+					2: {
+						Id:  2,
+						Pos: &tableaupb.Vector3{X: -1.5, Z: 100},
+					},
+				},
+			},
+		},
+		{
+			name: "cross-cell",
+			sheet: book.NewTableSheet("VectorConf", [][]string{
+				{"ID", "PosX", "PosY", "PosZ", "Point1X", "Point1Y", "Point2X", "Point2Y", "Offset"},
+				{"1", "1.5", "2", "3", "1", "2", "3", "4", ""},
+			}),
+			want: &unittestpb.VectorConf{
+				SpawnMap: map[uint32]*unittestpb.VectorConf_Spawn{
+					1: {
+						Id:        1,
+						Pos:       &tableaupb.Vector3{X: 1.5, Y: 2, Z: 3},
+						PointList: []*tableaupb.Vector2I{{X: 1, Y: 2}, {X: 3, Y: 4}},
+					},
+				},
+			},
+		},
+		{
+			name: "component-out-of-range",
+			sheet: book.NewTableSheet("VectorConf", [][]string{
+				{"ID", "Pos", "Point1", "Point2", "Offset"},
+				{"1", "(1.5, 2, 300)", "", "", ""},
+			}),
+			want: &unittestpb.VectorConf{},
+			err:  xerrors.ErrE2004,
+			pos:  "B2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// well-known messages are parsed as dynamic messages, which
+			// can not be set into generated messages.
+			msg := dynamicpb.NewMessage(tt.want.ProtoReflect().Descriptor())
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseDecimal(t *testing.T) {
+	header := []string{"ID", "Price"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("DecimalConf", [][]string{
+				header,
+				{"1", "0.1"},
+				{"2", "19.99"},
+				{"3", "19.990"},
+			}),
+			want: &unittestpb.DecimalConf{
+				GoodsMap: map[uint32]*unittestpb.DecimalConf_Goods{
+					1: {Id: 1, Price: &tableaupb.Decimal{Unscaled: 10, Scale: 2}},
+					2: {Id: 2, Price: &tableaupb.Decimal{Unscaled: 1999, Scale: 2}},
+					3: {Id: 3, Price: &tableaupb.Decimal{Unscaled: 1999, Scale: 2}},
+				},
+			},
+		},
+		{
+			name: "invalid-decimal",
+			sheet: book.NewTableSheet("DecimalConf", [][]string{
+				header,
+				{"1", "1.2.3"},
+			}),
+			want: &unittestpb.DecimalConf{},
+			err:  xerrors.ErrE2035,
+			pos:  "B2",
+		},
+		{
+			name: "exceed-scale",
+			sheet: book.NewTableSheet("DecimalConf", [][]string{
+				header,
+				{"1", "19.999"},
+			}),
+			want: &unittestpb.DecimalConf{},
+			err:  xerrors.ErrE2036,
+			pos:  "B2",
+		},
+		{
+			name: "out-of-range",
+			sheet: book.NewTableSheet("DecimalConf", [][]string{
+				header,
+				{"1", "0.00"},
+			}),
+			want: &unittestpb.DecimalConf{},
+			err:  xerrors.ErrE2004,
+			pos:  "B2",
+		},
+		{
+			name: "not-in-ascending-order",
+			sheet: book.NewTableSheet("DecimalConf", [][]string{
+				header,
+				{"1", "19.99"},
+				{"2", "0.1"},
+			}),
+			want: &unittestpb.DecimalConf{},
+			err:  xerrors.ErrE2026,
+			pos:  "B3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// well-known messages are parsed as dynamic messages, which
+			// can not be set into generated messages.
+			msg := dynamicpb.NewMessage(tt.want.ProtoReflect().Descriptor())
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableParser_parseColor(t *testing.T) {
+	header := []string{"ID", "Color", "Palette"}
+	tests := []struct {
+		name  string
+		sheet *book.Sheet
+		want  proto.Message
+		err   error
+		pos   string // cell position
+	}{
+		{
+			name: "valid",
+			sheet: book.NewTableSheet("ColorConf", [][]string{
+				header,
+				{"1", "Orange", "#000,#FFF8"},
+				{"2", "rgba(0, 0, 255, 0.5)", ""},
+				{"3", "transparent", ""},
+			}),
+			want: &unittestpb.ColorConf{
+				ThemeMap: map[uint32]*unittestpb.ColorConf_Theme{
+					1: {
+						Id:    1,
+						Color: &tableaupb.Color{R: 255, G: 165, B: 0, A: 255},
+						Palette: []*tableaupb.Color{
+							{R: 0, G: 0, B: 0, A: 255},
+							{R: 255, G: 255, B: 255, A: 136},
+						},
+					},
+					2: {Id: 2, Color: &tableaupb.Color{R: 0, G: 0, B: 255, A: 128}},
+					3: {Id: 3, Color: &tableaupb.Color{}},
+				},
+			},
+		},
+		{
+			name: "invalid-color",
+			sheet: book.NewTableSheet("ColorConf", [][]string{
+				header,
+				{"1", "#12345", ""},
+			}),
+			want: &unittestpb.ColorConf{},
+			err:  xerrors.ErrE2037,
+			pos:  "B2",
+		},
+		{
+			name: "invalid-incell-color",
+			sheet: book.NewTableSheet("ColorConf", [][]string{
+				header,
+				{"1", "red", "#000,unknown"},
+			}),
+			want: &unittestpb.ColorConf{},
+			err:  xerrors.ErrE2037,
+			pos:  "C2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// well-known messages are parsed as dynamic messages, which
+			// can not be set into generated messages.
+			msg := dynamicpb.NewMessage(tt.want.ProtoReflect().Descriptor())
+			err := newTableParserForTest().Parse(msg, tt.sheet)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				assert.Equal(t, tt.pos, xerrors.NewDesc(err).GetValue(xerrors.KeyDataCellPos))
+				return
+			}
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("sheetParser.Parse() = %v, want %v", msg, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSheet_uniqueDomain(t *testing.T) {
+	type source struct {
+		bookName string
+		sheet    *book.Sheet
+		msg      proto.Message
+	}
+	mapHeader := []string{"RewardID", "Num"}
+	listHeader := []string{"RewardID", "RewardNum"}
+	sources := []source{
+		{
+			bookName: "EventA#*.csv",
+			sheet:    book.NewTableSheet("UniqueDomainMapConf", [][]string{mapHeader, {"1001", "1"}, {"1002", "2"}}),
+			msg:      &unittestpb.UniqueDomainMapConf{},
+		},
+		{
+			bookName: "EventAShard1#*.csv",
+			sheet:    book.NewTableSheet("UniqueDomainMapConf", [][]string{mapHeader, {"1003", "3"}}),
+			msg:      &unittestpb.UniqueDomainMapConf{},
+		},
+		{
+			bookName: "EventAShard2#*.csv",
+			sheet:    book.NewTableSheet("UniqueDomainMapConf", [][]string{mapHeader, {"1003", "4"}}),
+			msg:      &unittestpb.UniqueDomainMapConf{},
+		},
+		{
+			bookName: "EventB#*.csv",
+			sheet:    book.NewTableSheet("UniqueDomainListConf", [][]string{listHeader, {"2001", "1"}, {"2001", "2"}, {"1002", "3"}}),
+			msg:      &unittestpb.UniqueDomainListConf{},
+		},
+	}
+	gen := &Generator{uniqueDomains: fieldprop.NewUniqueDomains()}
+	for _, src := range sources {
+		info := &SheetInfo{
+			ProtoPackage: "protoconf",
+			LocationName: "Asia/Shanghai",
+			MD:           src.msg.ProtoReflect().Descriptor(),
+			BookOpts:     book.MetabookOptions(),
+			SheetOpts:    book.MetasheetOptions(context.Background()),
+			ExtInfo: &SheetParserExtInfo{
+				SubdirRewrites: map[string]string{},
+				BookFormat:     format.CSV,
+				UniqueDomains:  gen.uniqueDomains,
+			},
+		}
+		_, err := parseSheet(info, xerrors.NewCollector(maxErrors), src.sheet, src.bookName)
+		require.NoError(t, err)
+	}
+	err := gen.checkUniqueDomains()
+	require.ErrorIs(t, err, xerrors.ErrE2030)
+	for _, s := range []string{
+		// across workbooks
+		`"1002"`, "EventA#*.csv#UniqueDomainMapConf:reward_map[1002], EventB#*.csv#UniqueDomainListConf:reward_list[2].reward_id",
+		// across scatter workbooks
+		`"1003"`, "EventAShard1#*.csv#UniqueDomainMapConf:reward_map[1003], EventAShard2#*.csv#UniqueDomainMapConf:reward_map[1003]",
+		// in one sheet
+		`"2001"`, "EventB#*.csv#UniqueDomainListConf:reward_list[0].reward_id, EventB#*.csv#UniqueDomainListConf:reward_list[1].reward_id",
+	} {
+		assert.Contains(t, err.Error(), s)
+	}
+}
+
+func TestParseSheet_text(t *testing.T) {
+	fd := (&unittestpb.TextConf_Item{}).ProtoReflect().Descriptor().Fields().ByName("name")
+	key := func(source string) string {
+		return fieldprop.TextKey(fd, source)
+	}
+	sheet := book.NewTableSheet("TextConf", [][]string{
+		{"ID", "Name", "Desc"},
+		{"1", "金币", "通用货币"},
+		{"2", "钻石", "通用货币"},
+		{"3", "体力", ""},
+	})
+	tests := []struct {
+		name      string
+		texts     *fieldprop.TextTable
+		want      proto.Message
+		wantTexts map[string]string
+	}{
+		{
+			name:  "localized",
+			texts: fieldprop.NewTextTable(),
+			want: &unittestpb.TextConf{
+				ItemMap: map[uint32]*unittestpb.TextConf_Item{
+					1: {Id: 1, Name: key("金币"), Desc: key("通用货币")},
+					2: {Id: 2, Name: key("钻石"), Desc: key("通用货币")},
+					3: {Id: 3, Name: key("体力")},
+				},
+			},
+			wantTexts: map[string]string{
+				key("金币"):   "金币",
+				key("钻石"):   "钻石",
+				key("通用货币"): "通用货币",
+				key("体力"):   "体力",
+			},
+		},
+		{
+			// e.g.: partial run of specified workbooks
+			name:  "not-localized",
+			texts: nil,
+			want: &unittestpb.TextConf{
+				ItemMap: map[uint32]*unittestpb.TextConf_Item{
+					1: {Id: 1, Name: "金币", Desc: "通用货币"},
+					2: {Id: 2, Name: "钻石", Desc: "通用货币"},
+					3: {Id: 3, Name: "体力"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := &SheetInfo{
+				ProtoPackage: "protoconf",
+				LocationName: "Asia/Shanghai",
+				MD:           tt.want.ProtoReflect().Descriptor(),
+				BookOpts:     book.MetabookOptions(),
+				SheetOpts:    book.MetasheetOptions(context.Background()),
+				ExtInfo: &SheetParserExtInfo{
+					SubdirRewrites: map[string]string{},
+					BookFormat:     format.CSV,
+					Texts:          tt.texts,
+				},
+			}
+			msg, err := parseSheet(info, xerrors.NewCollector(maxErrors), sheet, "Item#*.csv")
+			require.NoError(t, err)
+			if !proto.Equal(tt.want, msg) {
+				t.Errorf("parseSheet() = %v, want %v", msg, tt.want)
+			}
+			if tt.texts != nil {
+				assert.Equal(t, tt.wantTexts, tt.texts.Texts())
+			}
+		})
+	}
+}
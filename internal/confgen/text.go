@@ -0,0 +1,196 @@
+package confgen
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/log"
+)
+
+// Column names of the translation CSV.
+const (
+	translationColumnKey         = "Key"
+	translationColumnSource      = "Source"
+	translationColumnTranslation = "Translation"
+)
+
+// translation is a row of the translated CSV.
+type translation struct {
+	source      string // source text when it was translated
+	translation string
+}
+
+// localizationEnabled checks whether the texts of fields with prop "text"
+// should be localized.
+func (gen *Generator) localizationEnabled() bool {
+	return gen.OutputOpt != nil && gen.OutputOpt.Localization != nil
+}
+
+// newTextTable creates the table of localized texts for this generation if
+// localization is enabled.
+func (gen *Generator) newTextTable() error {
+	gen.texts = nil
+	if !gen.localizationEnabled() {
+		return nil
+	}
+	if gen.OutputOpt.Localization.SourceLang == "" {
+		return xerrors.Newf("source language of localization is not specified")
+	}
+	gen.texts = fieldprop.NewTextTable()
+	return nil
+}
+
+// textTableName returns the base name of string table of the language, e.g.:
+// "Text_zh".
+func textTableName(lang string) string {
+	return "Text_" + lang
+}
+
+// writeTextTables writes the string table of the source language, and merges
+// the translated CSV of each target language to write its string table and
+// the translation CSV to send out. Missing and stale translations are
+// reported as errors in strict mode, otherwise logged as warnings.
+func (gen *Generator) writeTextTables() error {
+	if !gen.localizationEnabled() {
+		return nil
+	}
+	opt := gen.OutputOpt.Localization
+	outputDir := filepath.Join(gen.OutputDir, gen.OutputOpt.Subdir)
+	texts := gen.texts.Texts()
+	keys := slices.Sorted(maps.Keys(texts))
+	if err := writeTextTable(filepath.Join(outputDir, textTableName(opt.SourceLang)+".json"), texts); err != nil {
+		return err
+	}
+	collector := xerrors.NewCollector(maxErrors)
+	report := func(err error) error {
+		if opt.Strict {
+			return collector.Collect(err)
+		}
+		log.Warnf("%v", err)
+		return nil
+	}
+	for _, lang := range opt.TargetLangs {
+		translatedPath := filepath.Join(gen.InputDir, opt.TranslationDir, textTableName(lang)+".csv")
+		translations, err := readTranslations(translatedPath)
+		if err != nil {
+			return err
+		}
+		table := map[string]string{}
+		rows := [][]string{{translationColumnKey, translationColumnSource, translationColumnTranslation}}
+		missing := 0
+		for _, key := range keys {
+			source := texts[key]
+			tr := translations[key]
+			if tr == nil || tr.source != source || tr.translation == "" {
+				missing++
+				rows = append(rows, []string{key, source, ""})
+				if err := report(xerrors.E2047(lang, key, source)); err != nil {
+					return err
+				}
+				continue
+			}
+			table[key] = tr.translation
+			rows = append(rows, []string{key, source, tr.translation})
+		}
+		stale := 0
+		for _, key := range slices.Sorted(maps.Keys(translations)) {
+			tr := translations[key]
+			if source, ok := texts[key]; ok && source == tr.source {
+				continue
+			}
+			stale++
+			if err := report(xerrors.E2048(lang, key, tr.source)); err != nil {
+				return err
+			}
+		}
+		if err := writeTextTable(filepath.Join(outputDir, textTableName(lang)+".json"), table); err != nil {
+			return err
+		}
+		if err := writeTranslations(filepath.Join(outputDir, textTableName(lang)+".csv"), rows); err != nil {
+			return err
+		}
+		log.Infof("%15s: %s has %d text(s) translated, %d missing, and %d stale", "string table", lang, len(table), missing, stale)
+	}
+	if collector.HasErrors() {
+		return collector.Join()
+	}
+	return nil
+}
+
+// writeTextTable writes the string table (key -> text) in JSON format.
+func writeTextTable(path string, table map[string]string) error {
+	data, err := json.MarshalIndent(table, "", "  ")
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to marshal string table: %s", path)
+	}
+	data = append(data, '\n')
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return xerrors.Wrapf(err, "failed to create dir of string table: %s", path)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return xerrors.Wrapf(err, "failed to write string table: %s", path)
+	}
+	return nil
+}
+
+// readTranslations reads the translated CSV with columns "Key", "Source",
+// and "Translation" (in any order). It returns nil if the file not exists.
+func readTranslations(path string) (map[string]*translation, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, xerrors.Wrapf(err, "failed to open translated CSV: %s", path)
+	}
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to read translated CSV: %s", path)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	header := records[0]
+	keyCol := slices.Index(header, translationColumnKey)
+	sourceCol := slices.Index(header, translationColumnSource)
+	translationCol := slices.Index(header, translationColumnTranslation)
+	if keyCol < 0 || sourceCol < 0 || translationCol < 0 {
+		return nil, xerrors.Newf("translated CSV %s should have columns %q, %q, and %q", path,
+			translationColumnKey, translationColumnSource, translationColumnTranslation)
+	}
+	translations := map[string]*translation{}
+	for _, record := range records[1:] {
+		key := record[keyCol]
+		if key == "" {
+			continue
+		}
+		translations[key] = &translation{
+			source:      record[sourceCol],
+			translation: record[translationCol],
+		}
+	}
+	return translations, nil
+}
+
+// writeTranslations writes the translation CSV to send out.
+func writeTranslations(path string, rows [][]string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to create translation CSV: %s", path)
+	}
+	defer file.Close()
+	w := csv.NewWriter(file)
+	if err := w.WriteAll(rows); err != nil {
+		return xerrors.Wrapf(err, "failed to write translation CSV: %s", path)
+	}
+	return nil
+}
@@ -287,8 +287,8 @@ func getRelBookName(basepath, filename string) string {
 	}
 }
 
-// loadProtoRegistryFiles auto loads all protoregistry.Files in protoregistry.GlobalFiles or parsed from proto files.
-func loadProtoRegistryFiles(protoPackage string, protoPaths []string, protoFiles []string, excludeProtoFiles ...string) (*protoregistry.Files, error) {
+// LoadProtoRegistryFiles auto loads all protoregistry.Files in protoregistry.GlobalFiles or parsed from proto files.
+func LoadProtoRegistryFiles(protoPackage string, protoPaths []string, protoFiles []string, excludeProtoFiles ...string) (*protoregistry.Files, error) {
 	count := 0
 	protoregistry.GlobalFiles.RangeFilesByPackage(
 		protoreflect.FullName(protoPackage),
@@ -365,6 +365,8 @@ func storeMessage(msg proto.Message, name, locationName, outputDir string, opt *
 			store.Pretty(opt.Pretty),
 			store.EmitUnpopulated(opt.EmitUnpopulated),
 			store.EmitTimezones(opt.EmitTimezones),
+			store.EmitColorHex(opt.EmitColorHex),
+			store.EmitDecimalString(opt.EmitDecimalString),
 			store.UseProtoNames(opt.UseProtoNames),
 			store.UseEnumNumbers(opt.UseEnumNumbers),
 		)
@@ -387,6 +389,8 @@ func storePatchMergeMessage(msg proto.Message, name, locationName, outputDir str
 			store.Pretty(opt.Pretty),
 			// store.EmitUnpopulated(opt.EmitUnpopulated), // DO NOT emit unpopulated fields for clear reading
 			store.EmitTimezones(opt.EmitTimezones),
+			store.EmitColorHex(opt.EmitColorHex),
+			store.EmitDecimalString(opt.EmitDecimalString),
 			store.UseProtoNames(opt.UseProtoNames),
 			store.UseEnumNumbers(opt.UseEnumNumbers),
 		)
@@ -428,6 +432,10 @@ func isSafePathKeyChar(c byte) bool {
 // refer: https://github.com/tidwall/gjson/blob/v1.18.0/gjson.go#L3560
 func escapeMapKey(key protoreflect.Value) string {
 	comp := fmt.Sprint(key)
+	if msg, ok := key.Interface().(protoreflect.Message); ok && types.IsWellKnownVector(msg.Descriptor().FullName()) {
+		// key of keyed list can be a well-known vector
+		comp = xproto.FormatVector(msg)
+	}
 	for i := 0; i < len(comp); i++ {
 		if !isSafePathKeyChar(comp[i]) {
 			ncomp := make([]byte, len(comp)+1)
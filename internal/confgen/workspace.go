@@ -0,0 +1,42 @@
+package confgen
+
+import (
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// LoadProtoRegistryFiles loads the proto registry files specified by the
+// input options.
+func (gen *Generator) LoadProtoRegistryFiles() (*protoregistry.Files, error) {
+	return LoadProtoRegistryFiles(gen.ProtoPackage, gen.InputOpt.ProtoPaths, gen.InputOpt.ProtoFiles, gen.InputOpt.ExcludedProtoFiles...)
+}
+
+// BookSheetInfos returns the infos of all worksheets in the primary
+// workbooks related to the workbook, which can be a primary workbook or a
+// secondary (merger/scatter) workbook. The workbook name is relative to the
+// input dir, and CSV workbook name is in pattern: "<BookName>#*.csv".
+//
+// It is used to parse a single workbook out of the whole generation, e.g.:
+// by the language server.
+func (gen *Generator) BookSheetInfos(prFiles *protoregistry.Files, bookName string) ([]*SheetInfo, error) {
+	bookIndexes, err := buildWorkbookIndex(gen.ProtoPackage, gen.InputDir, gen.InputOpt.Subdirs, gen.InputOpt.SubdirRewrites, prFiles)
+	if err != nil {
+		return nil, err
+	}
+	primaryBookInfo, ok := bookIndexes.get(xfs.CleanSlashPath(bookName))
+	if !ok {
+		return nil, nil
+	}
+	var sheets []*SheetInfo
+	for _, fd := range primaryBookInfo.fds {
+		_, workbook := ParseFileOptions(fd)
+		if workbook == nil {
+			continue
+		}
+		rewrittenWorkbookName := xfs.RewriteSubdir(workbook.Name, gen.InputOpt.SubdirRewrites)
+		workbookFormat := format.GetFormat(workbook.Name)
+		sheets = append(sheets, gen.newSheetInfos(prFiles, fd, rewrittenWorkbookName, workbookFormat)...)
+	}
+	return sheets, nil
+}
@@ -0,0 +1,85 @@
+// Package docgen generates the reference documentation (Markdown or static
+// HTML) of all workbooks, worksheets, fields, enums, and structs from
+// protoconf files.
+package docgen
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/confgen"
+	"github.com/tableauio/tableau/internal/strcase"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
+)
+
+// IndexPage is the name (without extension) of the index page.
+const IndexPage = "index"
+
+type Generator struct {
+	ctx          context.Context
+	ProtoPackage string        // protobuf package name.
+	OutputDir    string        // output dir of generated documents.
+	Format       format.Format // output format: Markdown (default) or HTML.
+
+	InputOpt *options.ConfInputOption // input settings, only proto related settings are used.
+}
+
+func NewGenerator(protoPackage, outdir string, setters ...options.Option) *Generator {
+	opts := options.ParseOptions(setters...)
+	return NewGeneratorWithOptions(protoPackage, outdir, opts)
+}
+
+func NewGeneratorWithOptions(protoPackage, outdir string, opts *options.Options) *Generator {
+	ctx := context.Background()
+	ctx = strcase.NewContext(ctx, strcase.New(opts.Acronyms))
+	return &Generator{
+		ctx:          ctx,
+		ProtoPackage: protoPackage,
+		OutputDir:    outdir,
+		Format:       format.Markdown,
+		InputOpt:     opts.Conf.Input,
+	}
+}
+
+// Generate generates the index page, and one page per proto file (mostly
+// one workbook) of the proto package.
+func (gen *Generator) Generate() error {
+	if gen.Format != format.Markdown && gen.Format != format.HTML {
+		return xerrors.Newf("unknown doc format: %s", gen.Format)
+	}
+	prFiles, err := confgen.LoadProtoRegistryFiles(gen.ProtoPackage, gen.InputOpt.ProtoPaths, gen.InputOpt.ProtoFiles, gen.InputOpt.ExcludedProtoFiles...)
+	if err != nil {
+		return err
+	}
+	site := newBuilder(gen.ProtoPackage, "."+string(gen.Format)).Build(prFiles)
+	if err := os.MkdirAll(gen.OutputDir, 0700); err != nil {
+		return xerrors.Wrapf(err, "failed to create output dir: %s", gen.OutputDir)
+	}
+	if err := gen.render(indexTemplate, IndexPage+"."+string(gen.Format), site); err != nil {
+		return err
+	}
+	for _, page := range site.Pages {
+		if err := gen.render(pageTemplate, page.Filename, page); err != nil {
+			return xerrors.Wrapf(err, "failed to generate doc of %s", page.ProtoFile)
+		}
+		log.Infof("%15s: %s", "generated doc", page.Filename)
+	}
+	return nil
+}
+
+func (gen *Generator) render(name, filename string, data any) error {
+	var buf bytes.Buffer
+	if err := executeTemplate(&buf, gen.Format, name, data); err != nil {
+		return xerrors.Wrapf(err, "failed to render %s", filename)
+	}
+	path := filepath.Join(gen.OutputDir, filename)
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return xerrors.Wrapf(err, "failed to write file: %s", path)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package docgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/options"
+)
+
+// testProtoDir is the directory containing the proto files used by tests.
+// They are loaded at runtime via protoc compiler.
+const testProtoDir = "./testdata/proto"
+
+func newTestGenerator(outdir string) *Generator {
+	return NewGenerator("docgentest", outdir,
+		options.Conf(
+			&options.ConfOption{
+				Input: &options.ConfInputOption{
+					ProtoPaths: []string{testProtoDir},
+					ProtoFiles: []string{testProtoDir + "/*.proto"},
+				},
+			},
+		),
+	)
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestGenerator_Generate_Markdown(t *testing.T) {
+	outdir := t.TempDir()
+	gen := newTestGenerator(outdir)
+	require.NoError(t, gen.Generate())
+
+	index := readFile(t, filepath.Join(outdir, "index.md"))
+	assert.Contains(t, index, "| [hero/Hero.xlsx](hero.md) | `hero.proto` | [Hero](hero.md#HeroConf) |")
+	assert.Contains(t, index, "| [Item#*.csv](item.md) | `item.proto` | [ItemConf](item.md#ItemConf) |")
+	assert.Contains(t, index, "| [common.proto](common.md) | [Reward](common.md#Reward) | [ItemType](common.md#ItemType) |")
+
+	hero := readFile(t, filepath.Join(outdir, "hero.md"))
+	assert.Contains(t, hero, "- Alias: HeroBook")
+	assert.Contains(t, hero, `- Options: `+"`"+`name:"Hero" namerow:1 typerow:2 noterow:3 datarow:4`+"`")
+	assert.Contains(t, hero, "| `hero_map` |  | map&lt;uint32, HeroConf.Hero&gt; | vertical |  |  |")
+	// refer links, and note with escaped "|"
+	assert.Contains(t, hero, "| `hero_map.item_id` | ItemID | uint32 |  | refer: [ItemConf.ID](item.md#ItemConf), Unknown.ID | Hero's item \\| note |")
+	// shared struct type link
+	assert.Contains(t, hero, "| `hero_map.reward_list` | Reward | repeated [Reward](common.md#Reward) | horizontal | `size:2` |  |")
+
+	item := readFile(t, filepath.Join(outdir, "item.md"))
+	assert.Contains(t, item, "ItemConf is the item config.")
+	assert.Contains(t, item, "- Referred by: [HeroConf.hero_map.item_id](hero.md#HeroConf), [Reward.id](common.md#Reward)")
+	assert.Contains(t, item, "| `item_map.id` | ID | uint32 |  | `unique:true` | Item's ID |")
+	assert.Contains(t, item, "| `item_map.type` | Type | [ItemType](common.md#ItemType) |  |  | Item's type |")
+	assert.Contains(t, item, "| `item_map.param_list` | Param | repeated int32 | incell |  |  |")
+
+	common := readFile(t, filepath.Join(outdir, "common.md"))
+	assert.Contains(t, common, "- Used by: [HeroConf](hero.md#HeroConf)")
+	assert.Contains(t, common, "| `num` | Num | int32 |  | `range:\"1,~\"` | Item number |")
+	assert.Contains(t, common, "- Used by: [ItemConf](item.md#ItemConf)")
+	assert.Contains(t, common, "| 1 | ITEM_TYPE_FRUIT | Fruit | Fruit |")
+}
+
+func TestGenerator_Generate_HTML(t *testing.T) {
+	outdir := t.TempDir()
+	gen := newTestGenerator(outdir)
+	gen.Format = format.HTML
+	require.NoError(t, gen.Generate())
+
+	index := readFile(t, filepath.Join(outdir, "index.html"))
+	assert.Contains(t, index, `<a href="hero.html#HeroConf">Hero</a>`)
+
+	hero := readFile(t, filepath.Join(outdir, "hero.html"))
+	assert.Contains(t, hero, `<h3 id="HeroConf">Hero</h3>`)
+	assert.Contains(t, hero, `<td>map&lt;uint32, HeroConf.Hero&gt;</td>`)
+	assert.Contains(t, hero, `refer: <a href="item.html#ItemConf">ItemConf.ID</a>, Unknown.ID`)
+	assert.Contains(t, hero, `repeated <a href="common.html#Reward">Reward</a>`)
+}
+
+func TestGenerator_Generate_UnknownFormat(t *testing.T) {
+	gen := newTestGenerator(t.TempDir())
+	gen.Format = format.JSON
+	assert.Error(t, gen.Generate())
+}
+
+func Test_pageFilename(t *testing.T) {
+	assert.Equal(t, "item.md", pageFilename("item.proto", ".md"))
+	assert.Equal(t, "common__item.html", pageFilename("common/item.proto", ".html"))
+}
+
+func Test_escapeMarkdown(t *testing.T) {
+	assert.Equal(t, `a \| b<br>map&lt;int32, string&gt;`, escapeMarkdown("a | b\nmap<int32, string>"))
+}
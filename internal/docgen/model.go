@@ -0,0 +1,395 @@
+package docgen
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/confgen"
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
+	"github.com/tableauio/tableau/internal/types"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Link is a hyperlink. It is plain text if URL is empty.
+type Link struct {
+	Text string
+	URL  string
+}
+
+// Site is the whole documentation site.
+type Site struct {
+	ProtoPackage string
+	Index        string // filename of index page
+	Pages        []*Page
+}
+
+// Page is the document page of one proto file.
+type Page struct {
+	Filename  string // output filename, e.g.: item.md
+	ProtoFile string // proto file path
+	Index     string // filename of index page
+	Workbook  *tableaupb.WorkbookOptions
+	Sheets    []*Sheet
+	Structs   []*Struct
+	Enums     []*Enum
+}
+
+// Title returns the workbook name, or the proto file path if this page is
+// not generated from a workbook.
+func (p *Page) Title() string {
+	if p.Workbook != nil {
+		return p.Workbook.Name
+	}
+	return p.ProtoFile
+}
+
+// Sheet is the document of a worksheet.
+type Sheet struct {
+	Name       string // worksheet name
+	Message    string // protobuf message name
+	Anchor     string
+	Note       string
+	Options    string // worksheet options in text format
+	Fields     []*Field
+	ReferredBy []Link // fields in other sheets referring to this sheet
+}
+
+// Struct is the document of a predefined struct type.
+type Struct struct {
+	Name   string
+	Anchor string
+	Note   string
+	Fields []*Field
+	UsedBy []Link // sheets or structs using this type
+}
+
+// Enum is the document of a predefined enum type.
+type Enum struct {
+	Name   string
+	Anchor string
+	Note   string
+	Values []*EnumValue
+	UsedBy []Link // sheets or structs using this type
+}
+
+type EnumValue struct {
+	Number int32
+	Name   string
+	Alias  string
+	Note   string
+}
+
+// Field is the document of a message field. Fields of nested messages are
+// flattened with a dotted path.
+type Field struct {
+	Path   string // dotted path of proto field names, e.g.: item_map.id
+	Name   string // column name of field options
+	Type   []Link // field type, predefined types are linked
+	Layout string
+	Prop   string // field prop in text format, except refer
+	Refers []Link
+	Note   string
+}
+
+// builder builds the documentation site model from proto files.
+type builder struct {
+	protoPackage string
+	ext          string // page file extension
+
+	site    *Site
+	sheets  map[string]*Sheet                 // message name -> sheet
+	structs map[protoreflect.FullName]*Struct // full name -> predefined struct
+	enums   map[protoreflect.FullName]*Enum   // full name -> predefined enum
+	links   map[protoreflect.FullName]Link    // full name -> link of sheet or predefined type
+	usedBy  map[protoreflect.FullName]*[]Link // full name -> users of predefined type
+}
+
+func newBuilder(protoPackage, ext string) *builder {
+	return &builder{
+		protoPackage: protoPackage,
+		ext:          ext,
+		site: &Site{
+			ProtoPackage: protoPackage,
+			Index:        IndexPage + ext,
+		},
+		sheets:  map[string]*Sheet{},
+		structs: map[protoreflect.FullName]*Struct{},
+		enums:   map[protoreflect.FullName]*Enum{},
+		links:   map[protoreflect.FullName]Link{},
+		usedBy:  map[protoreflect.FullName]*[]Link{},
+	}
+}
+
+// Build builds the site in two passes: the first pass registers all sheets
+// and predefined types with their anchors, and the second pass parses fields
+// with links resolved.
+func (b *builder) Build(prFiles *protoregistry.Files) *Site {
+	var fds []protoreflect.FileDescriptor
+	prFiles.RangeFilesByPackage(
+		protoreflect.FullName(b.protoPackage),
+		func(fd protoreflect.FileDescriptor) bool {
+			fds = append(fds, fd)
+			return true
+		})
+	// NOTE: keep the output stable.
+	sort.Slice(fds, func(i, j int) bool {
+		return fds[i].Path() < fds[j].Path()
+	})
+	type pending struct {
+		md   protoreflect.MessageDescriptor
+		self Link
+	}
+	var sheetMsgs, structMsgs []pending
+	for _, fd := range fds {
+		_, workbook := confgen.ParseFileOptions(fd)
+		page := &Page{
+			Filename:  pageFilename(fd.Path(), b.ext),
+			ProtoFile: fd.Path(),
+			Index:     b.site.Index,
+			Workbook:  workbook,
+		}
+		for i := 0; i < fd.Messages().Len(); i++ {
+			md := fd.Messages().Get(i)
+			self := Link{Text: string(md.Name()), URL: page.Filename + "#" + string(md.Name())}
+			b.links[md.FullName()] = self
+			_, sheetOpts := confgen.ParseMessageOptions(md)
+			if sheetOpts != nil {
+				sheet := &Sheet{
+					Name:    sheetOpts.Name,
+					Message: string(md.Name()),
+					Anchor:  string(md.Name()),
+					Note:    leadingComments(md),
+					Options: marshalText(sheetOpts),
+				}
+				b.sheets[sheet.Message] = sheet
+				page.Sheets = append(page.Sheets, sheet)
+				sheetMsgs = append(sheetMsgs, pending{md, self})
+			} else {
+				st := &Struct{
+					Name:   string(md.Name()),
+					Anchor: string(md.Name()),
+					Note:   leadingComments(md),
+				}
+				b.structs[md.FullName()] = st
+				b.usedBy[md.FullName()] = &st.UsedBy
+				page.Structs = append(page.Structs, st)
+				structMsgs = append(structMsgs, pending{md, self})
+			}
+		}
+		for i := 0; i < fd.Enums().Len(); i++ {
+			ed := fd.Enums().Get(i)
+			enum := b.parseEnum(ed)
+			b.enums[ed.FullName()] = enum
+			b.links[ed.FullName()] = Link{Text: enum.Name, URL: page.Filename + "#" + enum.Anchor}
+			b.usedBy[ed.FullName()] = &enum.UsedBy
+			page.Enums = append(page.Enums, enum)
+		}
+		if len(page.Sheets) == 0 && len(page.Structs) == 0 && len(page.Enums) == 0 {
+			continue
+		}
+		b.site.Pages = append(b.site.Pages, page)
+	}
+	for _, p := range sheetMsgs {
+		sheet := b.sheets[string(p.md.Name())]
+		sheet.Fields = b.parseFields(p.md, "", p.self, map[protoreflect.FullName]bool{})
+	}
+	for _, p := range structMsgs {
+		st := b.structs[p.md.FullName()]
+		st.Fields = b.parseFields(p.md, "", p.self, map[protoreflect.FullName]bool{})
+	}
+	return b.site
+}
+
+func (b *builder) parseEnum(ed protoreflect.EnumDescriptor) *Enum {
+	enum := &Enum{
+		Name:   string(ed.Name()),
+		Anchor: string(ed.Name()),
+		Note:   leadingComments(ed),
+	}
+	for i := 0; i < ed.Values().Len(); i++ {
+		evd := ed.Values().Get(i)
+		opts := proto.GetExtension(evd.Options(), tableaupb.E_Evalue).(*tableaupb.EnumValueOptions)
+		enum.Values = append(enum.Values, &EnumValue{
+			Number: int32(evd.Number()),
+			Name:   string(evd.Name()),
+			Alias:  opts.GetName(),
+			Note:   trailingComments(evd),
+		})
+	}
+	return enum
+}
+
+// parseFields parses fields of message md recursively. The fields of nested
+// message types (not predefined) are expanded just after the parent field.
+func (b *builder) parseFields(md protoreflect.MessageDescriptor, prefix string, self Link, visited map[protoreflect.FullName]bool) []*Field {
+	visited[md.FullName()] = true
+	defer delete(visited, md.FullName())
+	var fields []*Field
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		opts := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+		field := &Field{
+			Path:   prefix + string(fd.Name()),
+			Name:   opts.GetName(),
+			Type:   b.fieldType(fd, self),
+			Layout: layout(fd, opts),
+			Note:   opts.GetNote(),
+		}
+		if field.Note == "" {
+			field.Note = trailingComments(fd)
+		}
+		prop := &tableaupb.FieldProp{}
+		if opts.GetProp() != nil {
+			prop = proto.Clone(opts.GetProp()).(*tableaupb.FieldProp)
+		}
+		// protogen generates the deprecated and field presence props as
+		// protobuf built-in options
+		prop.Deprecated = fd.Options().(*descriptorpb.FieldOptions).GetDeprecated()
+		prop.FieldPresence = xproto.HasExplicitPresence(fd)
+		if prop.Refer != "" {
+			field.Refers = b.parseRefers(prop.Refer, Link{Text: self.Text + "." + field.Path, URL: self.URL})
+			prop.Refer = ""
+		}
+		field.Prop = marshalText(prop)
+		fields = append(fields, field)
+		// expand nested message type
+		var subMd protoreflect.MessageDescriptor
+		if fd.IsMap() {
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				subMd = fd.MapValue().Message()
+			}
+		} else if fd.Kind() == protoreflect.MessageKind {
+			subMd = fd.Message()
+		}
+		if subMd != nil && !visited[subMd.FullName()] {
+			if _, ok := b.links[subMd.FullName()]; !ok && !types.IsWellKnownMessage(subMd.FullName()) && subMd.ParentFile().Package() == md.ParentFile().Package() {
+				fields = append(fields, b.parseFields(subMd, field.Path+".", self, visited)...)
+			}
+		}
+	}
+	return fields
+}
+
+// parseRefers parses the comma separated refers, and records the referrer
+// to the referred sheets.
+func (b *builder) parseRefers(refers string, referrer Link) []Link {
+	var links []Link
+	for _, refer := range fieldprop.SplitRefers(refers) {
+		link := Link{Text: strings.TrimSpace(refer)}
+		desc, err := fieldprop.ParseRefer(refer)
+		if err == nil {
+			if sheet, ok := b.sheets[desc.GetMessageName()]; ok {
+				link.URL = b.links[protoreflect.FullName(b.protoPackage+"."+sheet.Message)].URL
+				sheet.ReferredBy = appendLink(sheet.ReferredBy, referrer)
+			}
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+// fieldType returns the field type in protobuf syntax, and the predefined
+// types are linked.
+func (b *builder) fieldType(fd protoreflect.FieldDescriptor, self Link) []Link {
+	if fd.IsMap() {
+		typ := []Link{{Text: "map<" + fd.MapKey().Kind().String() + ", "}}
+		typ = append(typ, b.singularType(fd.MapValue(), self))
+		return append(typ, Link{Text: ">"})
+	}
+	typ := b.singularType(fd, self)
+	if fd.IsList() {
+		return []Link{{Text: "repeated "}, typ}
+	}
+	return []Link{typ}
+}
+
+func (b *builder) singularType(fd protoreflect.FieldDescriptor, self Link) Link {
+	var desc protoreflect.Descriptor
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		desc = fd.Enum()
+	case protoreflect.MessageKind:
+		desc = fd.Message()
+	default:
+		return Link{Text: fd.Kind().String()}
+	}
+	if link, ok := b.links[desc.FullName()]; ok {
+		if users := b.usedBy[desc.FullName()]; users != nil {
+			*users = appendLink(*users, self)
+		}
+		return link
+	}
+	name := string(desc.FullName())
+	if prefix := b.protoPackage + "."; strings.HasPrefix(name, prefix) {
+		name = strings.TrimPrefix(name, prefix)
+	}
+	return Link{Text: name}
+}
+
+// layout returns the effective layout of map and list field.
+func layout(fd protoreflect.FieldDescriptor, opts *tableaupb.FieldOptions) string {
+	if !fd.IsMap() && !fd.IsList() {
+		return ""
+	}
+	l := opts.GetLayout()
+	if l == tableaupb.Layout_LAYOUT_DEFAULT {
+		var isStruct bool
+		if fd.IsMap() {
+			isStruct = fd.MapValue().Kind() == protoreflect.MessageKind
+		} else {
+			isStruct = fd.Kind() == protoreflect.MessageKind && !types.IsWellKnownMessage(fd.Message().FullName())
+		}
+		switch {
+		case !isStruct:
+			l = tableaupb.Layout_LAYOUT_INCELL
+		case fd.IsMap():
+			l = tableaupb.Layout_LAYOUT_VERTICAL
+		default:
+			l = tableaupb.Layout_LAYOUT_HORIZONTAL
+		}
+	}
+	return strings.ToLower(strings.TrimPrefix(l.String(), "LAYOUT_"))
+}
+
+func appendLink(links []Link, link Link) []Link {
+	for _, l := range links {
+		if l == link {
+			return links
+		}
+	}
+	return append(links, link)
+}
+
+// pageFilename converts the proto file path to page filename, e.g.:
+// "common/item.proto" -> "common__item.md".
+func pageFilename(protoPath, ext string) string {
+	name := strings.TrimSuffix(protoPath, ".proto")
+	return strings.ReplaceAll(name, "/", "__") + ext
+}
+
+func leadingComments(desc protoreflect.Descriptor) string {
+	loc := desc.ParentFile().SourceLocations().ByDescriptor(desc)
+	return strings.TrimSpace(loc.LeadingComments)
+}
+
+func trailingComments(desc protoreflect.Descriptor) string {
+	loc := desc.ParentFile().SourceLocations().ByDescriptor(desc)
+	return strings.TrimSpace(loc.TrailingComments)
+}
+
+// marshalText marshals the message to compact text format.
+func marshalText(m proto.Message) string {
+	bin, err := prototext.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	// NOTE: remove redundant spaces/whitespace from a string
+	// refer: https://stackoverflow.com/questions/37290693/how-to-remove-redundant-spaces-whitespace-from-a-string-in-golang
+	return strings.Join(strings.Fields(string(bin)), " ")
+}
@@ -0,0 +1,52 @@
+package docgen
+
+import (
+	"embed"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/tableauio/tableau/format"
+)
+
+const (
+	indexTemplate = "index"
+	pageTemplate  = "page"
+)
+
+//go:embed templates
+var templatesFS embed.FS
+
+var (
+	mdTemplates   *texttemplate.Template
+	htmlTemplates *htmltemplate.Template
+)
+
+func init() {
+	mdTemplates = texttemplate.Must(texttemplate.New("md").
+		Funcs(texttemplate.FuncMap{"md": escapeMarkdown}).
+		ParseFS(templatesFS, "templates/*.md.tmpl"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.New("html").
+		ParseFS(templatesFS, "templates/*.html.tmpl"))
+}
+
+func executeTemplate(w io.Writer, docFormat format.Format, name string, data any) error {
+	if docFormat == format.HTML {
+		return htmlTemplates.ExecuteTemplate(w, name, data)
+	}
+	return mdTemplates.ExecuteTemplate(w, name, data)
+}
+
+var markdownReplacer = strings.NewReplacer(
+	`|`, `\|`,
+	`<`, `&lt;`,
+	`>`, `&gt;`,
+	"\r\n", "<br>",
+	"\n", "<br>",
+)
+
+// escapeMarkdown escapes text to be used in a markdown table cell.
+func escapeMarkdown(text string) string {
+	return markdownReplacer.Replace(text)
+}
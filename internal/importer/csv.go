@@ -118,6 +118,25 @@ func readCSVRows(filename string, topN uint) (rows [][]string, err error) {
 	if err != nil {
 		return nil, xerrors.E3002(err)
 	}
+	return parseCSVRows(data, topN)
+}
+
+// ParseCSVSheet parses the content of a CSV worksheet file, which recognizes
+// pattern: "<BookName>#<SheetName>.csv", e.g.: the unsaved content in an
+// editor.
+func ParseCSVSheet(filename string, content []byte) (*book.Sheet, error) {
+	_, sheetName, err := xfs.ParseCSVFilenamePattern(filename)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := parseCSVRows(content, 0)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to parse CSV file: %s", filename)
+	}
+	return book.NewTableSheet(sheetName, rows), nil
+}
+
+func parseCSVRows(data []byte, topN uint) (rows [][]string, err error) {
 	// check and strip BOM
 	hasBOM := bytes.HasPrefix(data, BOM)
 	if hasBOM {
@@ -118,20 +118,38 @@ func readXMLBook(ctx context.Context, filename string, sheetNames []string, pars
 	if err != nil {
 		return nil, xerrors.E3002(err)
 	}
+	if err := parseXMLBookContent(ctx, newBook, filename, content, sheetNames); err != nil {
+		return nil, err
+	}
+	return newBook, nil
+}
+
+// ParseXMLSheets parses all sheets in the content of a XML workbook file,
+// e.g.: the unsaved content in an editor.
+func ParseXMLSheets(ctx context.Context, filename string, content []byte) ([]*book.Sheet, error) {
+	bookName := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	newBook := book.NewBook(ctx, bookName, filename, nil)
+	if err := parseXMLBookContent(ctx, newBook, filename, content, nil); err != nil {
+		return nil, err
+	}
+	return newBook.GetSheets(), nil
+}
+
+func parseXMLBookContent(ctx context.Context, newBook *book.Book, filename string, content []byte, sheetNames []string) error {
 	// 1. parse metasheet in the xml comment
 	msContent := extractXMLMetasheetInComment(string(content), metasheet.FromContext(ctx).Name)
 	rawDocs, err := extractRawXMLDocuments(msContent)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	for _, rawDoc := range rawDocs {
 		doc, err := xmldom.ParseXML(rawDoc)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		sheet, err := parseXMLSheet(doc, Protogen, metasheet.FromContext(ctx).Name)
 		if err != nil {
-			return nil, xerrors.Wrapf(err, "file: %s", filename)
+			return xerrors.Wrapf(err, "file: %s", filename)
 		}
 		if wantSheet(sheet.Name, sheetNames) {
 			newBook.AddSheet(sheet)
@@ -141,22 +159,22 @@ func readXMLBook(ctx context.Context, filename string, sheetNames []string, pars
 	// 2. parse data sheet in the xml content
 	rawDocs, err = extractRawXMLDocuments(string(content))
 	if err != nil {
-		return nil, err
+		return err
 	}
 	for _, rawDoc := range rawDocs {
 		doc, err := xmldom.ParseXML(rawDoc)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		sheet, err := parseXMLSheet(doc, UnknownMode, metasheet.FromContext(ctx).Name)
 		if err != nil {
-			return nil, xerrors.Wrapf(err, "file: %s", filename)
+			return xerrors.Wrapf(err, "file: %s", filename)
 		}
 		if wantSheet(sheet.Name, sheetNames) {
 			newBook.AddSheet(sheet)
 		}
 	}
-	return newBook, nil
+	return nil
 }
 
 func readXMLBookWithOnlySchemaSheet(ctx context.Context, filename string, parser book.SheetParser) (*book.Book, error) {
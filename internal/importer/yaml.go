@@ -2,6 +2,7 @@ package importer
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -53,12 +54,37 @@ func readYAMLBook(ctx context.Context, filename string, sheetNames []string, par
 	if err != nil {
 		return nil, xerrors.E3002(err)
 	}
-	// parse all documents in a file
-	decoder := yaml.NewDecoder(file)
+	defer file.Close()
+	sheets, err := decodeYAMLSheets(file)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "file: %s", filename)
+	}
+	for _, sheet := range sheets {
+		if wantSheet(sheet.Name, sheetNames) {
+			newBook.AddSheet(sheet)
+		}
+	}
+	return newBook, nil
+}
+
+// ParseYAMLSheets parses all document sheets in the content of a YAML
+// workbook file, e.g.: the unsaved content in an editor.
+func ParseYAMLSheets(filename string, content []byte) ([]*book.Sheet, error) {
+	sheets, err := decodeYAMLSheets(bytes.NewReader(content))
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "file: %s", filename)
+	}
+	return sheets, nil
+}
+
+// decodeYAMLSheets parses all documents in r, and each document is a sheet.
+func decodeYAMLSheets(r io.Reader) ([]*book.Sheet, error) {
+	var sheets []*book.Sheet
+	decoder := yaml.NewDecoder(r)
 	for i := 0; ; i++ {
 		var doc yaml.Node
 		// Decode one document at a time
-		err = decoder.Decode(&doc)
+		err := decoder.Decode(&doc)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
@@ -68,13 +94,11 @@ func readYAMLBook(ctx context.Context, filename string, sheetNames []string, par
 		}
 		sheet, err := parseYAMLSheet(&doc, i)
 		if err != nil {
-			return nil, xerrors.Wrapf(err, "file: %s", filename)
-		}
-		if wantSheet(sheet.Name, sheetNames) {
-			newBook.AddSheet(sheet)
+			return nil, err
 		}
+		sheets = append(sheets, sheet)
 	}
-	return newBook, nil
+	return sheets, nil
 }
 
 func readYAMLBookWithOnlySchemaSheet(ctx context.Context, filename string, parser book.SheetParser) (*book.Book, error) {
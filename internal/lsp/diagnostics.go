@@ -0,0 +1,134 @@
+package lsp
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/confgen"
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/log"
+	"github.com/xuri/excelize/v2"
+)
+
+const diagnosticSource = "tableau"
+
+var (
+	// e.g.: "B5", or "[B...D]5" for a range of columns.
+	cellPosRegexp = regexp.MustCompile(`^(?:\[([A-Z]+)\.\.\.[A-Z]+\]|([A-Z]+))(\d+)$`)
+	// e.g.: "Ln 3, Col 5" of document node.
+	nodePosRegexp = regexp.MustCompile(`^Ln (\d+), Col (\d+)$`)
+	// e.g.: "yaml: line 3: mapping values are not allowed in this context"
+	yamlErrLineRegexp = regexp.MustCompile(`line (\d+):`)
+)
+
+// diagnose parses the document by the confgen sheet parsers against the
+// current proto registry, and converts errors to diagnostics.
+func (s *Server) diagnose(doc *document) []Diagnostic {
+	diags := []Diagnostic{}
+	errs, infos := s.check(doc)
+	for _, err := range errs {
+		for _, diag := range xerrors.NewDesc(err).Diagnostics() {
+			message := diag.Reason
+			if message == "" {
+				message = strings.TrimSpace(diag.Message)
+			}
+			if diag.Help != "" {
+				message += "\n" + diag.Help
+			}
+			diags = append(diags, Diagnostic{
+				Range:    s.rangeOf(doc, diag, infos),
+				Severity: DiagnosticSeverityError,
+				Code:     diag.Code,
+				Source:   diagnosticSource,
+				Message:  message,
+			})
+		}
+	}
+	return diags
+}
+
+// check parses all worksheets in the document, and returns the errors and
+// sheet infos (sheet name -> info).
+func (s *Server) check(doc *document) ([]error, map[string]*confgen.SheetInfo) {
+	infos := map[string]*confgen.SheetInfo{}
+	sheets, err := doc.sheets(s.ctx)
+	if err != nil {
+		return []error{err}, infos
+	}
+	prFiles, err := s.loadProtoRegistryFiles()
+	if err != nil {
+		return []error{err}, infos
+	}
+	bookName, err := doc.bookName(s.gen.InputDir)
+	if err != nil {
+		return []error{err}, infos
+	}
+	sheetInfos, err := s.gen.BookSheetInfos(prFiles, bookName)
+	if err != nil {
+		return []error{err}, infos
+	}
+	// NOTE: referred workbooks may have been changed since the last check.
+	fieldprop.ClearReferredCache()
+	var errs []error
+	collector := xerrors.NewCollector(0)
+	for _, sheet := range sheets {
+		for _, info := range sheetInfos {
+			if info.SheetName() != sheet.Name {
+				continue
+			}
+			infos[sheet.Name] = info
+			if _, err := confgen.ParseSheet(info, collector, sheet); err != nil {
+				errs = append(errs, xerrors.WrapKV(err, xerrors.KeySheetName, sheet.Name))
+			}
+		}
+	}
+	log.Debugf("lsp: checked %s: %d sheet(s), %d error(s)", bookName, len(sheets), len(errs))
+	return errs, infos
+}
+
+// rangeOf locates the diagnostic in the document. The first line is
+// returned if the position is unknown.
+func (s *Server) rangeOf(doc *document, diag *xerrors.Diagnostic, infos map[string]*confgen.SheetInfo) Range {
+	if matches := cellPosRegexp.FindStringSubmatch(diag.CellPos); matches != nil && doc.format == format.CSV {
+		colName := matches[1]
+		if colName == "" {
+			colName = matches[2]
+		}
+		col, err1 := excelize.ColumnNameToNumber(colName)
+		row, err2 := strconv.Atoi(matches[3])
+		if err1 == nil && err2 == nil {
+			if info := infos[diag.Sheet]; info != nil && info.SheetOpts.GetTranspose() {
+				row, col = col, row
+			}
+			if rng, ok := parseCSVTable(doc).cellRange(row-1, col-1); ok {
+				return rng
+			}
+		}
+	}
+	lines := doc.lines()
+	line, column := -1, 0
+	if matches := nodePosRegexp.FindStringSubmatch(diag.CellPos); matches != nil {
+		line, _ = strconv.Atoi(matches[1])
+		column, _ = strconv.Atoi(matches[2])
+		line--
+		column--
+	} else if matches := yamlErrLineRegexp.FindStringSubmatch(diag.Message); matches != nil && doc.format == format.YAML {
+		line, _ = strconv.Atoi(matches[1])
+		line--
+	}
+	if line < 0 || line >= len(lines) {
+		return Range{End: Position{Character: utf16Len(lines[0])}}
+	}
+	start := Position{Line: line, Character: runeOffsetToChar(lines[line], column)}
+	end := Position{Line: line, Character: utf16Len(lines[line])}
+	if diag.Value != "" && column >= 0 {
+		rest := string([]rune(lines[line])[min(column, len([]rune(lines[line]))):])
+		if strings.HasPrefix(rest, diag.Value) {
+			end.Character = start.Character + utf16Len(diag.Value)
+		}
+	}
+	return Range{Start: start, End: end}
+}
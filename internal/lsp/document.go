@@ -0,0 +1,214 @@
+package lsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/importer"
+	"github.com/tableauio/tableau/internal/importer/book"
+	"github.com/tableauio/tableau/internal/x/xfs"
+)
+
+// document is a workbook file opened in the editor.
+type document struct {
+	uri     string
+	path    string // absolute file path
+	format  format.Format
+	content []byte // the latest content, maybe unsaved
+}
+
+// bookName returns the workbook name relative to indir. CSV workbook name is
+// in pattern: "<BookName>#*.csv".
+func (d *document) bookName(indir string) (string, error) {
+	relPath, err := xfs.Rel(indir, d.path)
+	if err != nil {
+		return "", err
+	}
+	if d.format == format.CSV {
+		bookName, _, err := xfs.ParseCSVFilenamePattern(relPath)
+		if err != nil {
+			return "", err
+		}
+		return xfs.GenCSVBooknamePattern(filepath.Dir(relPath), bookName), nil
+	}
+	return relPath, nil
+}
+
+// sheets parses the content into worksheets.
+func (d *document) sheets(ctx context.Context) ([]*book.Sheet, error) {
+	switch d.format {
+	case format.CSV:
+		sheet, err := importer.ParseCSVSheet(d.path, d.content)
+		if err != nil {
+			return nil, err
+		}
+		return []*book.Sheet{sheet}, nil
+	case format.YAML:
+		return importer.ParseYAMLSheets(d.path, d.content)
+	case format.XML:
+		return importer.ParseXMLSheets(ctx, d.path, d.content)
+	default:
+		return nil, nil
+	}
+}
+
+// lines returns the lines of content without line endings.
+func (d *document) lines() []string {
+	lines := strings.Split(string(d.content), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
+
+// csvTable is the parsed CSV content with the start position of each cell.
+type csvTable struct {
+	lines   []string
+	records [][]string
+	starts  [][]Position // record -> field -> start position
+}
+
+// parseCSVTable parses CSV content. It is best effort: if a syntax error
+// occurs, the records before it are kept.
+func parseCSVTable(d *document) *csvTable {
+	t := &csvTable{lines: d.lines()}
+	content := bytes.TrimPrefix(d.content, importer.BOM)
+	r := csv.NewReader(bytes.NewReader(content))
+	r.FieldsPerRecord = -1
+	for {
+		record, err := r.Read()
+		if err != nil {
+			break
+		}
+		starts := make([]Position, len(record))
+		for i := range record {
+			line, column := r.FieldPos(i)
+			starts[i] = t.position(line-1, column-1)
+		}
+		t.records = append(t.records, record)
+		t.starts = append(t.starts, starts)
+	}
+	return t
+}
+
+// position converts zero-based line and byte offset to LSP position.
+func (t *csvTable) position(line, byteOffset int) Position {
+	if line < 0 || line >= len(t.lines) {
+		return Position{Line: line}
+	}
+	return Position{Line: line, Character: byteOffsetToChar(t.lines[line], byteOffset)}
+}
+
+// cellRange returns the range of the cell at zero-based row and col.
+func (t *csvTable) cellRange(row, col int) (Range, bool) {
+	if row < 0 || row >= len(t.starts) || col < 0 || col >= len(t.starts[row]) {
+		return Range{}, false
+	}
+	start := t.starts[row][col]
+	end := Position{Line: start.Line}
+	if col+1 < len(t.starts[row]) && t.starts[row][col+1].Line == start.Line {
+		// end before the comma
+		end.Character = t.starts[row][col+1].Character - 1
+	} else if start.Line < len(t.lines) {
+		end.Character = utf16Len(t.lines[start.Line])
+	}
+	return Range{Start: start, End: end}, true
+}
+
+// cellAt returns the zero-based row and col of the cell at pos.
+func (t *csvTable) cellAt(pos Position) (row, col int, ok bool) {
+	row = -1
+	for i, starts := range t.starts {
+		if len(starts) != 0 && starts[0].Line <= pos.Line {
+			row = i
+		}
+	}
+	if row < 0 {
+		return 0, 0, false
+	}
+	col = -1
+	for i, start := range t.starts[row] {
+		if start.Line < pos.Line || (start.Line == pos.Line && start.Character <= pos.Character) {
+			col = i
+		}
+	}
+	if col < 0 {
+		return 0, 0, false
+	}
+	return row, col, true
+}
+
+// cell returns the data of the cell at zero-based row and col.
+func (t *csvTable) cell(row, col int) string {
+	if row < 0 || row >= len(t.records) || col < 0 || col >= len(t.records[row]) {
+		return ""
+	}
+	return t.records[row][col]
+}
+
+// findNode returns the path (from the top-level map node to the target) of
+// the deepest node at pos in the document tree, e.g.: YAML.
+func findNode(doc *book.Node, pos Position, lines []string) []*book.Node {
+	var result []*book.Node
+	var walk func(node *book.Node, path []*book.Node)
+	walk = func(node *book.Node, path []*book.Node) {
+		for _, child := range node.Children {
+			childPath := append(path[:len(path):len(path)], child)
+			line := child.NamePos.Line - 1
+			if line == pos.Line && line < len(lines) &&
+				runeOffsetToChar(lines[line], child.NamePos.Column-1) <= pos.Character &&
+				len(childPath) >= len(result) {
+				result = childPath
+			}
+			walk(child, childPath)
+		}
+	}
+	for _, top := range doc.Children {
+		// the top-level map node is the sheet message itself
+		walk(top, nil)
+	}
+	return result
+}
+
+// byteOffsetToChar converts the byte offset in line to UTF-16 offset.
+func byteOffsetToChar(line string, byteOffset int) int {
+	if byteOffset > len(line) {
+		byteOffset = len(line)
+	}
+	if byteOffset < 0 {
+		byteOffset = 0
+	}
+	return utf16Len(line[:byteOffset])
+}
+
+// runeOffsetToChar converts the rune offset in line to UTF-16 offset.
+func runeOffsetToChar(line string, runeOffset int) int {
+	char := 0
+	for i, r := range []rune(line) {
+		if i >= runeOffset {
+			break
+		}
+		char += utf16RuneLen(r)
+	}
+	return char
+}
+
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += utf16RuneLen(r)
+	}
+	return n
+}
+
+func utf16RuneLen(r rune) int {
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,58 @@
+package lsp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+)
+
+func TestCSVTable(t *testing.T) {
+	doc := &document{
+		format:  format.CSV,
+		content: []byte("ID,Name,Desc\n1,\"a\nb\",x\n2,苹果,😀y\n"),
+	}
+	table := parseCSVTable(doc)
+	require.Len(t, table.records, 3)
+
+	rng, ok := table.cellRange(1, 2)
+	require.True(t, ok)
+	assert.Equal(t, Range{Start: Position{Line: 2, Character: 3}, End: Position{Line: 2, Character: 4}}, rng)
+	rng, ok = table.cellRange(2, 2)
+	require.True(t, ok)
+	assert.Equal(t, Range{Start: Position{Line: 3, Character: 5}, End: Position{Line: 3, Character: 8}}, rng)
+	_, ok = table.cellRange(3, 0)
+	assert.False(t, ok)
+
+	row, col, ok := table.cellAt(Position{Line: 2, Character: 0})
+	require.True(t, ok)
+	assert.Equal(t, []int{1, 1}, []int{row, col}, "in multi-line cell")
+	row, col, ok = table.cellAt(Position{Line: 3, Character: 6})
+	require.True(t, ok)
+	assert.Equal(t, []int{2, 2}, []int{row, col})
+	assert.Equal(t, "😀y", table.cell(row, col))
+}
+
+func TestFindNode(t *testing.T) {
+	doc := &document{
+		path:    "Hero.yaml",
+		format:  format.YAML,
+		content: []byte("\"@sheet\": HeroConf\nID: 1\nSkills:\n  - ID: 10\n    Level: 2\n"),
+	}
+	sheets, err := doc.sheets(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sheets, 1)
+	names := func(line, char int) []string {
+		var names []string
+		for _, node := range findNode(sheets[0].Document, Position{Line: line, Character: char}, doc.lines()) {
+			names = append(names, node.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"ID"}, names(1, 4))
+	assert.Equal(t, []string{"Skills", "", "ID"}, names(3, 8))
+	assert.Equal(t, []string{"Skills", "", "Level"}, names(4, 4))
+	assert.Empty(t, names(5, 0))
+}
@@ -0,0 +1,296 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/confgen"
+	"github.com/tableauio/tableau/internal/confgen/fieldprop"
+	"github.com/tableauio/tableau/internal/importer/book"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// fieldAt returns the sheet info and the field at pos in the document, and
+// whether pos is at a data cell (not the header) of table sheet.
+func (s *Server) fieldAt(doc *document, pos Position) (*confgen.SheetInfo, protoreflect.FieldDescriptor, bool) {
+	sheets, err := doc.sheets(s.ctx)
+	if err != nil {
+		return nil, nil, false
+	}
+	prFiles, err := s.loadProtoRegistryFiles()
+	if err != nil {
+		return nil, nil, false
+	}
+	bookName, err := doc.bookName(s.gen.InputDir)
+	if err != nil {
+		return nil, nil, false
+	}
+	infos, err := s.gen.BookSheetInfos(prFiles, bookName)
+	if err != nil {
+		return nil, nil, false
+	}
+	findInfo := func(sheetName string) *confgen.SheetInfo {
+		for _, info := range infos {
+			if info.SheetName() == sheetName {
+				return info
+			}
+		}
+		return nil
+	}
+	switch doc.format {
+	case format.CSV:
+		info := findInfo(sheets[0].Name)
+		if info == nil {
+			return nil, nil, false
+		}
+		t := parseCSVTable(doc)
+		row, col, ok := t.cellAt(pos)
+		if !ok {
+			return nil, nil, false
+		}
+		namerow := int(info.SheetOpts.GetNamerow())
+		if namerow <= 0 {
+			namerow = options.DefaultNameRow
+		}
+		datarow := int(info.SheetOpts.GetDatarow())
+		if datarow <= 0 {
+			datarow = options.DefaultDataRow
+		}
+		if info.SheetOpts.GetTranspose() {
+			row, col = col, row
+		}
+		var name string
+		if info.SheetOpts.GetTranspose() {
+			name = t.cell(col, namerow-1)
+		} else {
+			name = t.cell(namerow-1, col)
+		}
+		if nameline := int(info.SheetOpts.GetNameline()); nameline > 0 {
+			lines := strings.Split(name, "\n")
+			if nameline <= len(lines) {
+				name = lines[nameline-1]
+			}
+		}
+		fd := findTableField(info.MD, strings.TrimSpace(name))
+		return info, fd, row+1 >= datarow
+	case format.YAML:
+		for _, sheet := range sheets {
+			if sheet.Document == nil {
+				continue
+			}
+			path := findNode(sheet.Document, pos, doc.lines())
+			if len(path) == 0 {
+				continue
+			}
+			info := findInfo(sheet.Name)
+			if info == nil {
+				return nil, nil, false
+			}
+			return info, findDocumentField(info.MD, path), true
+		}
+	}
+	return nil, nil, false
+}
+
+// findTableField finds the field by the column name in table sheet. The
+// column name of a nested field is the concatenation of the names of all
+// its ancestors (with card index of list or map) and itself.
+func findTableField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if name == "" {
+		return nil
+	}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fieldOptions(fd).GetName() == name {
+			return fd
+		}
+	}
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fieldName := fieldOptions(fd).GetName()
+		if !strings.HasPrefix(name, fieldName) {
+			continue
+		}
+		// strip card index of list or map, e.g.: "Item1ID" -> "ID"
+		rest := strings.TrimLeft(name[len(fieldName):], "0123456789")
+		if rest == "" {
+			if fd.IsList() || fd.IsMap() {
+				return fd
+			}
+			continue
+		}
+		sub := fd.Message()
+		if fd.IsMap() {
+			sub = fd.MapValue().Message()
+		}
+		if sub == nil {
+			continue
+		}
+		if subField := findTableField(sub, rest); subField != nil {
+			return subField
+		}
+	}
+	return nil
+}
+
+// findDocumentField finds the field by the node path in document sheet. The
+// map keys and list elements in path are skipped.
+func findDocumentField(md protoreflect.MessageDescriptor, path []*book.Node) protoreflect.FieldDescriptor {
+	var fd protoreflect.FieldDescriptor
+	for _, node := range path {
+		if strings.HasPrefix(node.Name, book.MetaSign) {
+			return nil
+		}
+		if fd != nil {
+			switch {
+			case fd.IsMap():
+				// map key node: into map value
+				if fd.MapValue().Message() == nil {
+					return fd
+				}
+				md = fd.MapValue().Message()
+				fd = nil
+				continue
+			case fd.IsList() && node.Name == "":
+				// list element node
+				if fd.Message() == nil {
+					return fd
+				}
+				md = fd.Message()
+				fd = nil
+				continue
+			case fd.Message() != nil:
+				md = fd.Message()
+			default:
+				return nil
+			}
+		}
+		fd = findFieldByName(md, node.Name)
+		if fd == nil {
+			return nil
+		}
+	}
+	return fd
+}
+
+func findFieldByName(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fieldOptions(fd).GetName() == name {
+			return fd
+		}
+	}
+	return nil
+}
+
+func fieldOptions(fd protoreflect.FieldDescriptor) *tableaupb.FieldOptions {
+	opts, _ := fd.Options().(*descriptorpb.FieldOptions)
+	if opts == nil {
+		return nil
+	}
+	fieldOpts, _ := proto.GetExtension(opts, tableaupb.E_Field).(*tableaupb.FieldOptions)
+	return fieldOpts
+}
+
+// typeName returns the type name of field, e.g.: "[]int32", "map<uint32, Item>".
+func typeName(fd protoreflect.FieldDescriptor) string {
+	kindName := func(fd protoreflect.FieldDescriptor) string {
+		switch fd.Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			return string(fd.Message().Name())
+		case protoreflect.EnumKind:
+			return string(fd.Enum().Name())
+		default:
+			return fd.Kind().String()
+		}
+	}
+	switch {
+	case fd.IsMap():
+		return fmt.Sprintf("map<%s, %s>", kindName(fd.MapKey()), kindName(fd.MapValue()))
+	case fd.IsList():
+		return "[]" + kindName(fd)
+	default:
+		return kindName(fd)
+	}
+}
+
+// hoverAt returns the hover info of the field at pos, with the field note.
+func (s *Server) hoverAt(doc *document, pos Position) *Hover {
+	_, fd, _ := s.fieldAt(doc, pos)
+	if fd == nil {
+		return nil
+	}
+	opts := fieldOptions(fd)
+	value := fmt.Sprintf("**%s** `%s`", opts.GetName(), typeName(fd))
+	if note := opts.GetNote(); note != "" {
+		value += "\n\n" + note
+	}
+	if refer := opts.GetProp().GetRefer(); refer != "" {
+		value += "\n\nrefer: `" + refer + "`"
+	}
+	return &Hover{Contents: MarkupContent{Kind: "markdown", Value: value}}
+}
+
+// completeAt returns the completions of the field value at pos: enum values
+// of enum field, and referred values of field with refer prop.
+func (s *Server) completeAt(ctx context.Context, doc *document, pos Position) *CompletionList {
+	list := &CompletionList{Items: []CompletionItem{}}
+	info, fd, isData := s.fieldAt(doc, pos)
+	if fd == nil || !isData {
+		return list
+	}
+	valueFd := fd
+	if fd.IsMap() {
+		valueFd = fd.MapValue()
+	}
+	if ed := valueFd.Enum(); ed != nil {
+		values := ed.Values()
+		for i := 0; i < values.Len(); i++ {
+			evd := values.Get(i)
+			list.Items = append(list.Items, CompletionItem{
+				Label:  string(evd.Name()),
+				Kind:   CompletionItemKindEnumMember,
+				Detail: string(ed.Name()),
+			})
+			evOpts, _ := evd.Options().(*descriptorpb.EnumValueOptions)
+			if evOpts == nil {
+				continue
+			}
+			if alias := proto.GetExtension(evOpts, tableaupb.E_Evalue).(*tableaupb.EnumValueOptions).GetName(); alias != "" {
+				list.Items = append(list.Items, CompletionItem{
+					Label:  alias,
+					Kind:   CompletionItemKindEnumMember,
+					Detail: fmt.Sprintf("%s (%s)", ed.Name(), evd.Name()),
+				})
+			}
+		}
+	}
+	if prop := fieldOptions(fd).GetProp(); prop.GetRefer() != "" {
+		values, err := fieldprop.ReferredValues(ctx, prop, &fieldprop.Input{
+			ProtoPackage:   s.gen.ProtoPackage,
+			InputDir:       s.gen.InputDir,
+			SubdirRewrites: s.gen.InputOpt.SubdirRewrites,
+			PRFiles:        info.ExtInfo.PRFiles,
+		})
+		if err != nil {
+			log.Debugf("lsp: load referred values of %s failed: %s", prop.GetRefer(), err)
+		}
+		for _, value := range values {
+			list.Items = append(list.Items, CompletionItem{
+				Label:  value,
+				Kind:   CompletionItemKindValue,
+				Detail: prop.GetRefer(),
+			})
+		}
+	}
+	return list
+}
@@ -0,0 +1,301 @@
+// Package lsp implements a language server (LSP over stdio) for workbooks in
+// text formats: CSV, YAML, and XML. It publishes diagnostics by running the
+// confgen sheet parsers, and offers hover info and completions based on the
+// proto registry.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/confgen"
+	"github.com/tableauio/tableau/internal/importer/metasheet"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Server is a language server of workbooks.
+type Server struct {
+	ctx     context.Context
+	gen     *confgen.Generator
+	version string
+
+	conn     *conn
+	prFiles  *protoregistry.Files // proto registry, loaded lazily.
+	docs     map[string]*document // document uri -> document
+	shutdown bool                 // shutdown requested
+	handlers map[string]func(*request) (any, error)
+}
+
+// NewServer creates a new language server. The proto files and input
+// settings are specified by options.ConfInputOption.
+func NewServer(protoPackage, indir string, opts *options.Options) *Server {
+	if absIndir, err := filepath.Abs(indir); err == nil {
+		indir = absIndir
+	}
+	metasheetName := metasheet.DefaultMetasheetName
+	if opts.Proto != nil && opts.Proto.Input != nil {
+		metasheetName = opts.Proto.Input.MetasheetName
+	}
+	s := &Server{
+		ctx:  metasheet.NewContext(context.Background(), &metasheet.Metasheet{Name: metasheetName}),
+		gen:  confgen.NewGeneratorWithOptions(protoPackage, indir, "", opts),
+		docs: map[string]*document{},
+	}
+	s.handlers = map[string]func(*request) (any, error){
+		"initialize":              s.initialize,
+		"initialized":             noop,
+		"shutdown":                s.handleShutdown,
+		"textDocument/didOpen":    s.didOpen,
+		"textDocument/didChange":  s.didChange,
+		"textDocument/didSave":    s.didSave,
+		"textDocument/didClose":   s.didClose,
+		"textDocument/hover":      s.hover,
+		"textDocument/completion": s.completion,
+	}
+	return s
+}
+
+// SetVersion sets the server version reported to the client.
+func (s *Server) SetVersion(version string) {
+	s.version = version
+}
+
+// Serve serves the client over r and w (e.g.: stdin and stdout) until the
+// exit notification is received or the stream is closed.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	for {
+		req, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			var rerr *responseError
+			if errors.As(err, &rerr) {
+				log.Errorf("lsp: %s", rerr)
+				continue
+			}
+			return err
+		}
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("exit without shutdown")
+			}
+			return nil
+		}
+		handler := s.handlers[req.Method]
+		if req.ID == nil {
+			// notification: no response
+			if handler != nil {
+				if _, err := handler(req); err != nil {
+					log.Errorf("lsp: %s failed: %s", req.Method, err)
+				}
+			}
+			continue
+		}
+		var result any
+		if handler == nil {
+			err = &responseError{Code: codeMethodNotFound, Message: "method not found: " + req.Method}
+		} else {
+			result, err = handler(req)
+		}
+		if err := s.conn.reply(req.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func noop(*request) (any, error) {
+	return nil, nil
+}
+
+func unmarshalParams(req *request, params any) error {
+	if err := json.Unmarshal(req.Params, params); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) initialize(*request) (any, error) {
+	return &InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync: &TextDocumentSyncOptions{
+				OpenClose: true,
+				Change:    TextDocumentSyncKindFull,
+				Save:      &SaveOptions{},
+			},
+			HoverProvider:      true,
+			CompletionProvider: &CompletionOptions{TriggerCharacters: []string{",", ":", " "}},
+		},
+		ServerInfo: &ServerInfo{Name: "tableauc", Version: s.version},
+	}, nil
+}
+
+func (s *Server) handleShutdown(*request) (any, error) {
+	s.shutdown = true
+	return nil, nil
+}
+
+func (s *Server) didOpen(req *request) (any, error) {
+	var params DidOpenTextDocumentParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+	doc := s.newDocument(params.TextDocument.URI, params.TextDocument.Text)
+	if doc == nil {
+		return nil, nil
+	}
+	s.docs[doc.uri] = doc
+	return nil, s.publishDiagnostics(doc)
+}
+
+func (s *Server) didChange(req *request) (any, error) {
+	var params DidChangeTextDocumentParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+	if len(params.ContentChanges) == 0 {
+		return nil, nil
+	}
+	// full sync: the last change is the full content
+	text := params.ContentChanges[len(params.ContentChanges)-1].Text
+	doc := s.newDocument(params.TextDocument.URI, text)
+	if doc == nil {
+		return nil, nil
+	}
+	s.docs[doc.uri] = doc
+	return nil, s.publishDiagnostics(doc)
+}
+
+func (s *Server) didSave(req *request) (any, error) {
+	var params DidSaveTextDocumentParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(params.TextDocument.URI, ".proto") {
+		// reload proto registry and re-check all open documents
+		s.prFiles = nil
+		for _, doc := range s.docs {
+			if err := s.publishDiagnostics(doc); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+	if doc := s.docs[params.TextDocument.URI]; doc != nil {
+		return nil, s.publishDiagnostics(doc)
+	}
+	return nil, nil
+}
+
+func (s *Server) didClose(req *request) (any, error) {
+	var params DidCloseTextDocumentParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+	if _, ok := s.docs[params.TextDocument.URI]; !ok {
+		return nil, nil
+	}
+	delete(s.docs, params.TextDocument.URI)
+	// clear diagnostics of the closed document
+	return nil, s.conn.notify("textDocument/publishDiagnostics", &PublishDiagnosticsParams{
+		URI:         params.TextDocument.URI,
+		Diagnostics: []Diagnostic{},
+	})
+}
+
+func (s *Server) hover(req *request) (any, error) {
+	var params TextDocumentPositionParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+	doc := s.docs[params.TextDocument.URI]
+	if doc == nil {
+		return nil, nil
+	}
+	return s.hoverAt(doc, params.Position), nil
+}
+
+func (s *Server) completion(req *request) (any, error) {
+	var params TextDocumentPositionParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+	doc := s.docs[params.TextDocument.URI]
+	if doc == nil {
+		return nil, nil
+	}
+	return s.completeAt(context.Background(), doc, params.Position), nil
+}
+
+func (s *Server) publishDiagnostics(doc *document) error {
+	return s.conn.notify("textDocument/publishDiagnostics", &PublishDiagnosticsParams{
+		URI:         doc.uri,
+		Diagnostics: s.diagnose(doc),
+	})
+}
+
+// loadProtoRegistryFiles loads the proto registry if not loaded.
+func (s *Server) loadProtoRegistryFiles() (*protoregistry.Files, error) {
+	if s.prFiles != nil {
+		return s.prFiles, nil
+	}
+	prFiles, err := s.gen.LoadProtoRegistryFiles()
+	if err != nil {
+		return nil, err
+	}
+	s.prFiles = prFiles
+	return prFiles, nil
+}
+
+// newDocument creates a document from uri and text. It returns nil if the
+// document is not a workbook in supported formats.
+func (s *Server) newDocument(uri, text string) *document {
+	path, err := uriToPath(uri)
+	if err != nil {
+		log.Debugf("lsp: %s", err)
+		return nil
+	}
+	fmt := format.GetFormat(path)
+	switch fmt {
+	case format.CSV, format.YAML, format.XML:
+	default:
+		return nil
+	}
+	return &document{
+		uri:     uri,
+		path:    path,
+		format:  fmt,
+		content: []byte(text),
+	}
+}
+
+// uriToPath converts a "file" uri to file path.
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", errors.New("unsupported uri scheme: " + uri)
+	}
+	path := u.Path
+	if u.Fragment != "" {
+		// unescaped "#" in CSV filename, e.g.: "Item#ItemConf.csv"
+		path += "#" + u.Fragment
+	}
+	if runtime.GOOS == "windows" {
+		// e.g.: file:///C:/path -> C:/path
+		path = strings.TrimPrefix(path, "/")
+	}
+	return filepath.FromSlash(path), nil
+}
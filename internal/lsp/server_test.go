@@ -0,0 +1,173 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/options"
+)
+
+func newTestServer() *Server {
+	opts := options.NewDefault()
+	opts.Conf.Input.ProtoPaths = []string{"testdata/proto"}
+	opts.Conf.Input.ProtoFiles = []string{"testdata/proto/*.proto"}
+	return NewServer("lsptest", "testdata/workbook", opts)
+}
+
+// session encodes client messages, serves them, and decodes server messages.
+type session struct {
+	t     *testing.T
+	input bytes.Buffer
+	id    int
+}
+
+func (s *session) send(method string, params any, isRequest bool) {
+	msg := map[string]any{"jsonrpc": "2.0", "method": method, "params": params}
+	if isRequest {
+		s.id++
+		msg["id"] = s.id
+	}
+	data, err := json.Marshal(msg)
+	require.NoError(s.t, err)
+	fmt.Fprintf(&s.input, "Content-Length: %d\r\n\r\n%s", len(data), data)
+}
+
+type serverMessage struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *responseError  `json:"error"`
+}
+
+func (s *session) serve(server *Server) []*serverMessage {
+	var output bytes.Buffer
+	require.NoError(s.t, server.Serve(&s.input, &output))
+	r := textproto.NewReader(bufio.NewReader(&output))
+	var msgs []*serverMessage
+	for {
+		header, err := r.ReadMIMEHeader()
+		if err == io.EOF {
+			return msgs
+		}
+		require.NoError(s.t, err)
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		require.NoError(s.t, err)
+		data := make([]byte, length)
+		_, err = io.ReadFull(r.R, data)
+		require.NoError(s.t, err)
+		msg := &serverMessage{}
+		require.NoError(s.t, json.Unmarshal(data, msg))
+		msgs = append(msgs, msg)
+	}
+}
+
+func fileURI(t *testing.T, path string) string {
+	absPath, err := filepath.Abs(path)
+	require.NoError(t, err)
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(absPath)}).String()
+}
+
+func TestServer(t *testing.T) {
+	csvURI := fileURI(t, "testdata/workbook/Item#ItemConf.csv")
+	yamlURI := fileURI(t, "testdata/workbook/Hero.yaml")
+	yamlText, err := os.ReadFile("testdata/workbook/Hero.yaml")
+	require.NoError(t, err)
+
+	s := &session{t: t}
+	s.send("initialize", map[string]any{}, true)
+	s.send("initialized", map[string]any{}, false)
+	// unsaved content with an invalid enum value
+	s.send("textDocument/didOpen", map[string]any{
+		"textDocument": map[string]any{"uri": csvURI, "languageId": "csv", "version": 1,
+			"text": "ID,Type\nuint32,enum<.ItemType>\nItem's ID,Item's type\n1,Fruit\n2,Unknown\n"},
+	}, false)
+	s.send("textDocument/hover", map[string]any{
+		"textDocument": map[string]any{"uri": csvURI},
+		"position":     map[string]any{"line": 3, "character": 3},
+	}, true)
+	s.send("textDocument/completion", map[string]any{
+		"textDocument": map[string]any{"uri": csvURI},
+		"position":     map[string]any{"line": 4, "character": 2},
+	}, true)
+	s.send("textDocument/didOpen", map[string]any{
+		"textDocument": map[string]any{"uri": yamlURI, "languageId": "yaml", "version": 1, "text": string(yamlText)},
+	}, false)
+	s.send("textDocument/completion", map[string]any{
+		"textDocument": map[string]any{"uri": yamlURI},
+		"position":     map[string]any{"line": 2, "character": 8},
+	}, true)
+	s.send("unknown/method", map[string]any{}, true)
+	s.send("shutdown", nil, true)
+	s.send("exit", nil, false)
+	msgs := s.serve(newTestServer())
+	require.Len(t, msgs, 8)
+
+	// initialize
+	var initResult InitializeResult
+	require.NoError(t, json.Unmarshal(msgs[0].Result, &initResult))
+	assert.True(t, initResult.Capabilities.HoverProvider)
+	assert.Equal(t, TextDocumentSyncKindFull, initResult.Capabilities.TextDocumentSync.Change)
+
+	// diagnostics of CSV
+	assert.Equal(t, "textDocument/publishDiagnostics", msgs[1].Method)
+	var diagParams PublishDiagnosticsParams
+	require.NoError(t, json.Unmarshal(msgs[1].Params, &diagParams))
+	assert.Equal(t, csvURI, diagParams.URI)
+	require.Len(t, diagParams.Diagnostics, 1)
+	diag := diagParams.Diagnostics[0]
+	assert.Equal(t, Range{Start: Position{Line: 4, Character: 2}, End: Position{Line: 4, Character: 9}}, diag.Range)
+	assert.NotEmpty(t, diag.Code)
+	assert.Equal(t, diagnosticSource, diag.Source)
+
+	// hover of CSV
+	var hover Hover
+	require.NoError(t, json.Unmarshal(msgs[2].Result, &hover))
+	assert.Equal(t, "**Type** `ItemType`\n\nItem's type", hover.Contents.Value)
+
+	// completion of enum values
+	var list CompletionList
+	require.NoError(t, json.Unmarshal(msgs[3].Result, &list))
+	var labels []string
+	for _, item := range list.Items {
+		labels = append(labels, item.Label)
+	}
+	assert.Equal(t, []string{"ITEM_TYPE_UNKNOWN", "ITEM_TYPE_FRUIT", "Fruit", "ITEM_TYPE_EQUIP", "Equip"}, labels)
+
+	// diagnostics of YAML
+	require.NoError(t, json.Unmarshal(msgs[4].Params, &diagParams))
+	assert.Equal(t, yamlURI, diagParams.URI)
+	assert.Empty(t, diagParams.Diagnostics)
+
+	// completion of refer targets
+	require.NoError(t, json.Unmarshal(msgs[5].Result, &list))
+	labels = nil
+	for _, item := range list.Items {
+		labels = append(labels, item.Label)
+	}
+	assert.Equal(t, []string{"1", "2"}, labels)
+
+	// unknown method
+	require.NotNil(t, msgs[6].Error)
+	assert.Equal(t, codeMethodNotFound, msgs[6].Error.Code)
+
+	// shutdown
+	assert.Nil(t, msgs[7].Error)
+}
+
+func TestServer_ExitWithoutShutdown(t *testing.T) {
+	s := &session{t: t}
+	s.send("exit", nil, false)
+	assert.Error(t, newTestServer().Serve(&s.input, io.Discard))
+}
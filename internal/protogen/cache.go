@@ -0,0 +1,115 @@
+package protogen
+
+import (
+	"fmt"
+
+	"github.com/tableauio/tableau/internal/buildcache"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
+)
+
+// cacheName is the name of protogen's build cache in the cache dir.
+const cacheName = "protogen"
+
+// cacheSalt returns the hash of generator settings, which is mixed into the
+// input hash of each workbook, so that any setting change invalidates all
+// cached workbooks.
+func cacheSalt(protoPackage, indir, outdir string, opts *options.Options) (string, error) {
+	h := buildcache.NewHasher()
+	h.WriteString(cacheName, Version, protoPackage, indir, outdir, opts.LocationName)
+	if err := h.WriteJSON([]any{opts.Acronyms, opts.Proto}); err != nil {
+		return "", xerrors.Wrapf(err, "failed to hash generator settings")
+	}
+	return h.Sum(), nil
+}
+
+// openCache opens the build cache if enabled.
+func (gen *Generator) openCache() error {
+	if gen.CacheOpt == nil || !gen.CacheOpt.Enable {
+		return nil
+	}
+	if gen.cacheSaltErr != nil {
+		log.Warnf("%15s: disabled as %v", "build cache", gen.cacheSaltErr)
+		return nil
+	}
+	cache, err := buildcache.Open(gen.CacheOpt.Dir, cacheName)
+	if err != nil {
+		return err
+	}
+	gen.cache = cache
+	return nil
+}
+
+// saveCache saves the build cache if enabled.
+func (gen *Generator) saveCache() error {
+	if gen.cache == nil {
+		return nil
+	}
+	if hits := gen.cache.Hits(); hits > 0 {
+		log.Infof("%15s: %d unchanged book(s) skipped", "build cache", hits)
+	}
+	return gen.cache.Save()
+}
+
+// bookCacheInput returns the input hash of a workbook, which consists of:
+//   - generator settings
+//   - contents of all workbook files
+//   - imported proto files (deps), including the descriptors of predefined
+//     proto files and the type infos parsed from struct/enum type sheets
+//     of other workbooks in the first pass.
+func (gen *Generator) bookCacheInput(absPath string, deps []string) (string, error) {
+	h := buildcache.NewHasher()
+	h.WriteString(gen.cacheSalt)
+	if err := h.WriteWorkbook(absPath); err != nil {
+		return "", err
+	}
+	for _, dep := range deps {
+		h.WriteString(dep)
+		if fd, err := gen.ProtoRegistryFiles.FindFileByPath(dep); err == nil {
+			if err := h.WriteFileDescriptor(fd); err != nil {
+				return "", err
+			}
+		}
+		for _, info := range gen.typeInfos.ListByParentFilename(dep) {
+			h.WriteString(string(info.FullName), fmt.Sprint(info.Kind), info.FirstFieldOptionName)
+		}
+	}
+	return h.Sum(), nil
+}
+
+// hitBookCache reports whether the workbook is unchanged since last
+// generation, and restores its generated proto file if needed.
+func (gen *Generator) hitBookCache(key, absPath string, checkProtoFileConflicts bool) (bool, error) {
+	entry := gen.cache.Get(key)
+	if entry == nil {
+		return false, nil
+	}
+	input, err := gen.bookCacheInput(absPath, entry.Deps)
+	if err != nil {
+		return false, err
+	}
+	if input != entry.Input {
+		return false, nil
+	}
+	if checkProtoFileConflicts {
+		for path := range entry.Outputs {
+			if existed, err := xfs.Exists(path); err != nil {
+				return false, xerrors.WrapKV(err)
+			} else if existed {
+				return false, xerrors.Newf("file already exists: %s", path)
+			}
+		}
+	}
+	return gen.cache.Hit(key, input), nil
+}
+
+// putBookCache records the workbook and its generated proto file.
+func (gen *Generator) putBookCache(key, absPath string, be *bookExporter) error {
+	input, err := gen.bookCacheInput(absPath, be.Imports)
+	if err != nil {
+		return err
+	}
+	return gen.cache.Put(key, input, be.Imports, []string{be.GetProtoFileAbsPath()})
+}
@@ -0,0 +1,74 @@
+package protogen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/options"
+)
+
+func newCacheTestGenerator(indir, outdir, cacheDir string) *Generator {
+	return NewGenerator("cachetest", indir, outdir,
+		options.Proto(
+			&options.ProtoOption{
+				Input: &options.ProtoInputOption{
+					Formats: []format.Format{format.CSV},
+				},
+				Output: &options.ProtoOutputOption{},
+			},
+		),
+		options.Cache(&options.CacheOption{Enable: true, Dir: cacheDir}),
+	)
+}
+
+func TestGenerator_Cache(t *testing.T) {
+	indir := t.TempDir()
+	outdir := t.TempDir()
+	cacheDir := t.TempDir()
+	require.NoError(t, os.CopyFS(indir, os.DirFS("./testdata/cache")))
+
+	generate := func() (int, error) {
+		gen := newCacheTestGenerator(indir, outdir, cacheDir)
+		err := gen.Generate()
+		return gen.cache.Hits(), err
+	}
+
+	hits, err := generate()
+	require.NoError(t, err)
+	assert.Equal(t, 0, hits, "first build")
+	itemPath := filepath.Join(outdir, "item.proto")
+	expected, err := os.ReadFile(itemPath)
+	require.NoError(t, err)
+
+	hits, err = generate()
+	require.NoError(t, err)
+	assert.Equal(t, 3, hits, "all workbooks are unchanged")
+	// proto files are deleted before generation, and then restored from cache
+	actual, err := os.ReadFile(itemPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+
+	heroPath := filepath.Join(indir, "Hero#HeroConf.csv")
+	require.NoError(t, os.WriteFile(heroPath, []byte("ID,Name,Level\n\"map<uint32, Hero>\",string,int32\n"), 0644))
+	hits, err = generate()
+	require.NoError(t, err)
+	assert.Equal(t, 2, hits, "only changed workbook is rebuilt")
+	data, err := os.ReadFile(filepath.Join(outdir, "hero.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "int32 level = 3")
+
+	// ItemConf imports the enum type FruitType of workbook Common, so it is
+	// invalidated (and failed to be parsed) when the enum type is renamed.
+	commonPath := filepath.Join(indir, "Common#Enum.csv")
+	data, err = os.ReadFile(commonPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(commonPath, []byte(strings.Replace(string(data), "FruitType", "FruitKind", 1)), 0644))
+	_, err = generate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "FruitType")
+}
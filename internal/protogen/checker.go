@@ -0,0 +1,458 @@
+package protogen
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/confgen"
+	"github.com/tableauio/tableau/internal/importer"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/tableauio/tableau/internal/x/xproto"
+	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Check checks breaking changes between the previously generated proto files
+// in output dir and the newly generated ones from the workbooks. The new
+// proto files are generated into a temporary dir, so the output dir keeps
+// untouched.
+//
+// The following wire-incompatible changes are reported:
+//   - messager removed or renamed
+//   - field type changed (including cardinality and map key type)
+//   - field number changed
+//   - field removed without reserving its number
+//   - enum value removed without reserving its number
+func (gen *Generator) Check(relWorkbookPaths ...string) error {
+	outdir := filepath.Join(gen.OutputDir, gen.OutputOpt.Subdir)
+	tmpdir, err := os.MkdirTemp("", "tableau-check-")
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to create temp dir")
+	}
+	defer os.RemoveAll(tmpdir)
+	newOutdir := filepath.Join(tmpdir, gen.OutputOpt.Subdir)
+	// NOTE: the previously generated proto files are also needed by the
+	// new generation, e.g.: preserveFieldNumbers and advanced first-pass mode.
+	if err := copyProtoFiles(outdir, newOutdir); err != nil {
+		return err
+	}
+	newGen, err := gen.rebase(tmpdir, outdir, newOutdir)
+	if err != nil {
+		return err
+	}
+	log.Infof("%15s: generating new proto files into %s", "check", newOutdir)
+	if err := newGen.Generate(relWorkbookPaths...); err != nil {
+		return err
+	}
+	oldFiles, err := gen.parseProtoRegistryFiles(true)
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to parse previously generated proto files")
+	}
+	newFiles, err := newGen.parseProtoRegistryFiles(true)
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to parse newly generated proto files")
+	}
+	return newChecker(newGen).check(oldFiles, newFiles)
+}
+
+// rebase creates a new generator whose output dir is newOutputDir. The proto
+// paths and proto files in olddir are also rebased to newdir.
+func (gen *Generator) rebase(newOutputDir, olddir, newdir string) (*Generator, error) {
+	inputOpt := *gen.InputOpt
+	inputOpt.ProtoPaths = nil
+	for _, path := range gen.InputOpt.ProtoPaths {
+		inputOpt.ProtoPaths = append(inputOpt.ProtoPaths, rebasePath(path, olddir, newdir))
+	}
+	inputOpt.ProtoFiles = nil
+	for _, path := range gen.InputOpt.ProtoFiles {
+		inputOpt.ProtoFiles = append(inputOpt.ProtoFiles, rebasePath(path, olddir, newdir))
+	}
+	newGen := &Generator{
+		ctx:          gen.ctx,
+		ProtoPackage: gen.ProtoPackage,
+		InputDir:     gen.InputDir,
+		OutputDir:    newOutputDir,
+		LocationName: gen.LocationName,
+		InputOpt:     &inputOpt,
+		OutputOpt:    gen.OutputOpt,
+		typeInfos:    xproto.NewTypeInfos(gen.ProtoPackage),
+		collector:    xerrors.NewCollector(maxErrors),
+
+		cachedImporters: make(map[string]importer.Importer),
+	}
+	registryFiles, err := newGen.parseProtoRegistryFiles(false)
+	if err != nil {
+		return nil, err
+	}
+	newGen.ProtoRegistryFiles = registryFiles
+	newGen.ProtoRegistryTypes = dynamicpb.NewTypes(registryFiles)
+	return newGen, nil
+}
+
+// rebasePath rebases path from olddir to newdir if path is in olddir,
+// otherwise returns the path as it is.
+func rebasePath(path, olddir, newdir string) string {
+	absPath, err1 := filepath.Abs(path)
+	absOlddir, err2 := filepath.Abs(olddir)
+	if err1 != nil || err2 != nil {
+		return path
+	}
+	rel, err := filepath.Rel(absOlddir, absPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return path
+	}
+	return filepath.Join(newdir, rel)
+}
+
+// copyProtoFiles copies all proto files in srcdir (recursively) to dstdir.
+func copyProtoFiles(srcdir, dstdir string) error {
+	if err := os.MkdirAll(dstdir, xfs.DefaultDirPerm); err != nil {
+		return xerrors.WrapKV(err, xerrors.KeyOutdir, dstdir)
+	}
+	existed, err := xfs.Exists(srcdir)
+	if err != nil {
+		return xerrors.WrapKV(err, xerrors.KeyOutdir, srcdir)
+	}
+	if !existed {
+		return nil
+	}
+	return filepath.WalkDir(srcdir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to walk %s", path)
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".proto") {
+			return nil
+		}
+		rel, err := filepath.Rel(srcdir, path)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to get relative path of %s", path)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to read file: %s", path)
+		}
+		dst := filepath.Join(dstdir, rel)
+		if err := os.MkdirAll(filepath.Dir(dst), xfs.DefaultDirPerm); err != nil {
+			return xerrors.WrapKV(err, xerrors.KeyOutdir, filepath.Dir(dst))
+		}
+		if err := os.WriteFile(dst, content, xfs.DefaultFilePerm); err != nil {
+			return xerrors.Wrapf(err, "failed to write file: %s", dst)
+		}
+		return nil
+	})
+}
+
+// checker checks breaking changes between the old and new proto files.
+type checker struct {
+	gen       *Generator // generator of the new proto files
+	collector *xerrors.Collector
+	importers map[string]importer.Importer // workbook name -> importer
+}
+
+func newChecker(gen *Generator) *checker {
+	c := &checker{
+		gen:       gen,
+		collector: xerrors.NewCollector(0), // collect all breaking changes
+		importers: make(map[string]importer.Importer),
+	}
+	// NOTE: table importers are cached by generator, which are used to
+	// locate the responsible workbook cells.
+	for absPath, imp := range gen.cachedImporters {
+		relPath, err := getRelCleanSlashPath(gen.InputDir, filepath.Dir(absPath), filepath.Base(imp.Filename()))
+		if err != nil {
+			continue
+		}
+		c.importers[xfs.RewriteSubdir(relPath, gen.InputOpt.SubdirRewrites)] = imp
+	}
+	return c
+}
+
+// location is the location of a field in workbook.
+type location struct {
+	bookName  string
+	sheetName string
+	header    *tableHeader // nil if not a table sheet
+	prefix    string       // column name prefix
+	column    string       // if set, all fields are in this column, e.g.: incell struct
+}
+
+func (c *checker) check(oldFiles, newFiles *protoregistry.Files) error {
+	oldBooks := c.collectBooks(oldFiles)
+	newBooks := c.collectBooks(newFiles)
+	bookNames := make([]string, 0, len(oldBooks))
+	for name := range oldBooks {
+		bookNames = append(bookNames, name)
+	}
+	sort.Strings(bookNames)
+	for _, bookName := range bookNames {
+		oldFd := oldBooks[bookName]
+		newFd, ok := newBooks[bookName]
+		if !ok {
+			// the whole workbook is removed
+			c.rangeSheets(oldFd, func(md protoreflect.MessageDescriptor, sheetOpts *tableaupb.WorksheetOptions) {
+				c.collect(xerrors.E1000(string(md.Name()), sheetOpts.Name), &location{bookName: bookName, sheetName: sheetOpts.Name}, "")
+			})
+			continue
+		}
+		c.checkFile(bookName, oldFd, newFd)
+	}
+	return c.collector.Join()
+}
+
+// collectBooks collects proto files generated from workbooks.
+func (c *checker) collectBooks(files *protoregistry.Files) map[string]protoreflect.FileDescriptor {
+	books := make(map[string]protoreflect.FileDescriptor)
+	files.RangeFilesByPackage(protoreflect.FullName(c.gen.ProtoPackage), func(fd protoreflect.FileDescriptor) bool {
+		if _, workbook := confgen.ParseFileOptions(fd); workbook != nil {
+			books[workbook.Name] = fd
+		}
+		return true
+	})
+	return books
+}
+
+func (c *checker) rangeSheets(fd protoreflect.FileDescriptor, f func(md protoreflect.MessageDescriptor, sheetOpts *tableaupb.WorksheetOptions)) {
+	for i := 0; i < fd.Messages().Len(); i++ {
+		md := fd.Messages().Get(i)
+		if _, sheetOpts := confgen.ParseMessageOptions(md); sheetOpts != nil {
+			f(md, sheetOpts)
+		}
+	}
+}
+
+func (c *checker) checkFile(bookName string, oldFd, newFd protoreflect.FileDescriptor) {
+	_, newBookOpts := confgen.ParseFileOptions(newFd)
+	newSheets := make(map[string]protoreflect.MessageDescriptor) // sheet name -> message
+	c.rangeSheets(newFd, func(md protoreflect.MessageDescriptor, sheetOpts *tableaupb.WorksheetOptions) {
+		newSheets[sheetOpts.Name] = md
+	})
+	visited := make(map[protoreflect.FullName]bool)
+	c.rangeSheets(oldFd, func(oldMd protoreflect.MessageDescriptor, sheetOpts *tableaupb.WorksheetOptions) {
+		loc := &location{bookName: bookName, sheetName: sheetOpts.Name}
+		newMd := newFd.Messages().ByName(oldMd.Name())
+		if newMd == nil || !proto.HasExtension(newMd.Options(), tableaupb.E_Worksheet) {
+			if md, ok := newSheets[sheetOpts.Name]; ok {
+				c.collect(xerrors.E1001(string(oldMd.Name()), string(md.Name())), loc, "")
+			} else {
+				c.collect(xerrors.E1000(string(oldMd.Name()), sheetOpts.Name), loc, "")
+			}
+			return
+		}
+		_, newSheetOpts := confgen.ParseMessageOptions(newMd)
+		loc.sheetName = newSheetOpts.Name
+		loc.header = c.getTableHeader(bookName, newBookOpts, newSheetOpts)
+		c.checkMessage(loc, oldMd, newMd, visited)
+	})
+	// predefined types in workbook, e.g.: enum type and struct type sheets
+	for i := 0; i < oldFd.Enums().Len(); i++ {
+		oldEd := oldFd.Enums().Get(i)
+		if newEd := newFd.Enums().ByName(oldEd.Name()); newEd != nil {
+			opts := proto.GetExtension(newEd.Options(), tableaupb.E_Etype).(*tableaupb.EnumOptions)
+			c.checkEnum(&location{bookName: bookName, sheetName: opts.GetName()}, oldEd, newEd, visited)
+		}
+	}
+	for i := 0; i < oldFd.Messages().Len(); i++ {
+		oldMd := oldFd.Messages().Get(i)
+		if proto.HasExtension(oldMd.Options(), tableaupb.E_Worksheet) {
+			continue
+		}
+		if newMd := newFd.Messages().ByName(oldMd.Name()); newMd != nil {
+			opts := proto.GetExtension(newMd.Options(), tableaupb.E_Struct).(*tableaupb.StructOptions)
+			c.checkMessage(&location{bookName: bookName, sheetName: opts.GetName()}, oldMd, newMd, visited)
+		}
+	}
+}
+
+func (c *checker) getTableHeader(bookName string, bookOpts *tableaupb.WorkbookOptions, sheetOpts *tableaupb.WorksheetOptions) *tableHeader {
+	imp := c.importers[bookName]
+	if imp == nil {
+		return nil
+	}
+	sheet := imp.GetSheet(sheetOpts.Name)
+	if sheet == nil || sheet.Table == nil {
+		return nil
+	}
+	return newTableHeader(sheetOpts, bookOpts, c.gen.InputOpt.Header, sheet.Tabler())
+}
+
+func (c *checker) checkMessage(loc *location, oldMd, newMd protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) {
+	if visited[oldMd.FullName()] {
+		return
+	}
+	visited[oldMd.FullName()] = true
+	for i := 0; i < oldMd.Fields().Len(); i++ {
+		oldFd := oldMd.Fields().Get(i)
+		newFd := newMd.Fields().ByName(oldFd.Name())
+		if newFd == nil {
+			// field renamed with the same number, or removed
+			newFd = newMd.Fields().ByNumber(oldFd.Number())
+			if newFd == nil || oldMd.Fields().ByName(newFd.Name()) != nil {
+				// NOTE: the number reused by another existing field is
+				// also treated as removed.
+				if !newMd.ReservedRanges().Has(oldFd.Number()) {
+					c.collect(xerrors.E1005(string(oldFd.Name()), int32(oldFd.Number())), loc, loc.columnName(oldFd))
+				}
+				continue
+			}
+		} else if newFd.Number() != oldFd.Number() {
+			c.collect(xerrors.E1004(string(newFd.Name()), int32(oldFd.Number()), int32(newFd.Number())), loc, loc.columnName(newFd))
+			continue
+		}
+		c.checkField(loc, oldFd, newFd, visited)
+	}
+}
+
+func (c *checker) checkField(loc *location, oldFd, newFd protoreflect.FieldDescriptor, visited map[protoreflect.FullName]bool) {
+	column := loc.columnName(newFd)
+	if oldFd.IsMap() && newFd.IsMap() && oldFd.MapKey().Kind() != newFd.MapKey().Kind() {
+		// NOTE: go on checking the map value type.
+		c.collect(xerrors.E1003(string(newFd.Name()), oldFd.MapKey().Kind().String(), newFd.MapKey().Kind().String()), loc, column)
+	}
+	oldType, newType := fieldTypeName(oldFd), fieldTypeName(newFd)
+	oldElem, newElem := oldFd, newFd
+	if oldFd.IsMap() && newFd.IsMap() {
+		oldElem, newElem = oldFd.MapValue(), newFd.MapValue()
+	}
+	if oldFd.Cardinality() != newFd.Cardinality() || oldFd.IsMap() != newFd.IsMap() || oldElem.Kind() != newElem.Kind() {
+		c.collect(xerrors.E1002(string(newFd.Name()), oldType, newType), loc, column)
+		return
+	}
+	switch oldElem.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		oldMd, newMd := oldElem.Message(), newElem.Message()
+		if oldMd.FullName() != newMd.FullName() && !(isNested(oldMd) && isNested(newMd)) {
+			// NOTE: renaming a nested struct is wire-compatible, so only
+			// changing to a different predefined type is reported.
+			c.collect(xerrors.E1002(string(newFd.Name()), oldType, newType), loc, column)
+			return
+		}
+		if oldMd.ParentFile().Package() == protoreflect.FullName(c.gen.ProtoPackage) {
+			// only check structs generated from workbooks
+			c.checkMessage(loc.sub(newFd), oldMd, newMd, visited)
+		}
+	case protoreflect.EnumKind:
+		oldEd, newEd := oldElem.Enum(), newElem.Enum()
+		if oldEd.FullName() != newEd.FullName() {
+			c.collect(xerrors.E1002(string(newFd.Name()), oldType, newType), loc, column)
+			return
+		}
+		if oldEd.ParentFile().Package() == protoreflect.FullName(c.gen.ProtoPackage) {
+			// only check enums generated from workbooks
+			enumLoc := *loc
+			enumLoc.column = column
+			c.checkEnum(&enumLoc, oldEd, newEd, visited)
+		}
+	}
+}
+
+func (c *checker) checkEnum(loc *location, oldEd, newEd protoreflect.EnumDescriptor, visited map[protoreflect.FullName]bool) {
+	if visited[oldEd.FullName()] {
+		return
+	}
+	visited[oldEd.FullName()] = true
+	for i := 0; i < oldEd.Values().Len(); i++ {
+		oldVd := oldEd.Values().Get(i)
+		if newEd.Values().ByNumber(oldVd.Number()) != nil || newEd.ReservedRanges().Has(oldVd.Number()) {
+			continue
+		}
+		c.collect(xerrors.E1006(string(oldEd.Name()), string(oldVd.Name()), int32(oldVd.Number())), loc, loc.column)
+	}
+}
+
+// collect collects the breaking change error with the responsible workbook
+// cell info.
+func (c *checker) collect(err error, loc *location, column string) {
+	kvs := []any{
+		xerrors.KeyModule, xerrors.ModuleProto,
+		xerrors.KeyBookName, loc.bookName,
+		xerrors.KeySheetName, loc.sheetName,
+	}
+	if column != "" {
+		kvs = append(kvs, xerrors.KeyNameCell, column)
+		if loc.header != nil {
+			for cursor := range loc.header.nameRowData {
+				if loc.header.getNameCell(cursor) == column {
+					kvs = append(kvs,
+						xerrors.KeyNameCellPos, loc.header.Position(loc.header.NameRow-1, cursor),
+						xerrors.KeyTypeCellPos, loc.header.Position(loc.header.TypeRow-1, cursor),
+						xerrors.KeyTypeCell, loc.header.getTypeCell(cursor),
+					)
+					break
+				}
+			}
+		}
+	}
+	_ = c.collector.Collect(xerrors.WrapKV(err, kvs...))
+}
+
+// columnName returns the column name of the field in name row.
+func (l *location) columnName(fd protoreflect.FieldDescriptor) string {
+	if l.column != "" {
+		return l.column
+	}
+	opts := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+	if opts.GetName() == "" && opts.GetKey() != "" {
+		// vertical map or keyed list: locate the key column
+		return l.prefix + opts.GetKey()
+	}
+	if opts.GetName() == "" {
+		return ""
+	}
+	return l.prefix + opts.GetName()
+}
+
+// sub returns the location of sub fields of the field.
+func (l *location) sub(fd protoreflect.FieldDescriptor) *location {
+	sub := *l
+	if l.column != "" {
+		return &sub
+	}
+	opts := proto.GetExtension(fd.Options(), tableaupb.E_Field).(*tableaupb.FieldOptions)
+	switch {
+	case opts.GetLayout() == tableaupb.Layout_LAYOUT_INCELL || opts.GetSpan() == tableaupb.Span_SPAN_INNER_CELL:
+		sub.column = l.columnName(fd)
+	case (fd.IsList() || fd.IsMap()) && opts.GetLayout() == tableaupb.Layout_LAYOUT_HORIZONTAL:
+		// the first element of horizontal list or map
+		sub.prefix = l.prefix + opts.GetName() + "1"
+	default:
+		sub.prefix = l.prefix + opts.GetName()
+	}
+	return &sub
+}
+
+// isNested checks whether the message is nested in another message.
+func isNested(md protoreflect.MessageDescriptor) bool {
+	_, ok := md.Parent().(protoreflect.MessageDescriptor)
+	return ok
+}
+
+// fieldTypeName returns the field type name, e.g.: "repeated int32",
+// "map<uint32, Item>".
+func fieldTypeName(fd protoreflect.FieldDescriptor) string {
+	if fd.IsMap() {
+		return "map<" + fd.MapKey().Kind().String() + ", " + singularTypeName(fd.MapValue()) + ">"
+	}
+	if fd.IsList() {
+		return "repeated " + singularTypeName(fd)
+	}
+	return singularTypeName(fd)
+}
+
+func singularTypeName(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().FullName())
+	case protoreflect.EnumKind:
+		return string(fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
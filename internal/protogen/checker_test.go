@@ -0,0 +1,61 @@
+package protogen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/options"
+)
+
+func newCheckerTestGenerator(inputDir, outputDir string) *Generator {
+	return NewGenerator("checkertest", inputDir, outputDir,
+		options.Proto(
+			&options.ProtoOption{
+				Input: &options.ProtoInputOption{
+					Formats: []format.Format{format.CSV},
+				},
+				Output: &options.ProtoOutputOption{},
+			},
+		),
+	)
+}
+
+func TestGenerator_Check(t *testing.T) {
+	outdir := t.TempDir()
+	// previous generation
+	err := newCheckerTestGenerator("./testdata/checker/v1/", outdir).Generate()
+	require.NoError(t, err)
+	protoPath := filepath.Join(outdir, "checker.proto")
+	previous, err := os.ReadFile(protoPath)
+	require.NoError(t, err)
+
+	t.Run("no-breaking-changes", func(t *testing.T) {
+		err := newCheckerTestGenerator("./testdata/checker/v1/", outdir).Check()
+		require.NoError(t, err)
+	})
+	t.Run("breaking-changes", func(t *testing.T) {
+		err := newCheckerTestGenerator("./testdata/checker/v2/", outdir).Check()
+		require.Error(t, err)
+		got := err.Error()
+		for _, code := range []string{"E1000", "E1001", "E1002", "E1003", "E1004", "E1005", "E1006"} {
+			assert.Contains(t, got, "error["+code+"]")
+		}
+		// responsible workbook cells
+		assert.Contains(t, got, "Worksheet: ItemConf\nNameCellPos: B1\nNameCell: Num\nTypeCellPos: B2\nTypeCell: int64\n"+
+			`Reason: type of field "num" is changed from "int32" to "int64"`)
+		assert.Contains(t, got, "Worksheet: ItemConf\nNameCellPos: C1\nNameCell: Type\nTypeCellPos: C2\nTypeCell: enum<.FruitType>\n"+
+			`Reason: enum value "ORANGE" (3) of enum "FruitType" is removed without reserving its number`)
+		assert.Contains(t, got, `Reason: field "price" (4) is removed without reserving its number`)
+		assert.Contains(t, got, `Reason: messager is renamed from "HeroConf" to "HeroInfoConf"`)
+		assert.Contains(t, got, `Reason: messager "SkillConf" is removed`)
+	})
+
+	// previously generated proto files should be kept untouched
+	current, err := os.ReadFile(protoPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(previous), string(current))
+}
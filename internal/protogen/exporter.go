@@ -17,11 +17,13 @@ import (
 	"github.com/tableauio/tableau/internal/x/xfs"
 	"github.com/tableauio/tableau/internal/x/xproto"
 	"github.com/tableauio/tableau/log"
+	"github.com/tableauio/tableau/options"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"github.com/tableauio/tableau/proto/tableaupb/internalpb"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 type bookExporter struct {
@@ -31,6 +33,7 @@ type bookExporter struct {
 	OutputDir        string
 	FilenameSuffix   string
 	wb               *internalpb.Workbook
+	Imports          []string // imported proto files, resolved after export
 
 	gen *Generator
 
@@ -54,6 +57,10 @@ func (x *bookExporter) GetProtoFilePath() string {
 	return genProtoFilePath(x.wb.GetName(), x.FilenameSuffix)
 }
 
+func (x *bookExporter) GetProtoFileAbsPath() string {
+	return filepath.Join(x.OutputDir, x.GetProtoFilePath())
+}
+
 func (x *bookExporter) export(checkProtoFileConflicts bool) error {
 	// log.Debug(proto.MarshalTextString(wb))
 	p1 := printer.New()
@@ -106,7 +113,9 @@ func (x *bookExporter) export(checkProtoFileConflicts bool) error {
 
 	// generate imports
 	p2 := printer.New()
+	x.Imports = nil
 	for _, key := range set.Values() {
+		x.Imports = append(x.Imports, key.(string))
 		p2.P(`import "`, key, `";`)
 	}
 	p2.P("")
@@ -248,6 +257,7 @@ func (x *sheetExporter) exportStruct() error {
 	x.p.P("")
 
 	oldMD := x.findMDFromGeneratedProtos(x.ws.Name)
+	x.ws.Fields = x.filterDeprecatedFields(x.ws.Fields)
 	reserved := x.assignFieldNumbers(x.ws.Fields, oldMD)
 	x.printReserved(1, reserved)
 	// generate the fields
@@ -360,6 +370,7 @@ func (x *sheetExporter) exportUnion() error {
 		if parentMD != nil {
 			oldMD = parentMD.Messages().ByName(protoreflect.Name(typ))
 		}
+		msgField.Fields = x.filterDeprecatedFields(msgField.Fields)
 		reserved := x.assignFieldNumbers(msgField.Fields, oldMD)
 		x.printReserved(depth, reserved)
 		for _, field := range msgField.Fields {
@@ -398,6 +409,18 @@ func (x *sheetExporter) findMDFromGeneratedProtos(name string) protoreflect.Mess
 	return descriptor.(protoreflect.MessageDescriptor)
 }
 
+// filterDeprecatedFields filters out the deprecated fields if option
+// "proto.output.deprecatedFieldPolicy" is "reserve", so that their field
+// numbers will be reserved by assignFieldNumbers.
+func (x *sheetExporter) filterDeprecatedFields(fields []*internalpb.Field) []*internalpb.Field {
+	if x.be.gen.OutputOpt.DeprecatedFieldPolicy != options.DeprecatedFieldPolicyReserve {
+		return fields
+	}
+	return slices.DeleteFunc(fields, func(field *internalpb.Field) bool {
+		return field.GetOptions().GetProp().GetDeprecated()
+	})
+}
+
 // assignFieldNumbers assigns the field numbers to the fields. It uses the old
 // MD to preserve field numbers if provided, otherwise it assigns field numbers
 // in sequence starting from 1.
@@ -480,6 +503,10 @@ func (*sheetExporter) assignFieldNumbers(fields []*internalpb.Field, oldMD proto
 	for name, num := range oldFieldNameToNumber {
 		if !newFieldNames[name] {
 			reservedSet[num] = true
+			fd := oldMD.Fields().ByName(protoreflect.Name(name))
+			if !fd.Options().(*descriptorpb.FieldOptions).GetDeprecated() {
+				log.Warnf("field %s is deleted without being deprecated first, mark it with field prop \"deprecated\" before deleting", fd.FullName())
+			}
 		}
 	}
 	for n := int32(1); n <= maxUsedNumber; n++ {
@@ -586,6 +613,7 @@ func (x *sheetExporter) exportMessager() error {
 	x.p.P("")
 
 	md := x.findMDFromGeneratedProtos(x.ws.Name)
+	x.ws.Fields = x.filterDeprecatedFields(x.ws.Fields)
 	reserved := x.assignFieldNumbers(x.ws.Fields, md)
 	x.printReserved(1, reserved)
 	// generate the fields
@@ -608,10 +636,22 @@ func (x *sheetExporter) exportMessager() error {
 
 func (x *sheetExporter) exportField(depth int, field *internalpb.Field, prefix string, oldFD protoreflect.FieldDescriptor) error {
 	label := ""
-	if x.ws.GetOptions().GetFieldPresence() &&
+	if (x.ws.GetOptions().GetFieldPresence() || field.Options.GetProp().GetFieldPresence()) &&
 		types.IsScalarType(field.FullType) &&
 		!types.IsWellKnownMessage(field.FullType) {
-		label = "optional "
+		if x.be.Edition == "" {
+			label = "optional "
+		} else {
+			// NOTE: label "optional" is not allowed in editions, so explicit
+			// field presence feature is specified in genFieldOptionsString.
+			if field.Options.Prop == nil {
+				field.Options.Prop = &tableaupb.FieldProp{}
+			}
+			field.Options.Prop.FieldPresence = true
+		}
+	} else if field.Options.GetProp().GetFieldPresence() {
+		// only basic types can track field presence
+		field.Options.Prop.FieldPresence = false
 	}
 	note := ""
 	if field.Note != "" {
@@ -650,6 +690,15 @@ func (x *sheetExporter) exportField(depth int, field *internalpb.Field, prefix s
 		field.Options.Prop.ValidateMessage = ""
 	}
 
+	if flags := field.Options.GetProp().GetFlags(); flags != "" {
+		typeInfo := x.typeInfos.GetByFullName(protoreflect.FullName(flags))
+		x.exportFlagsComment(depth, flags, typeInfo)
+		// import the enum type's parent filename.
+		// NOTE: excludes self.
+		if typeInfo != nil && typeInfo.ParentFilename != x.be.GetProtoFilePath() {
+			x.Imports[typeInfo.ParentFilename] = true
+		}
+	}
 	x.p.P(printer.Indent(depth), label, field.FullType, " ", field.Name, " = ", field.Number, " ", x.be.genFieldOptionsString(field.Options, fieldRules), ";", note)
 
 	var oldMD protoreflect.MessageDescriptor
@@ -717,6 +766,7 @@ func (x *sheetExporter) exportField(depth int, field *internalpb.Field, prefix s
 			x.p.P(printer.Indent(depth+1), "option (buf.validate.message) = {", x.be.marshalToText(msgRules), "};")
 		}
 
+		field.Fields = x.filterDeprecatedFields(field.Fields)
 		reserved := x.assignFieldNumbers(field.Fields, oldMD)
 		x.printReserved(depth+1, reserved)
 		for _, subField := range field.Fields {
@@ -733,12 +783,44 @@ func (x *sheetExporter) exportField(depth int, field *internalpb.Field, prefix s
 	return nil
 }
 
+// exportFlagsComment documents the enum-to-bit mapping of bit flags, with one
+// line per enum value, e.g.:
+//
+//	// Bit flags of enum protoconf.PlatformType:
+//	//   - bit 1: PLATFORM_TYPE_IOS
+//	//   - bit 2: PLATFORM_TYPE_ANDROID
+func (x *sheetExporter) exportFlagsComment(depth int, flags string, typeInfo *xproto.TypeInfo) {
+	var values []*xproto.EnumValueInfo
+	if typeInfo != nil {
+		for _, value := range typeInfo.EnumValues {
+			// only enum values numbered in [0,63] can be bit flags
+			if value.Number >= 0 && value.Number <= 63 {
+				values = append(values, value)
+			}
+		}
+	}
+	if len(values) == 0 {
+		x.p.P(printer.Indent(depth), "// Bit flags of enum ", flags, ": bit N is set for the enum value numbered N.")
+		return
+	}
+	x.p.P(printer.Indent(depth), "// Bit flags of enum ", flags, ":")
+	for _, value := range values {
+		x.p.P(printer.Indent(depth), "//   - bit ", value.Number, ": ", value.Name)
+	}
+}
+
 func (x *bookExporter) genFieldOptionsString(opts *tableaupb.FieldOptions, fieldRules *validate.FieldRules) string {
 	jsonName := ""
+	deprecated := false
+	fieldPresence := false
 	// remember and then clear protobuf built-in options
 	if opts.Prop != nil {
 		jsonName = opts.Prop.JsonName
 		opts.Prop.JsonName = ""
+		deprecated = opts.Prop.Deprecated
+		opts.Prop.Deprecated = false
+		fieldPresence = opts.Prop.FieldPresence
+		opts.Prop.FieldPresence = false
 
 		// set nil if field prop is empty
 		if IsEmptyFieldProp(opts.Prop) {
@@ -751,6 +833,12 @@ func (x *bookExporter) genFieldOptionsString(opts *tableaupb.FieldOptions, field
 	if jsonName != "" {
 		fieldOpts += `, json_name="` + jsonName + `"`
 	}
+	if deprecated {
+		fieldOpts += `, deprecated = true`
+	}
+	if fieldPresence && x.Edition != "" {
+		fieldOpts += `, features.field_presence = EXPLICIT`
+	}
 	if fieldRules != nil {
 		fieldOpts += `, (buf.validate.field) = {` + x.marshalToText(fieldRules) + `}`
 	}
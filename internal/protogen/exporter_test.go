@@ -11,6 +11,7 @@ import (
 	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	"github.com/stretchr/testify/assert"
 	"github.com/tableauio/tableau/internal/printer"
+	"github.com/tableauio/tableau/internal/types"
 	"github.com/tableauio/tableau/internal/x/xproto"
 	"github.com/tableauio/tableau/internal/x/xproto/protoc"
 	"github.com/tableauio/tableau/options"
@@ -80,6 +81,19 @@ func Test_genFieldOptionsString(t *testing.T) {
 			},
 			want: `[(tableau.field) = {name:"ItemID"}, json_name="item_id_1"]`,
 		},
+		{
+			name: "name-and-prop-deprecated",
+			args: args{
+				opts: &tableaupb.FieldOptions{
+					Name: "ItemID",
+					Prop: &tableaupb.FieldProp{
+						Range:      "1,~",
+						Deprecated: true,
+					},
+				},
+			},
+			want: `[(tableau.field) = {name:"ItemID" prop:{range:"1,~"}}, deprecated = true]`,
+		},
 	}
 	be := &bookExporter{gen: &Generator{}}
 	for _, tt := range tests {
@@ -844,6 +858,18 @@ func Test_sheetExporter_exportUnion(t *testing.T) {
 }
 
 func Test_sheetExporter_exportMessager(t *testing.T) {
+	flagsTypeInfos := xproto.NewTypeInfos("protoconf")
+	flagsTypeInfos.Put(&xproto.TypeInfo{
+		FullName:       "protoconf.PlatformType",
+		ParentFilename: "common.proto",
+		Kind:           types.EnumKind,
+		EnumValues: []*xproto.EnumValueInfo{
+			{Name: "PLATFORM_TYPE_UNKNOWN", Number: 0},
+			{Name: "PLATFORM_TYPE_IOS", Number: 1},
+			{Name: "PLATFORM_TYPE_ANDROID", Number: 2},
+			{Name: "PLATFORM_TYPE_WEB", Number: 64},
+		},
+	})
 	tests := []struct {
 		name    string
 		x       *sheetExporter
@@ -1131,6 +1157,45 @@ func Test_sheetExporter_exportMessager(t *testing.T) {
   }
 }
 
+`,
+			wantErr: false,
+		},
+		{
+			name: "export-messager-with-flags",
+			x: &sheetExporter{
+				ws: &internalpb.Worksheet{
+					Name: "ActivityConf",
+					Fields: []*internalpb.Field{
+						{
+							Name: "platforms", Type: "uint64", FullType: "uint64",
+							Options: &tableaupb.FieldOptions{
+								Name: "Platforms",
+								Prop: &tableaupb.FieldProp{Flags: "protoconf.PlatformType"},
+							},
+						},
+					},
+				},
+				p: printer.New(),
+				be: &bookExporter{
+					gen: &Generator{
+						OutputOpt: &options.ProtoOutputOption{},
+					},
+					messagerPatternRegexp: regexp.MustCompile(`Conf$`),
+				},
+				typeInfos:      flagsTypeInfos,
+				nestedMessages: make(map[string]*internalpb.Field),
+				Imports:        make(map[string]bool),
+			},
+			want: `message ActivityConf {
+  option (tableau.worksheet) = {};
+
+  // Bit flags of enum protoconf.PlatformType:
+  //   - bit 0: PLATFORM_TYPE_UNKNOWN
+  //   - bit 1: PLATFORM_TYPE_IOS
+  //   - bit 2: PLATFORM_TYPE_ANDROID
+  uint64 platforms = 1 [(tableau.field) = {name:"Platforms" prop:{flags:"protoconf.PlatformType"}}];
+}
+
 `,
 			wantErr: false,
 		},
@@ -1204,6 +1269,53 @@ func buildOldMDWithReserved(t *testing.T, fields map[string]int32, reserved []re
 	return fd.Messages().Get(0)
 }
 
+func Test_sheetExporter_filterDeprecatedFields(t *testing.T) {
+	newFields := func() []*internalpb.Field {
+		return []*internalpb.Field{
+			{Name: "a"},
+			{Name: "b", Options: &tableaupb.FieldOptions{Prop: &tableaupb.FieldProp{Deprecated: true}}},
+			{Name: "c", Options: &tableaupb.FieldOptions{Prop: &tableaupb.FieldProp{Present: true}}},
+		}
+	}
+	tests := []struct {
+		name      string
+		policy    options.DeprecatedFieldPolicy
+		wantNames []string
+	}{
+		{
+			name:      "default-policy",
+			policy:    "",
+			wantNames: []string{"a", "b", "c"},
+		},
+		{
+			name:      "keep-policy",
+			policy:    options.DeprecatedFieldPolicyKeep,
+			wantNames: []string{"a", "b", "c"},
+		},
+		{
+			name:      "reserve-policy",
+			policy:    options.DeprecatedFieldPolicyReserve,
+			wantNames: []string{"a", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := &sheetExporter{
+				be: &bookExporter{
+					gen: &Generator{
+						OutputOpt: &options.ProtoOutputOption{DeprecatedFieldPolicy: tt.policy},
+					},
+				},
+			}
+			var gotNames []string
+			for _, field := range x.filterDeprecatedFields(newFields()) {
+				gotNames = append(gotNames, field.Name)
+			}
+			assert.Equal(t, tt.wantNames, gotNames)
+		})
+	}
+}
+
 func Test_sheetExporter_assignFieldNumbers(t *testing.T) {
 	type fieldSpec struct {
 		name       string
@@ -18,6 +18,7 @@ func ExtractMapFieldProp(prop *tableaupb.FieldProp, layout tableaupb.Layout) *ta
 	}
 	p := &tableaupb.FieldProp{
 		JsonName:        prop.JsonName,
+		Deprecated:      prop.Deprecated,
 		Fixed:           prop.Fixed,
 		Size:            prop.Size,
 		Present:         prop.Present,
@@ -45,6 +46,7 @@ func ExtractListFieldProp(prop *tableaupb.FieldProp, isScalarList bool, layout t
 	}
 	p := &tableaupb.FieldProp{
 		JsonName:        prop.JsonName,
+		Deprecated:      prop.Deprecated,
 		Present:         prop.Present,
 		Optional:        prop.Optional,
 		Patch:           prop.Patch,
@@ -59,6 +61,8 @@ func ExtractListFieldProp(prop *tableaupb.FieldProp, isScalarList bool, layout t
 		p.Refer = prop.Refer
 		p.Pattern = prop.Pattern
 		p.Validate = prop.Validate
+		p.UniqueDomain = prop.UniqueDomain
+		p.Disjoint = prop.Disjoint
 	}
 	switch layout {
 	case tableaupb.Layout_LAYOUT_HORIZONTAL:
@@ -81,6 +85,7 @@ func ExtractStructFieldProp(prop *tableaupb.FieldProp) *tableaupb.FieldProp {
 	}
 	p := &tableaupb.FieldProp{
 		JsonName:        prop.JsonName,
+		Deprecated:      prop.Deprecated,
 		Form:            prop.Form,
 		Present:         prop.Present,
 		Optional:        prop.Optional,
@@ -104,18 +109,33 @@ func ExtractScalarFieldProp(prop *tableaupb.FieldProp) *tableaupb.FieldProp {
 		return nil
 	}
 	p := &tableaupb.FieldProp{
-		JsonName: prop.JsonName,
-		Unique:   prop.Unique,
-		Sequence: prop.Sequence,
-		Range:    prop.Range,
-		Refer:    prop.Refer,
-		Default:  prop.Default,
-		Present:  prop.Present,
-		Optional: prop.Optional,
-		Patch:    prop.Patch,
-		Pattern:  prop.Pattern,
-		Order:    prop.Order,
-		Validate: prop.Validate,
+		JsonName:      prop.JsonName,
+		Deprecated:    prop.Deprecated,
+		FieldPresence: prop.FieldPresence,
+		Unique:        prop.Unique,
+		Sequence:      prop.Sequence,
+		Range:         prop.Range,
+		Refer:         prop.Refer,
+		Default:       prop.Default,
+		Present:       prop.Present,
+		Optional:      prop.Optional,
+		Patch:         prop.Patch,
+		Pattern:       prop.Pattern,
+		Order:         prop.Order,
+		Validate:      prop.Validate,
+		Compute:       prop.Compute,
+		UniqueDomain:  prop.UniqueDomain,
+		RequiredIf:    prop.RequiredIf,
+		ExclusiveWith: prop.ExclusiveWith,
+		Compare:       prop.Compare,
+		Disjoint:      prop.Disjoint,
+		Weight:        prop.Weight,
+		Flags:         prop.Flags,
+		Schedule:      prop.Schedule,
+		Text:          prop.Text,
+		StringFormat:  prop.StringFormat,
+		Canonical:     prop.Canonical,
+		Schemes:       prop.Schemes,
 	}
 	if IsEmptyFieldProp(p) {
 		return nil
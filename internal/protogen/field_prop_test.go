@@ -220,6 +220,42 @@ func TestExtractScalarFieldProp(t *testing.T) {
 				Range:  "1~10",
 			},
 		},
+		{
+			name: "crossFieldProps",
+			args: args{
+				prop: &tableaupb.FieldProp{
+					Size:          2,
+					Compute:       "this.num * 2",
+					UniqueDomain:  "Reward",
+					RequiredIf:    "Type=TYPE_ITEM",
+					ExclusiveWith: "EquipID",
+					Compare:       "<=MaxLevel",
+					Disjoint:      true,
+					Weight:        &tableaupb.FieldProp_Weight{Total: 100},
+					Flags:         "protoconf.PlatformType",
+					Schedule:      &tableaupb.FieldProp_Schedule{Occurrences: 3, Since: "2024-01-01"},
+					Text:          true,
+					StringFormat:  tableaupb.StringFormat_STRING_FORMAT_URL,
+					Canonical:     true,
+					Schemes:       "https",
+				},
+			},
+			want: &tableaupb.FieldProp{
+				Compute:       "this.num * 2",
+				UniqueDomain:  "Reward",
+				RequiredIf:    "Type=TYPE_ITEM",
+				ExclusiveWith: "EquipID",
+				Compare:       "<=MaxLevel",
+				Disjoint:      true,
+				Weight:        &tableaupb.FieldProp_Weight{Total: 100},
+				Flags:         "protoconf.PlatformType",
+				Schedule:      &tableaupb.FieldProp_Schedule{Occurrences: 3, Since: "2024-01-01"},
+				Text:          true,
+				StringFormat:  tableaupb.StringFormat_STRING_FORMAT_URL,
+				Canonical:     true,
+				Schemes:       "https",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
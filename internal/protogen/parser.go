@@ -86,15 +86,38 @@ func (p *bookParser) parseBasicField(name, typ, note string) (*internalpb.Field,
 // parseBasicField parses scalar, enum, and wellknown message types.
 func parseBasicField(ctx context.Context, typeInfos *xproto.TypeInfos, name, typ, note string) (*internalpb.Field, error) {
 	var prop types.PropDescriptor
+	var decimalPattern string
+	var flagsEnumType string
+	var localizedText bool
+	var stringFormat tableaupb.StringFormat
 	// enum syntax pattern
 	if desc := types.MatchEnum(typ); desc != nil {
 		typ = desc.EnumType
 		prop = desc.Prop
+	} else if desc := types.MatchFlags(typ); desc != nil {
+		// flags syntax pattern, e.g.: flags<.PlatformType>
+		typ = "uint64"
+		prop = desc.Prop
+		flagsEnumType = desc.EnumType
+	} else if desc := types.MatchDecimal(typ); desc != nil {
+		// decimal syntax pattern, e.g.: decimal(10,2)
+		typ = "decimal"
+		prop = desc.Prop
+		decimalPattern = desc.Pattern
 	} else if desc := types.MatchScalar(typ); desc != nil {
 		// scalar syntax pattern
 		typ = desc.ScalarType
 		prop = desc.Prop
 	}
+	if types.IsLocalizedText(typ) {
+		// localized text alias, e.g.: text or i18n
+		typ = "string"
+		localizedText = true
+	} else if format, ok := types.StringFormatOf(typ); ok {
+		// validated string alias, e.g.: uuid, regexp, or url
+		typ = "string"
+		stringFormat = format
+	}
 	typeDesc, err := parseTypeDescriptor(typeInfos, typ)
 	if err != nil {
 		return nil, xerrors.WrapKV(err,
@@ -110,6 +133,37 @@ func parseBasicField(ctx context.Context, typeInfos *xproto.TypeInfos, name, typ
 			xerrors.KeyPBFieldType, typ,
 			xerrors.KeyTrimmedNameCell, name)
 	}
+	if decimalPattern != "" {
+		if fieldProp == nil {
+			fieldProp = &tableaupb.FieldProp{}
+		}
+		fieldProp.Pattern = decimalPattern
+	}
+	if flagsEnumType != "" {
+		enumFullName, err := parseFlagsEnumType(typeInfos, flagsEnumType)
+		if err != nil {
+			return nil, xerrors.WrapKV(err,
+				xerrors.KeyPBFieldOpts, prop.Text,
+				xerrors.KeyPBFieldType, typ,
+				xerrors.KeyTrimmedNameCell, name)
+		}
+		if fieldProp == nil {
+			fieldProp = &tableaupb.FieldProp{}
+		}
+		fieldProp.Flags = enumFullName
+	}
+	if localizedText {
+		if fieldProp == nil {
+			fieldProp = &tableaupb.FieldProp{}
+		}
+		fieldProp.Text = true
+	}
+	if stringFormat != tableaupb.StringFormat_STRING_FORMAT_NONE {
+		if fieldProp == nil {
+			fieldProp = &tableaupb.FieldProp{}
+		}
+		fieldProp.StringFormat = stringFormat
+	}
 	pureName := strings.TrimPrefix(name, book.MetaSign) // remove leading meta sign "@"
 	return &internalpb.Field{
 		Name:       strcase.FromContext(ctx).ToSnake(pureName),
@@ -149,6 +203,22 @@ func parseTypeDescriptor(typeInfos *xproto.TypeInfos, rawType string) (*types.De
 	return types.ParseTypeDescriptor(rawType), nil
 }
 
+// parseFlagsEnumType returns the full name of the enum type of bit flags,
+// which is predefined or defined in the same proto package.
+func parseFlagsEnumType(typeInfos *xproto.TypeInfos, enumType string) (string, error) {
+	typeInfo := typeInfos.Get(enumType)
+	if typeInfo == nil && !strings.HasPrefix(enumType, ".") {
+		typeInfo = typeInfos.Get("." + enumType)
+	}
+	if typeInfo == nil {
+		return "", xerrors.Newf("flags enum type not found: %s", enumType)
+	}
+	if typeInfo.Kind != types.EnumKind {
+		return "", xerrors.Newf("flags type %s is not an enum", enumType)
+	}
+	return string(typeInfo.FullName), nil
+}
+
 // parseIncellStruct parses incell struct type definition. For example:
 //   - int32 ID
 //   - int32 ID, string Name
@@ -9,6 +9,7 @@ import (
 	"github.com/tableauio/tableau/internal/x/xproto"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"github.com/tableauio/tableau/proto/tableaupb/internalpb"
+	"google.golang.org/protobuf/proto"
 )
 
 func Test_parseField(t *testing.T) {
@@ -64,6 +65,96 @@ func Test_parseField(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "decimal with precision and scale",
+			args: args{
+				typeInfos: xproto.NewTypeInfos("protoconf"),
+				name:      "Price",
+				typ:       `decimal(10,2)|{range:"0,~"}`,
+			},
+			want: &internalpb.Field{
+				Type:       "tableau.Decimal",
+				FullType:   "tableau.Decimal",
+				Name:       "price",
+				Predefined: true,
+				Options: &tableaupb.FieldOptions{
+					Name: "Price",
+					Prop: &tableaupb.FieldProp{
+						Range:   "0,~",
+						Pattern: "10,2",
+					},
+				},
+			},
+		},
+		{
+			name: "flags of predefined enum type: ItemType",
+			args: args{
+				typeInfos: typeInfos1,
+				name:      "Types",
+				typ:       "flags<.ItemType>",
+			},
+			want: &internalpb.Field{
+				Type:     "uint64",
+				FullType: "uint64",
+				Name:     "types",
+				Options: &tableaupb.FieldOptions{
+					Name: "Types",
+					Prop: &tableaupb.FieldProp{
+						Flags: "protoconf.ItemType",
+					},
+				},
+			},
+		},
+		{
+			name: "localized text",
+			args: args{
+				typeInfos: xproto.NewTypeInfos("protoconf"),
+				name:      "Name",
+				typ:       `i18n|{unique:true}`,
+			},
+			want: &internalpb.Field{
+				Type:     "string",
+				FullType: "string",
+				Name:     "name",
+				Options: &tableaupb.FieldOptions{
+					Name: "Name",
+					Prop: &tableaupb.FieldProp{
+						Unique: proto.Bool(true),
+						Text:   true,
+					},
+				},
+			},
+		},
+		{
+			name: "url with schemes",
+			args: args{
+				typeInfos: xproto.NewTypeInfos("protoconf"),
+				name:      "Icon",
+				typ:       `url|{schemes:"https" canonical:true}`,
+			},
+			want: &internalpb.Field{
+				Type:     "string",
+				FullType: "string",
+				Name:     "icon",
+				Options: &tableaupb.FieldOptions{
+					Name: "Icon",
+					Prop: &tableaupb.FieldProp{
+						StringFormat: tableaupb.StringFormat_STRING_FORMAT_URL,
+						Canonical:    true,
+						Schemes:      "https",
+					},
+				},
+			},
+		},
+		{
+			name: "flags of unknown enum type",
+			args: args{
+				typeInfos: typeInfos1,
+				name:      "Types",
+				typ:       "flags<.UnknownType>",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -72,7 +163,7 @@ func Test_parseField(t *testing.T) {
 				t.Errorf("parseField() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
+			if !proto.Equal(got, tt.want) {
 				t.Errorf("parseField() = %v, want %v", got, tt.want)
 			}
 		})
@@ -9,13 +9,13 @@ import (
 	"sync"
 
 	"github.com/tableauio/tableau/format"
+	"github.com/tableauio/tableau/internal/buildcache"
 	"github.com/tableauio/tableau/internal/confgen"
 	"github.com/tableauio/tableau/internal/importer"
 	"github.com/tableauio/tableau/internal/importer/book"
 	"github.com/tableauio/tableau/internal/importer/book/tableparser"
 	"github.com/tableauio/tableau/internal/importer/metasheet"
 	"github.com/tableauio/tableau/internal/strcase"
-	"github.com/tableauio/tableau/internal/types"
 	"github.com/tableauio/tableau/internal/x/xerrors"
 	"github.com/tableauio/tableau/internal/x/xfs"
 	"github.com/tableauio/tableau/internal/x/xproto"
@@ -25,7 +25,6 @@ import (
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"github.com/tableauio/tableau/proto/tableaupb/internalpb"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/dynamicpb"
 )
@@ -46,13 +45,17 @@ type Generator struct {
 	LocationName string // TZ location name.
 	InputOpt     *options.ProtoInputOption
 	OutputOpt    *options.ProtoOutputOption
+	CacheOpt     *options.CacheOption // build cache settings.
 
 	ProtoRegistryFiles *protoregistry.Files
 	ProtoRegistryTypes *dynamicpb.Types
 
 	// internal
-	typeInfos *xproto.TypeInfos  // predefined type infos
-	collector *xerrors.Collector // concurrent error collector shared across the generator.
+	typeInfos    *xproto.TypeInfos  // predefined type infos
+	collector    *xerrors.Collector // concurrent error collector shared across the generator.
+	cache        *buildcache.Cache  // build cache, nil if not enabled.
+	cacheSalt    string             // hash of generator settings for build cache.
+	cacheSaltErr error              // error of hashing generator settings, which disables build cache.
 
 	// used in advanced mode or when preserveFieldNumbers is set to true
 	registryWithGeneratedOnce       sync.Once
@@ -79,12 +82,14 @@ func NewGeneratorWithOptions(protoPackage, indir, outdir string, opts *options.O
 		LocationName: opts.LocationName,
 		InputOpt:     opts.Proto.Input,
 		OutputOpt:    opts.Proto.Output,
+		CacheOpt:     opts.Cache,
 		ctx:          ctx,
 		typeInfos:    xproto.NewTypeInfos(protoPackage),
 		collector:    xerrors.NewCollector(maxErrors),
 
 		cachedImporters: make(map[string]importer.Importer),
 	}
+	gen.cacheSalt, gen.cacheSaltErr = cacheSalt(protoPackage, indir, outdir, opts)
 	registryFiles, err := gen.parseProtoRegistryFiles(false)
 	if err != nil {
 		panic(err)
@@ -145,7 +150,18 @@ func (gen *Generator) preprocess(useGeneratedProtos, delExisted bool) error {
 
 // Generate generates proto files for the specified workbooks. If no workbook paths are provided,
 // it generates proto files for all workbooks found in the input directory.
-func (gen *Generator) Generate(relWorkbookPaths ...string) error {
+//
+// If build cache is enabled, unchanged workbooks are skipped in the second
+// pass, and their proto files are restored from the cache if needed.
+func (gen *Generator) Generate(relWorkbookPaths ...string) (err error) {
+	if err := gen.openCache(); err != nil {
+		return err
+	}
+	defer func() {
+		if cacheErr := gen.saveCache(); err == nil {
+			err = cacheErr
+		}
+	}()
 	if len(relWorkbookPaths) == 0 {
 		return gen.GenAll()
 	}
@@ -448,6 +464,12 @@ func (gen *Generator) convertTable(dir, filename string, checkProtoFileConflicts
 		debugBookName += " (alias: " + alias + ")"
 	}
 	if pass == secondPass {
+		if hit, err := gen.hitBookCache(relativePath, absPath, checkProtoFileConflicts); err != nil {
+			return xerrors.WrapKV(err, xerrors.KeyBookName, debugBookName)
+		} else if hit {
+			log.Infof("%15s: %s", "unchanged book", debugBookName)
+			return nil
+		}
 		log.Infof("%15s: %s, %d sheet(s) will be parsed", "analyzing book", debugBookName, len(imp.GetSheets()))
 	}
 	// create a book parser
@@ -477,6 +499,11 @@ func (gen *Generator) convertTable(dir, filename string, checkProtoFileConflicts
 		if err := be.export(checkProtoFileConflicts); err != nil {
 			return xerrors.WrapKV(err, xerrors.KeyBookName, debugBookName)
 		}
+		if gen.cache != nil {
+			if err := gen.putBookCache(relativePath, absPath, be); err != nil {
+				return xerrors.WrapKV(err, xerrors.KeyBookName, debugBookName)
+			}
+		}
 	}
 	return nil
 }
@@ -600,13 +627,9 @@ func (gen *Generator) extractTypeInfoFromSpecialSheetMode(mode tableaupb.Mode, s
 	// parse each special sheet mode
 	switch mode {
 	case tableaupb.Mode_MODE_ENUM_TYPE:
-		// add type info
-		info := &xproto.TypeInfo{
-			FullName:       protoreflect.FullName(gen.ProtoPackage + "." + typeName),
-			ParentFilename: parentFilename,
-			Kind:           types.EnumKind,
+		if err := extractEnumTypeInfo(sheet, typeName, parentFilename, parser, gen); err != nil {
+			return err
 		}
-		gen.typeInfos.Put(info)
 	case tableaupb.Mode_MODE_ENUM_TYPE_MULTI:
 		for row := table.BeginRow(); row < table.EndRow(); row++ {
 			cols := table.GetRow(row)
@@ -619,13 +642,13 @@ func (gen *Generator) extractTypeInfoFromSpecialSheetMode(mode tableaupb.Mode, s
 				if err != nil {
 					return xerrors.Wrapf(err, "failed to parse enum type block, sheet: %s, row: %d", sheet.Name, row)
 				}
-				// add type info
-				info := &xproto.TypeInfo{
-					FullName:       protoreflect.FullName(gen.ProtoPackage + "." + typeName),
-					ParentFilename: parentFilename,
-					Kind:           types.EnumKind,
+				blockBeginRow := row
+				blockEndRow := table.FindBlockEndRow(blockBeginRow)
+				row = blockEndRow // skip row to next block
+				subSheet := sheet.SubTableSheet(book.Rows(blockBeginRow, blockEndRow))
+				if err := extractEnumTypeInfo(subSheet, typeName, parentFilename, parser, gen); err != nil {
+					return err
 				}
-				gen.typeInfos.Put(info)
 			}
 		}
 	case tableaupb.Mode_MODE_STRUCT_TYPE:
@@ -12,6 +12,7 @@ import (
 	"github.com/tableauio/tableau/internal/importer/book"
 	"github.com/tableauio/tableau/internal/x/xerrors"
 	"github.com/tableauio/tableau/internal/x/xfs"
+	"github.com/tableauio/tableau/internal/x/xproto"
 	"github.com/tableauio/tableau/options"
 	"github.com/tableauio/tableau/proto/tableaupb"
 	"github.com/tableauio/tableau/proto/tableaupb/internalpb"
@@ -1034,10 +1035,11 @@ func TestGenerator_extractTypeInfoFromSpecialSheetMode(t *testing.T) {
 		parentFilename string
 	}
 	tests := []struct {
-		name    string
-		gen     *Generator
-		args    args
-		wantErr bool
+		name           string
+		gen            *Generator
+		args           args
+		wantEnumValues []*xproto.EnumValueInfo
+		wantErr        bool
 	}{
 		{
 			name: "MODE_ENUM_TYPE",
@@ -1056,6 +1058,12 @@ func TestGenerator_extractTypeInfoFromSpecialSheetMode(t *testing.T) {
 				typeName:       "ItemType",
 				parentFilename: "test.proto",
 			},
+			wantEnumValues: []*xproto.EnumValueInfo{
+				{Name: "ITEM_TYPE_UNKNOWN", Number: 0},
+				{Name: "ITEM_TYPE_FRUIT", Number: 1},
+				{Name: "ITEM_TYPE_EQUIP", Number: 2},
+				{Name: "ITEM_TYPE_BOX", Number: 3},
+			},
 			wantErr: false,
 		},
 		{
@@ -1099,6 +1107,87 @@ func TestGenerator_extractTypeInfoFromSpecialSheetMode(t *testing.T) {
 			if err := tt.gen.extractTypeInfoFromSpecialSheetMode(tt.args.mode, tt.args.sheet, tt.args.typeName, tt.args.parentFilename); (err != nil) != tt.wantErr {
 				t.Errorf("Generator.extractTypeInfoFromSpecialSheetMode() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if tt.wantEnumValues != nil {
+				info := tt.gen.typeInfos.Get("." + tt.args.typeName)
+				require.NotNil(t, info)
+				assert.Equal(t, tt.wantEnumValues, info.EnumValues)
+			}
+		})
+	}
+}
+
+func TestGenerator_DeprecatedField(t *testing.T) {
+	newGenerator := func(outdir string, policy options.DeprecatedFieldPolicy) *Generator {
+		return NewGenerator("deprecatedtest", "./testdata/deprecated/", outdir,
+			options.Proto(
+				&options.ProtoOption{
+					Input: &options.ProtoInputOption{
+						Formats: []format.Format{format.CSV},
+					},
+					Output: &options.ProtoOutputOption{
+						PreserveFieldNumbers:  true,
+						DeprecatedFieldPolicy: policy,
+					},
+				},
+			),
+		)
+	}
+	tmpdir := t.TempDir()
+	protoPath := filepath.Join(tmpdir, "deprecated.proto")
+
+	err := newGenerator(tmpdir, options.DeprecatedFieldPolicyKeep).Generate()
+	require.NoError(t, err)
+	content, err := os.ReadFile(protoPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `int32 price = 3 [(tableau.field) = {name:"Price"}, deprecated = true]; // Item's price`)
+
+	// regenerate with "reserve" policy
+	err = newGenerator(tmpdir, options.DeprecatedFieldPolicyReserve).Generate()
+	require.NoError(t, err)
+	content, err = os.ReadFile(protoPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "price")
+	assert.Contains(t, string(content), "reserved 3;")
+}
+
+func TestGenerator_FieldPresence(t *testing.T) {
+	tests := []struct {
+		name    string
+		edition string
+		want    string
+	}{
+		{
+			name: "proto3",
+			want: `optional int32 num = 2 [(tableau.field) = {name:"Num"}]; // Item's num`,
+		},
+		{
+			name:    "edition-2023",
+			edition: "2023",
+			want:    `int32 num = 2 [(tableau.field) = {name:"Num"}, features.field_presence = EXPLICIT]; // Item's num`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpdir := t.TempDir()
+			gen := NewGenerator("presencetest", "./testdata/presence/", tmpdir,
+				options.Proto(
+					&options.ProtoOption{
+						Input: &options.ProtoInputOption{
+							Formats: []format.Format{format.CSV},
+						},
+						Output: &options.ProtoOutputOption{
+							Edition: tt.edition,
+						},
+					},
+				),
+			)
+			require.NoError(t, gen.Generate())
+			content, err := os.ReadFile(filepath.Join(tmpdir, "presence.proto"))
+			require.NoError(t, err)
+			assert.Contains(t, string(content), tt.want)
+			// the generated proto file should be valid
+			_, err = gen.parseProtoRegistryFiles(true)
+			require.NoError(t, err)
 		})
 	}
 }
@@ -58,11 +58,21 @@ func isEnumTypeBlockHeader(cols []string) bool {
 }
 
 func parseEnumType(ws *internalpb.Worksheet, sheet *book.Sheet, parser book.SheetParser, gen *Generator) error {
+	fields, err := parseEnumValues(ws.Name, sheet, parser, gen)
+	if err != nil {
+		return err
+	}
+	ws.Fields = append(ws.Fields, fields...)
+	return nil
+}
+
+// parseEnumValues parses the enum values of enum type typeName in sheet.
+func parseEnumValues(typeName string, sheet *book.Sheet, parser book.SheetParser, gen *Generator) ([]*internalpb.Field, error) {
 	desc := &internalpb.EnumDescriptor{}
 	if err := parser.Parse(desc, sheet); err != nil {
-		return err
+		return nil, err
 	}
-	prefix := strcase.FromContext(gen.ctx).ToScreamingSnake(ws.Name) + "_"
+	prefix := strcase.FromContext(gen.ctx).ToScreamingSnake(typeName) + "_"
 
 	usedNumbers := make(map[int32]struct{}, len(desc.Values))
 	for _, value := range desc.Values {
@@ -71,10 +81,11 @@ func parseEnumType(ws *internalpb.Worksheet, sheet *book.Sheet, parser book.Shee
 		}
 	}
 
+	fields := make([]*internalpb.Field, 0, len(desc.Values))
 	for i, value := range desc.Values {
 		number, err := resolveFieldNumber(i, value.Number, usedNumbers, "enum", value.Name)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		name := value.Name
 		if gen.OutputOpt.EnumValueWithPrefix && !strings.HasPrefix(name, prefix) {
@@ -85,8 +96,29 @@ func parseEnumType(ws *internalpb.Worksheet, sheet *book.Sheet, parser book.Shee
 			Name:   strings.TrimSpace(name),
 			Alias:  strings.TrimSpace(value.Alias),
 		}
-		ws.Fields = append(ws.Fields, field)
+		fields = append(fields, field)
 	}
+	return fields, nil
+}
+
+// extractEnumTypeInfo adds the type info of enum type typeName in sheet,
+// along with its enum values, e.g.: to document bit flags of the enum.
+func extractEnumTypeInfo(sheet *book.Sheet, typeName, parentFilename string, parser book.SheetParser, gen *Generator) error {
+	fields, err := parseEnumValues(typeName, sheet, parser, gen)
+	if err != nil {
+		return err
+	}
+	var enumValues []*xproto.EnumValueInfo
+	for _, field := range fields {
+		enumValues = append(enumValues, &xproto.EnumValueInfo{Name: field.Name, Number: field.Number})
+	}
+	info := &xproto.TypeInfo{
+		FullName:       protoreflect.FullName(gen.ProtoPackage + "." + typeName),
+		ParentFilename: parentFilename,
+		Kind:           types.EnumKind,
+		EnumValues:     enumValues,
+	}
+	gen.typeInfos.Put(info)
 	return nil
 }
 
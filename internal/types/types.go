@@ -58,11 +58,22 @@ var structRegexp = regexp.MustCompile(`^\{` + structTypeGroup + `\}` + `(?P<Colu
 //   - string
 var scalarRegexp = regexp.MustCompile(`^` + `(?P<ScalarType>` + typeCharClass + `+)` + rawPropGroup)
 
+// Decimal definition patterns:
+//   - decimal
+//   - decimal(Precision)
+//   - decimal(Precision,Scale)
+var decimalRegexp = regexp.MustCompile(`^decimal` + `(\(` + `(?P<Pattern>[0-9 ,]*)` + `\))?` + rawPropGroup + `$`)
+
 // Enum definition patterns:
 //   - enum<Type>
 //   - enum<.PredefinedType>
 var enumRegexp = regexp.MustCompile(`^enum<` + `(?P<EnumType>` + typeCharClass + `+)` + `>` + rawPropGroup)
 
+// Flags definition patterns:
+//   - flags<Type>
+//   - flags<.PredefinedType>
+var flagsRegexp = regexp.MustCompile(`^flags<` + `(?P<EnumType>` + typeCharClass + `+)` + `>` + rawPropGroup)
+
 // Field property definition patterns:
 //   - |{range:"1,10" refer:"XXXConf.ID"}
 //   - | {range:"1,10" refer:"XXXConf.ID"}
@@ -242,6 +253,33 @@ func MatchScalar(text string) *ScalarDescriptor {
 	return desc
 }
 
+type DecimalDescriptor struct {
+	Pattern string // "Precision,Scale", same as field prop pattern
+	Prop    PropDescriptor
+}
+
+// MatchDecimal matches the decimal type patterns. For example:
+//   - decimal
+//   - decimal(10)
+//   - decimal(10,2)
+func MatchDecimal(text string) *DecimalDescriptor {
+	match := decimalRegexp.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+	desc := &DecimalDescriptor{}
+	for i, name := range decimalRegexp.SubexpNames() {
+		value := strings.TrimSpace(match[i])
+		switch name {
+		case "Pattern":
+			desc.Pattern = strings.ReplaceAll(value, " ", "")
+		case "Prop":
+			desc.Prop.Text = value
+		}
+	}
+	return desc
+}
+
 type EnumDescriptor struct {
 	EnumType string
 	Prop     PropDescriptor
@@ -273,6 +311,32 @@ func IsEnum(text string) bool {
 	return MatchEnum(text) != nil
 }
 
+type FlagsDescriptor struct {
+	EnumType string
+	Prop     PropDescriptor
+}
+
+// MatchFlags matches the bit-flag enum set pattern. For example:
+//   - flags<Type>
+//   - flags<.PredefinedType>
+func MatchFlags(text string) *FlagsDescriptor {
+	match := flagsRegexp.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+	desc := &FlagsDescriptor{}
+	for i, name := range flagsRegexp.SubexpNames() {
+		value := strings.TrimSpace(match[i])
+		switch name {
+		case "EnumType":
+			desc.EnumType = value
+		case "Prop":
+			desc.Prop.Text = value
+		}
+	}
+	return desc
+}
+
 type PropDescriptor struct {
 	Text string // serialized prototext of tableaupb.FieldProp
 }
@@ -362,6 +426,23 @@ func init() {
 		WellKnownMessageFraction:   ScalarKind,
 		WellKnownMessageComparator: ScalarKind,
 		WellKnownMessageVersion:    ScalarKind,
+		WellKnownMessageVector2:    ScalarKind,
+		WellKnownMessageVector3:    ScalarKind,
+		WellKnownMessageVector4:    ScalarKind,
+		WellKnownMessageVector2i:   ScalarKind,
+		WellKnownMessageVector3i:   ScalarKind,
+		WellKnownMessageVector4i:   ScalarKind,
+		WellKnownMessageDecimal:    ScalarKind,
+		WellKnownMessageColor:      ScalarKind,
+
+		WellKnownMessageInterval:          ScalarKind,
+		WellKnownMessageDoubleInterval:    ScalarKind,
+		WellKnownMessageTimestampInterval: ScalarKind,
+
+		WellKnownMessageDate:      ScalarKind,
+		WellKnownMessageTimeOfDay: ScalarKind,
+
+		WellKnownMessageSchedule: ScalarKind,
 
 		// "enum":     EnumKind,
 		// "repeated": ListKind,
@@ -384,22 +465,92 @@ type Descriptor struct {
 	Kind       Kind
 }
 
+// vectorAliases maps type aliases to well-known vector message full names.
+var vectorAliases = map[string]string{
+	"vector2":  WellKnownMessageVector2,
+	"vector3":  WellKnownMessageVector3,
+	"vector4":  WellKnownMessageVector4,
+	"vector2i": WellKnownMessageVector2i,
+	"vector3i": WellKnownMessageVector3i,
+	"vector4i": WellKnownMessageVector4i,
+}
+
+// intervalAliases maps type aliases to well-known interval message full names.
+var intervalAliases = map[string]string{
+	"interval":         WellKnownMessageInterval,
+	"doubleinterval":   WellKnownMessageDoubleInterval,
+	"datetimeinterval": WellKnownMessageTimestampInterval,
+}
+
+// IsLocalizedText checks if the type is the localized text alias "text" or
+// "i18n", which is a string field with prop "text".
+func IsLocalizedText(rawType string) bool {
+	return rawType == "text" || rawType == "i18n"
+}
+
+// stringFormatAliases maps type aliases to validated string formats.
+var stringFormatAliases = map[string]tableaupb.StringFormat{
+	"uuid":   tableaupb.StringFormat_STRING_FORMAT_UUID,
+	"regexp": tableaupb.StringFormat_STRING_FORMAT_REGEXP,
+	"url":    tableaupb.StringFormat_STRING_FORMAT_URL,
+}
+
+// StringFormatOf returns the validated string format of the type alias
+// "uuid", "regexp", or "url", which is a string field with prop
+// "string_format". It returns false if the type is not such an alias.
+func StringFormatOf(rawType string) (tableaupb.StringFormat, bool) {
+	format, ok := stringFormatAliases[rawType]
+	return format, ok
+}
+
+// StringFormatAlias returns the type alias of the validated string format,
+// or empty string if not found.
+func StringFormatAlias(format tableaupb.StringFormat) string {
+	for alias, f := range stringFormatAliases {
+		if f == format {
+			return alias
+		}
+	}
+	return ""
+}
+
 func ParseTypeDescriptor(rawType string) *Descriptor {
 	switch rawType {
-	case "datetime", "date":
+	case "datetime":
 		return &Descriptor{
 			Name:       WellKnownMessageTimestamp,
 			FullName:   WellKnownMessageTimestamp,
 			Predefined: true,
 			Kind:       ScalarKind,
 		}
-	case "time", "duration":
+	case "duration":
 		return &Descriptor{
 			Name:       WellKnownMessageDuration,
 			FullName:   WellKnownMessageDuration,
 			Predefined: true,
 			Kind:       ScalarKind,
 		}
+	case "date":
+		return &Descriptor{
+			Name:       WellKnownMessageDate,
+			FullName:   WellKnownMessageDate,
+			Predefined: true,
+			Kind:       ScalarKind,
+		}
+	case "time":
+		return &Descriptor{
+			Name:       WellKnownMessageTimeOfDay,
+			FullName:   WellKnownMessageTimeOfDay,
+			Predefined: true,
+			Kind:       ScalarKind,
+		}
+	case "schedule":
+		return &Descriptor{
+			Name:       WellKnownMessageSchedule,
+			FullName:   WellKnownMessageSchedule,
+			Predefined: true,
+			Kind:       ScalarKind,
+		}
 	case "fraction":
 		return &Descriptor{
 			Name:       WellKnownMessageFraction,
@@ -421,6 +572,36 @@ func ParseTypeDescriptor(rawType string) *Descriptor {
 			Predefined: true,
 			Kind:       ScalarKind,
 		}
+	case "decimal":
+		return &Descriptor{
+			Name:       WellKnownMessageDecimal,
+			FullName:   WellKnownMessageDecimal,
+			Predefined: true,
+			Kind:       ScalarKind,
+		}
+	case "color":
+		return &Descriptor{
+			Name:       WellKnownMessageColor,
+			FullName:   WellKnownMessageColor,
+			Predefined: true,
+			Kind:       ScalarKind,
+		}
+	case "vector2", "vector3", "vector4", "vector2i", "vector3i", "vector4i":
+		fullName := vectorAliases[rawType]
+		return &Descriptor{
+			Name:       fullName,
+			FullName:   fullName,
+			Predefined: true,
+			Kind:       ScalarKind,
+		}
+	case "interval", "doubleinterval", "datetimeinterval":
+		fullName := intervalAliases[rawType]
+		return &Descriptor{
+			Name:       fullName,
+			FullName:   fullName,
+			Predefined: true,
+			Kind:       ScalarKind,
+		}
 	default:
 		desc := &Descriptor{
 			Name:       rawType,
@@ -3,6 +3,8 @@ package types
 import (
 	"reflect"
 	"testing"
+
+	"github.com/tableauio/tableau/proto/tableaupb"
 )
 
 func TestMatchMap(t *testing.T) {
@@ -366,6 +368,79 @@ func TestMatchEnum(t *testing.T) {
 	}
 }
 
+func TestMatchFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want *FlagsDescriptor
+	}{
+		{
+			name: "flags",
+			text: "flags<PlatformType>",
+			want: &FlagsDescriptor{EnumType: "PlatformType"},
+		},
+		{
+			name: "predefined-flags-with-prop",
+			text: `flags<.PlatformType>|{default:"IOS"}`,
+			want: &FlagsDescriptor{
+				EnumType: ".PlatformType",
+				Prop:     PropDescriptor{Text: `default:"IOS"`},
+			},
+		},
+		{
+			name: "enum",
+			text: "enum<.PlatformType>",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchFlags(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchDecimal(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want *DecimalDescriptor
+	}{
+		{
+			name: "decimal",
+			text: "decimal",
+			want: &DecimalDescriptor{},
+		},
+		{
+			name: "decimal-with-precision-and-scale",
+			text: "decimal(10, 2)",
+			want: &DecimalDescriptor{Pattern: "10,2"},
+		},
+		{
+			name: "decimal-with-prop",
+			text: `decimal(10)|{range:"0,~"}`,
+			want: &DecimalDescriptor{
+				Pattern: "10",
+				Prop:    PropDescriptor{Text: `range:"0,~"`},
+			},
+		},
+		{
+			name: "not-decimal",
+			text: "decimals",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchDecimal(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchDecimal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMatchBoringInteger(t *testing.T) {
 	type args struct {
 		text string
@@ -553,6 +628,41 @@ func TestParseTypeDescriptor(t *testing.T) {
 			args: "duration",
 			want: ScalarKind,
 		},
+		{
+			name: "date",
+			args: "date",
+			want: ScalarKind,
+		},
+		{
+			name: "time",
+			args: "time",
+			want: ScalarKind,
+		},
+		{
+			name: "decimal",
+			args: "decimal",
+			want: ScalarKind,
+		},
+		{
+			name: "color",
+			args: "color",
+			want: ScalarKind,
+		},
+		{
+			name: "schedule",
+			args: "schedule",
+			want: ScalarKind,
+		},
+		{
+			name: "vector3",
+			args: "vector3",
+			want: ScalarKind,
+		},
+		{
+			name: "tableau.Vector2i",
+			args: "tableau.Vector2i",
+			want: ScalarKind,
+		},
 		{
 			name: "Item",
 			args: "Item",
@@ -572,3 +682,37 @@ func TestParseTypeDescriptor(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTypeDescriptor_DateAndTime(t *testing.T) {
+	tests := map[string]string{
+		"datetime": WellKnownMessageTimestamp,
+		"duration": WellKnownMessageDuration,
+		"date":     WellKnownMessageDate,
+		"time":     WellKnownMessageTimeOfDay,
+	}
+	for rawType, want := range tests {
+		if got := ParseTypeDescriptor(rawType); got.FullName != want {
+			t.Errorf("ParseTypeDescriptor(%q).FullName = %v, want %v", rawType, got.FullName, want)
+		}
+	}
+}
+
+func TestStringFormatOf(t *testing.T) {
+	tests := map[string]tableaupb.StringFormat{
+		"uuid":   tableaupb.StringFormat_STRING_FORMAT_UUID,
+		"regexp": tableaupb.StringFormat_STRING_FORMAT_REGEXP,
+		"url":    tableaupb.StringFormat_STRING_FORMAT_URL,
+	}
+	for rawType, want := range tests {
+		got, ok := StringFormatOf(rawType)
+		if !ok || got != want {
+			t.Errorf("StringFormatOf(%q) = %v, %v, want %v, true", rawType, got, ok, want)
+		}
+		if alias := StringFormatAlias(want); alias != rawType {
+			t.Errorf("StringFormatAlias(%v) = %v, want %v", want, alias, rawType)
+		}
+	}
+	if _, ok := StringFormatOf("string"); ok {
+		t.Errorf("StringFormatOf(%q) should not be ok", "string")
+	}
+}
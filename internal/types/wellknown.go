@@ -8,6 +8,23 @@ const (
 	WellKnownMessageFraction   = "tableau.Fraction"
 	WellKnownMessageComparator = "tableau.Comparator"
 	WellKnownMessageVersion    = "tableau.Version"
+	WellKnownMessageVector2    = "tableau.Vector2"
+	WellKnownMessageVector3    = "tableau.Vector3"
+	WellKnownMessageVector4    = "tableau.Vector4"
+	WellKnownMessageVector2i   = "tableau.Vector2i"
+	WellKnownMessageVector3i   = "tableau.Vector3i"
+	WellKnownMessageVector4i   = "tableau.Vector4i"
+	WellKnownMessageDecimal    = "tableau.Decimal"
+	WellKnownMessageColor      = "tableau.Color"
+
+	WellKnownMessageInterval          = "tableau.Interval"
+	WellKnownMessageDoubleInterval    = "tableau.DoubleInterval"
+	WellKnownMessageTimestampInterval = "tableau.TimestampInterval"
+
+	WellKnownMessageDate      = "google.type.Date"
+	WellKnownMessageTimeOfDay = "google.type.TimeOfDay"
+
+	WellKnownMessageSchedule = "tableau.Schedule"
 )
 
 var wellKnownMessages map[string]string
@@ -19,6 +36,23 @@ func init() {
 		WellKnownMessageFraction:   "tableau/protobuf/wellknown.proto",
 		WellKnownMessageComparator: "tableau/protobuf/wellknown.proto",
 		WellKnownMessageVersion:    "tableau/protobuf/wellknown.proto",
+		WellKnownMessageVector2:    "tableau/protobuf/wellknown.proto",
+		WellKnownMessageVector3:    "tableau/protobuf/wellknown.proto",
+		WellKnownMessageVector4:    "tableau/protobuf/wellknown.proto",
+		WellKnownMessageVector2i:   "tableau/protobuf/wellknown.proto",
+		WellKnownMessageVector3i:   "tableau/protobuf/wellknown.proto",
+		WellKnownMessageVector4i:   "tableau/protobuf/wellknown.proto",
+		WellKnownMessageDecimal:    "tableau/protobuf/wellknown.proto",
+		WellKnownMessageColor:      "tableau/protobuf/wellknown.proto",
+
+		WellKnownMessageInterval:          "tableau/protobuf/wellknown.proto",
+		WellKnownMessageDoubleInterval:    "tableau/protobuf/wellknown.proto",
+		WellKnownMessageTimestampInterval: "tableau/protobuf/wellknown.proto",
+
+		WellKnownMessageDate:      "google/type/date.proto",
+		WellKnownMessageTimeOfDay: "google/type/timeofday.proto",
+
+		WellKnownMessageSchedule: "tableau/protobuf/wellknown.proto",
 	}
 }
 
@@ -31,10 +65,42 @@ func init() {
 //   - google.protobuf.Duration
 //   - tableau.Fraction
 //   - tableau.Comparator
+//   - tableau.Version
+//   - tableau.Vector2, tableau.Vector3, tableau.Vector4
+//   - tableau.Vector2i, tableau.Vector3i, tableau.Vector4i
+//   - tableau.Decimal
+//   - tableau.Color
+//   - tableau.Interval, tableau.DoubleInterval, tableau.TimestampInterval
+//   - google.type.Date
+//   - google.type.TimeOfDay
+//   - tableau.Schedule
 func IsWellKnownMessage[T protoreflect.FullName | string](fullTypeName T) bool {
 	return wellKnownMessages[string(fullTypeName)] != ""
 }
 
+// IsWellKnownVector checks if the given message full name is a well-known
+// vector message, e.g.: tableau.Vector3 or tableau.Vector3i.
+func IsWellKnownVector[T protoreflect.FullName | string](fullTypeName T) bool {
+	switch string(fullTypeName) {
+	case WellKnownMessageVector2, WellKnownMessageVector3, WellKnownMessageVector4,
+		WellKnownMessageVector2i, WellKnownMessageVector3i, WellKnownMessageVector4i:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsWellKnownInterval checks if the given message full name is a well-known
+// interval message, e.g.: tableau.Interval or tableau.TimestampInterval.
+func IsWellKnownInterval[T protoreflect.FullName | string](fullTypeName T) bool {
+	switch string(fullTypeName) {
+	case WellKnownMessageInterval, WellKnownMessageDoubleInterval, WellKnownMessageTimestampInterval:
+		return true
+	default:
+		return false
+	}
+}
+
 func GetWellKnownMessageImport(fullTypeName string) string {
 	return wellKnownMessages[fullTypeName]
 }
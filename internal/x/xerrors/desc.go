@@ -258,6 +258,52 @@ func (d *Desc) Stringify(withDebug bool) string {
 	}
 }
 
+// Compact renders the description compactly, one line per error:
+//
+//	error[<ErrCode>] <BookName>#<SheetName> <CellPos>: <Reason>
+//
+// Missing parts are omitted, and a plain error is rendered by its first line.
+func (d *Desc) Compact() string {
+	var leaves []*Desc
+	flattenDescs(d, &leaves)
+	lines := make([]string, 0, len(leaves))
+	for _, leaf := range leaves {
+		lines = append(lines, leaf.compactLine())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (d *Desc) compactLine() string {
+	if d.err == nil {
+		return ""
+	}
+	reason, ok := d.fields[KeyReason].(string)
+	if !ok {
+		reason, _, _ = strings.Cut(d.err.Error(), "\n")
+	}
+	var sb strings.Builder
+	if code := d.fields[keyErrCode]; code != nil {
+		fmt.Fprintf(&sb, "error[%v]", code)
+	} else {
+		sb.WriteString("error")
+	}
+	if book := d.fields[KeyBookName]; book != nil {
+		fmt.Fprintf(&sb, " %v", book)
+		if sheet := d.fields[KeySheetName]; sheet != nil {
+			fmt.Fprintf(&sb, "#%v", sheet)
+		}
+	}
+	for _, key := range []string{KeyDataCellPos, KeyTypeCellPos, KeyNameCellPos} {
+		if pos := d.fields[key]; pos != nil && pos != "" {
+			fmt.Fprintf(&sb, " %v", pos)
+			break
+		}
+	}
+	sb.WriteString(": ")
+	sb.WriteString(reason)
+	return sb.String()
+}
+
 // fieldsString returns all structured fields as an ordered multi-line string.
 func (d *Desc) fieldsString() string {
 	var lines []string
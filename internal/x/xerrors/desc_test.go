@@ -218,6 +218,23 @@ Help: fix the field value to satisfy the protovalidate rule
 	assert.Equal(t, wantNoDebug, md.Stringify(false))
 }
 
+// TestDescCompact verifies the compact one-line-per-error rendering.
+func TestDescCompact(t *testing.T) {
+	e1 := WrapKV(E2027("score: value must be > 0", "800"),
+		KeyDataCellPos, "B5",
+	)
+	e2 := fmt.Errorf("plain error\nwith details")
+	wrapped := WrapKV(errors.Join(e1, e2),
+		KeyModule, ModuleConf,
+		KeyBookName, "Validate#*.csv",
+		KeySheetName, "ValidateFieldLevel",
+	)
+	want := `error[E2027] Validate#*.csv#ValidateFieldLevel B5: "800" violates rule: score: value must be > 0
+error Validate#*.csv#ValidateFieldLevel: plain error`
+	assert.Equal(t, want, NewDesc(wrapped).Compact())
+	assert.Equal(t, "error: plain error", NewDesc(fmt.Errorf("plain error")).Compact())
+}
+
 // TestNewDescWrapKVOverJoinSingleChild verifies WrapKV wrapping errors.Join
 // with exactly one non-nil child → single Desc (not numbered list).
 func TestNewDescWrapKVOverJoinSingleChild(t *testing.T) {
@@ -0,0 +1,70 @@
+package xerrors
+
+import "fmt"
+
+// Diagnostic is the machine-readable form of an error, e.g.: to be consumed
+// by CI bots and editor plugins.
+type Diagnostic struct {
+	Code    string `json:"code,omitempty"`    // error code, e.g.: E2005
+	Desc    string `json:"desc,omitempty"`    // error code description
+	Module  string `json:"module,omitempty"`  // default, protogen, or confgen
+	Book    string `json:"book,omitempty"`    // workbook name
+	Sheet   string `json:"sheet,omitempty"`   // worksheet name
+	CellPos string `json:"cellPos,omitempty"` // cell position, e.g.: A5
+	Column  string `json:"column,omitempty"`  // column name
+	Value   string `json:"value,omitempty"`   // cell value
+	Reason  string `json:"reason,omitempty"`  // localized error reason
+	Help    string `json:"help,omitempty"`    // localized suggestion to fix the error
+	Message string `json:"message"`           // localized full message, same as the text output
+}
+
+// Diagnostics returns one diagnostic per error. Returns nil for nil d.
+func (d *Desc) Diagnostics() []*Diagnostic {
+	if d == nil {
+		return nil
+	}
+	var leaves []*Desc
+	flattenDescs(d, &leaves)
+	diags := make([]*Diagnostic, 0, len(leaves))
+	for _, leaf := range leaves {
+		diags = append(diags, leaf.diagnostic())
+	}
+	return diags
+}
+
+func (d *Desc) diagnostic() *Diagnostic {
+	// NOTE: render message first, as it populates the default ecode fields.
+	diag := &Diagnostic{Message: d.String()}
+	str := func(key string) string {
+		if val := d.fields[key]; val != nil {
+			return fmt.Sprint(val)
+		}
+		return ""
+	}
+	if d.fields[KeyReason] != nil {
+		diag.Code = str(keyErrCode)
+		diag.Desc = str(keyErrDesc)
+		diag.Reason = str(KeyReason)
+		diag.Help = str(keyHelp)
+	}
+	diag.Module = str(KeyModule)
+	diag.Book = str(KeyBookName)
+	diag.Sheet = str(KeySheetName)
+	diag.Column = str(KeyColumnName)
+	// the most specific cell position and its value
+	for _, pair := range [][2]string{
+		{KeyDataCellPos, KeyDataCell},
+		{KeyTypeCellPos, KeyTypeCell},
+		{KeyNameCellPos, KeyNameCell},
+	} {
+		if pos := str(pair[0]); pos != "" {
+			diag.CellPos = pos
+			diag.Value = str(pair[1])
+			break
+		}
+	}
+	if diag.CellPos == "" {
+		diag.Value = str(KeyDataCell)
+	}
+	return diag
+}
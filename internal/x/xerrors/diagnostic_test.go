@@ -0,0 +1,59 @@
+package xerrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescDiagnostics(t *testing.T) {
+	e1 := WrapKV(E2027("score: value must be > 0", "800"),
+		KeyDataCellPos, "B5",
+		KeyDataCell, "800",
+		KeyColumnName, "Score",
+	)
+	e2 := fmt.Errorf("plain error")
+	wrapped := WrapKV(errors.Join(e1, e2),
+		KeyModule, ModuleConf,
+		KeyBookName, "Validate#*.csv",
+		KeySheetName, "ValidateFieldLevel",
+	)
+	diags := NewDesc(wrapped).Diagnostics()
+	require.Len(t, diags, 2)
+	assert.Equal(t, &Diagnostic{
+		Code:    "E2027",
+		Desc:    "protovalidate violation",
+		Module:  ModuleConf,
+		Book:    "Validate#*.csv",
+		Sheet:   "ValidateFieldLevel",
+		CellPos: "B5",
+		Column:  "Score",
+		Value:   "800",
+		Reason:  `"800" violates rule: score: value must be > 0`,
+		Help:    "fix the field value to satisfy the protovalidate rule",
+		Message: `error[E2027]: protovalidate violation
+Workbook: Validate#*.csv
+Worksheet: ValidateFieldLevel
+DataCellPos: B5
+DataCell: 800
+Reason: "800" violates rule: score: value must be > 0
+Help: fix the field value to satisfy the protovalidate rule
+`,
+	}, diags[0])
+	assert.Equal(t, &Diagnostic{
+		Module:  ModuleConf,
+		Book:    "Validate#*.csv",
+		Sheet:   "ValidateFieldLevel",
+		Message: "plain error",
+	}, diags[1])
+
+	diags = NewDesc(WrapKV(E2005("1"), KeyModule, ModuleProto, KeyTypeCellPos, "A2", KeyTypeCell, "map<uint32, Item>")).Diagnostics()
+	require.Len(t, diags, 1)
+	assert.Equal(t, "A2", diags[0].CellPos)
+	assert.Equal(t, "map<uint32, Item>", diags[0].Value)
+
+	assert.Nil(t, NewDesc(nil).Diagnostics())
+}
@@ -5,6 +5,13 @@ var ErrE0001 = newEcode("E0001", `sheet not found in book`)
 var ErrE0002 = newEcode("E0002", `cannot unmarshal file content to given proto.Message`)
 var ErrE0003 = newEcode("E0003", `duplicate column name`)
 var ErrE0004 = newEcode("E0004", `unknown error`)
+var ErrE1000 = newEcode("E1000", `messager removed`)
+var ErrE1001 = newEcode("E1001", `messager renamed`)
+var ErrE1002 = newEcode("E1002", `field type changed`)
+var ErrE1003 = newEcode("E1003", `map key type changed`)
+var ErrE1004 = newEcode("E1004", `field number changed`)
+var ErrE1005 = newEcode("E1005", `field removed without reservation`)
+var ErrE1006 = newEcode("E1006", `enum value removed without reservation`)
 var ErrE2000 = newEcode("E2000", `integer overflow`)
 var ErrE2001 = newEcode("E2001", `field prop "refer" not configured correctly`)
 var ErrE2002 = newEcode("E2002", `field value not in referred space`)
@@ -34,6 +41,30 @@ var ErrE2025 = newEcode("E2025", `version value mismatches pattern`)
 var ErrE2026 = newEcode("E2026", `illegally ordered values`)
 var ErrE2027 = newEcode("E2027", `protovalidate violation`)
 var ErrE2028 = newEcode("E2028", `duplicate elements in incell keyed-list`)
+var ErrE2029 = newEcode("E2029", `failed to compute field by CEL expression`)
+var ErrE2030 = newEcode("E2030", `value not unique in unique domain across sheets`)
+var ErrE2031 = newEcode("E2031", `field value required by condition`)
+var ErrE2032 = newEcode("E2032", `mutually exclusive fields both present`)
+var ErrE2033 = newEcode("E2033", `field value comparison with another field failed`)
+var ErrE2034 = newEcode("E2034", `invalid vector pattern`)
+var ErrE2035 = newEcode("E2035", `invalid decimal`)
+var ErrE2036 = newEcode("E2036", `decimal value exceeds precision or scale`)
+var ErrE2037 = newEcode("E2037", `invalid color`)
+var ErrE2038 = newEcode("E2038", `invalid interval`)
+var ErrE2039 = newEcode("E2039", `overlapped intervals`)
+var ErrE2040 = newEcode("E2040", `negative weight`)
+var ErrE2041 = newEcode("E2041", `illegal total weight`)
+var ErrE2042 = newEcode("E2042", `duplicate bit flag`)
+var ErrE2043 = newEcode("E2043", `bit flag out of range`)
+var ErrE2044 = newEcode("E2044", `invalid date format`)
+var ErrE2045 = newEcode("E2045", `invalid time of day format`)
+var ErrE2046 = newEcode("E2046", `invalid cron expression`)
+var ErrE2047 = newEcode("E2047", `missing translation`)
+var ErrE2048 = newEcode("E2048", `stale translation`)
+var ErrE2049 = newEcode("E2049", `invalid UUID`)
+var ErrE2050 = newEcode("E2050", `invalid regular expression`)
+var ErrE2051 = newEcode("E2051", `invalid URL`)
+var ErrE2052 = newEcode("E2052", `text key collision`)
 var ErrE3000 = newEcode("E3000", `no workbook file found about sheet specifier`)
 var ErrE3001 = newEcode("E3001", `no worksheet found in workbook`)
 var ErrE3002 = newEcode("E3002", `failed to open file`)
@@ -74,6 +105,66 @@ func E0004() error {
 	return renderEcode(ErrE0004, map[string]any{})
 }
 
+// E1000: messager removed
+func E1000(messagerName string, sheetName string) error {
+	return renderEcode(ErrE1000, map[string]any{
+		"MessagerName": messagerName,
+		"SheetName":    sheetName,
+	})
+}
+
+// E1001: messager renamed
+func E1001(oldName string, newName string) error {
+	return renderEcode(ErrE1001, map[string]any{
+		"OldName": oldName,
+		"NewName": newName,
+	})
+}
+
+// E1002: field type changed
+func E1002(fieldName string, oldType string, newType string) error {
+	return renderEcode(ErrE1002, map[string]any{
+		"FieldName": fieldName,
+		"OldType":   oldType,
+		"NewType":   newType,
+	})
+}
+
+// E1003: map key type changed
+func E1003(fieldName string, oldType string, newType string) error {
+	return renderEcode(ErrE1003, map[string]any{
+		"FieldName": fieldName,
+		"OldType":   oldType,
+		"NewType":   newType,
+	})
+}
+
+// E1004: field number changed
+func E1004(fieldName string, oldNumber int32, newNumber int32) error {
+	return renderEcode(ErrE1004, map[string]any{
+		"FieldName": fieldName,
+		"OldNumber": oldNumber,
+		"NewNumber": newNumber,
+	})
+}
+
+// E1005: field removed without reservation
+func E1005(fieldName string, fieldNumber int32) error {
+	return renderEcode(ErrE1005, map[string]any{
+		"FieldName":   fieldName,
+		"FieldNumber": fieldNumber,
+	})
+}
+
+// E1006: enum value removed without reservation
+func E1006(enumName string, valueName string, valueNumber int32) error {
+	return renderEcode(ErrE1006, map[string]any{
+		"EnumName":    enumName,
+		"ValueName":   valueName,
+		"ValueNumber": valueNumber,
+	})
+}
+
 // E2000: integer overflow
 func E2000(type_ string, value string, min any, max any) error {
 	return renderEcode(ErrE2000, map[string]any{
@@ -326,6 +417,241 @@ func E2028(elem any) error {
 	})
 }
 
+// E2029: failed to compute field by CEL expression
+func E2029(expr string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2029, map[string]any{
+		"Expr":  expr,
+		"Error": error_,
+	})
+}
+
+// E2030: value not unique in unique domain across sheets
+func E2030(domain string, value string, locations string) error {
+	return renderEcode(ErrE2030, map[string]any{
+		"Domain":    domain,
+		"Value":     value,
+		"Locations": locations,
+	})
+}
+
+// E2031: field value required by condition
+func E2031(fieldName string, condition string) error {
+	return renderEcode(ErrE2031, map[string]any{
+		"FieldName": fieldName,
+		"Condition": condition,
+	})
+}
+
+// E2032: mutually exclusive fields both present
+func E2032(fieldName string, otherFieldName string) error {
+	return renderEcode(ErrE2032, map[string]any{
+		"FieldName":      fieldName,
+		"OtherFieldName": otherFieldName,
+	})
+}
+
+// E2033: field value comparison with another field failed
+func E2033(fieldName string, value string, operator string, otherFieldName string, otherValue string) error {
+	return renderEcode(ErrE2033, map[string]any{
+		"FieldName":      fieldName,
+		"Value":          value,
+		"Operator":       operator,
+		"OtherFieldName": otherFieldName,
+		"OtherValue":     otherValue,
+	})
+}
+
+// E2034: invalid vector pattern
+func E2034(value string, dimension int, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2034, map[string]any{
+		"Value":     value,
+		"Dimension": dimension,
+		"Error":     error_,
+	})
+}
+
+// E2035: invalid decimal
+func E2035(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2035, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2036: decimal value exceeds precision or scale
+func E2036(value string, pattern string) error {
+	return renderEcode(ErrE2036, map[string]any{
+		"Value":   value,
+		"Pattern": pattern,
+	})
+}
+
+// E2037: invalid color
+func E2037(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2037, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2038: invalid interval
+func E2038(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2038, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2039: overlapped intervals
+func E2039(value string, prevValue string) error {
+	return renderEcode(ErrE2039, map[string]any{
+		"Value":     value,
+		"PrevValue": prevValue,
+	})
+}
+
+// E2040: negative weight
+func E2040(value string) error {
+	return renderEcode(ErrE2040, map[string]any{
+		"Value": value,
+	})
+}
+
+// E2041: illegal total weight
+func E2041(fieldName string, total string, want string) error {
+	return renderEcode(ErrE2041, map[string]any{
+		"FieldName": fieldName,
+		"Total":     total,
+		"Want":      want,
+	})
+}
+
+// E2042: duplicate bit flag
+func E2042(value string, flag string, enumName any) error {
+	return renderEcode(ErrE2042, map[string]any{
+		"Value":    value,
+		"Flag":     flag,
+		"EnumName": enumName,
+	})
+}
+
+// E2043: bit flag out of range
+func E2043(flag string, enumName any, number int32) error {
+	return renderEcode(ErrE2043, map[string]any{
+		"Flag":     flag,
+		"EnumName": enumName,
+		"Number":   number,
+	})
+}
+
+// E2044: invalid date format
+func E2044(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2044, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2045: invalid time of day format
+func E2045(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2045, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2046: invalid cron expression
+func E2046(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2046, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2047: missing translation
+func E2047(lang string, key string, source string) error {
+	return renderEcode(ErrE2047, map[string]any{
+		"Lang":   lang,
+		"Key":    key,
+		"Source": source,
+	})
+}
+
+// E2048: stale translation
+func E2048(lang string, key string, source string) error {
+	return renderEcode(ErrE2048, map[string]any{
+		"Lang":   lang,
+		"Key":    key,
+		"Source": source,
+	})
+}
+
+// E2049: invalid UUID
+func E2049(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2049, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2050: invalid regular expression
+func E2050(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2050, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2051: invalid URL
+func E2051(value string, error_ error) error {
+	if error_ == nil {
+		return nil
+	}
+	return renderEcode(ErrE2051, map[string]any{
+		"Value": value,
+		"Error": error_,
+	})
+}
+
+// E2052: text key collision
+func E2052(key string, source string, other string) error {
+	return renderEcode(ErrE2052, map[string]any{
+		"Key":    key,
+		"Source": source,
+		"Other":  other,
+	})
+}
+
 // E3000: no workbook file found about sheet specifier
 func E3000(sheetSpecifier string, pattern string) error {
 	return renderEcode(ErrE3000, map[string]any{
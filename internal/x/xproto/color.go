@@ -0,0 +1,280 @@
+package xproto
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// parseColor parses a RGBA color from following forms:
+//   - hex: #RGB, #RGBA, #RRGGBB, #RRGGBBAA, e.g.: #FF8000, #FF800080
+//   - functional: rgb(255, 128, 0), rgba(255, 128, 0, 0.5)
+//   - named CSS colors (case-insensitive): red, orange, transparent, ...
+//
+// The alpha channel is 255 (opaque) if not specified.
+func parseColor(md pref.MessageDescriptor, value string) (v pref.Value, present bool, err error) {
+	rgba, err := parseRGBA(value)
+	if err != nil {
+		return DefaultColorValue, false, xerrors.E2037(value, err)
+	}
+	msg := dynamicpb.NewMessage(md)
+	for i, name := range []pref.Name{"r", "g", "b", "a"} {
+		msg.Set(md.Fields().ByName(name), pref.ValueOfUint32(uint32(rgba[i])))
+	}
+	return pref.ValueOfMessage(msg.ProtoReflect()), true, nil
+}
+
+func parseRGBA(value string) ([4]uint8, error) {
+	text := strings.ToLower(value)
+	switch {
+	case strings.HasPrefix(text, "#"):
+		return parseHexColor(text[1:])
+	case strings.HasPrefix(text, "rgb(") || strings.HasPrefix(text, "rgba("):
+		return parseFunctionalColor(text)
+	default:
+		if text == "transparent" {
+			return [4]uint8{0, 0, 0, 0}, nil
+		}
+		rgb, ok := namedColors[text]
+		if !ok {
+			return [4]uint8{}, fmt.Errorf("unknown color name: %s", value)
+		}
+		return [4]uint8{uint8(rgb >> 16), uint8(rgb >> 8), uint8(rgb), math.MaxUint8}, nil
+	}
+}
+
+// parseHexColor parses hex digits of forms: RGB, RGBA, RRGGBB, and RRGGBBAA.
+func parseHexColor(hex string) ([4]uint8, error) {
+	switch len(hex) {
+	case 3, 4:
+		// shorthand: each digit is duplicated, e.g.: "F80" -> "FF8800"
+		var sb strings.Builder
+		for _, c := range hex {
+			sb.WriteRune(c)
+			sb.WriteRune(c)
+		}
+		hex = sb.String()
+	case 6, 8:
+	default:
+		return [4]uint8{}, fmt.Errorf("invalid count of hex digits: %d", len(hex))
+	}
+	rgba := [4]uint8{0, 0, 0, math.MaxUint8}
+	for i := 0; i < len(hex)/2; i++ {
+		n, err := strconv.ParseUint(hex[2*i:2*i+2], 16, 8)
+		if err != nil {
+			return [4]uint8{}, fmt.Errorf("invalid hex digits: %s", hex[2*i:2*i+2])
+		}
+		rgba[i] = uint8(n)
+	}
+	return rgba, nil
+}
+
+// parseFunctionalColor parses functional notations: rgb(R, G, B) and
+// rgba(R, G, B, A), where R, G, and B range from 0 to 255, and A ranges from
+// 0 to 1.
+func parseFunctionalColor(text string) ([4]uint8, error) {
+	if !strings.HasSuffix(text, ")") {
+		return [4]uint8{}, fmt.Errorf("missing closing parenthesis")
+	}
+	name, args, _ := strings.Cut(strings.TrimSuffix(text, ")"), "(")
+	channels := strings.Split(args, ",")
+	wantCount := 3
+	if name == "rgba" {
+		wantCount = 4
+	}
+	if len(channels) != wantCount {
+		return [4]uint8{}, fmt.Errorf("%s() requires %d channels, but got %d", name, wantCount, len(channels))
+	}
+	rgba := [4]uint8{0, 0, 0, math.MaxUint8}
+	for i := 0; i < 3; i++ {
+		channel := strings.TrimSpace(channels[i])
+		n, err := strconv.ParseUint(channel, 10, 8)
+		if err != nil {
+			return [4]uint8{}, fmt.Errorf("channel %q should be an integer in range [0,255]", channel)
+		}
+		rgba[i] = uint8(n)
+	}
+	if wantCount == 4 {
+		channel := strings.TrimSpace(channels[3])
+		alpha, err := strconv.ParseFloat(channel, 64)
+		if err != nil || alpha < 0 || alpha > 1 {
+			return [4]uint8{}, fmt.Errorf("alpha %q should be a number in range [0,1]", channel)
+		}
+		rgba[3] = uint8(math.Round(alpha * math.MaxUint8))
+	}
+	return rgba, nil
+}
+
+// FormatColor formats a well-known color message to the canonical hex form:
+// "#RRGGBB" if opaque, otherwise "#RRGGBBAA", e.g.: "#FF8000" and
+// "#FF800080".
+func FormatColor(msg pref.Message) string {
+	fields := msg.Descriptor().Fields()
+	channel := func(name pref.Name) uint64 {
+		return msg.Get(fields.ByName(name)).Uint()
+	}
+	a := channel("a")
+	if a == math.MaxUint8 {
+		return fmt.Sprintf("#%02X%02X%02X", channel("r"), channel("g"), channel("b"))
+	}
+	return fmt.Sprintf("#%02X%02X%02X%02X", channel("r"), channel("g"), channel("b"), a)
+}
+
+// namedColors maps CSS named colors to RGB values.
+//
+// See https://www.w3.org/TR/css-color-4/#named-colors.
+var namedColors = map[string]uint32{
+	"aliceblue":            0xF0F8FF,
+	"antiquewhite":         0xFAEBD7,
+	"aqua":                 0x00FFFF,
+	"aquamarine":           0x7FFFD4,
+	"azure":                0xF0FFFF,
+	"beige":                0xF5F5DC,
+	"bisque":               0xFFE4C4,
+	"black":                0x000000,
+	"blanchedalmond":       0xFFEBCD,
+	"blue":                 0x0000FF,
+	"blueviolet":           0x8A2BE2,
+	"brown":                0xA52A2A,
+	"burlywood":            0xDEB887,
+	"cadetblue":            0x5F9EA0,
+	"chartreuse":           0x7FFF00,
+	"chocolate":            0xD2691E,
+	"coral":                0xFF7F50,
+	"cornflowerblue":       0x6495ED,
+	"cornsilk":             0xFFF8DC,
+	"crimson":              0xDC143C,
+	"cyan":                 0x00FFFF,
+	"darkblue":             0x00008B,
+	"darkcyan":             0x008B8B,
+	"darkgoldenrod":        0xB8860B,
+	"darkgray":             0xA9A9A9,
+	"darkgreen":            0x006400,
+	"darkgrey":             0xA9A9A9,
+	"darkkhaki":            0xBDB76B,
+	"darkmagenta":          0x8B008B,
+	"darkolivegreen":       0x556B2F,
+	"darkorange":           0xFF8C00,
+	"darkorchid":           0x9932CC,
+	"darkred":              0x8B0000,
+	"darksalmon":           0xE9967A,
+	"darkseagreen":         0x8FBC8F,
+	"darkslateblue":        0x483D8B,
+	"darkslategray":        0x2F4F4F,
+	"darkslategrey":        0x2F4F4F,
+	"darkturquoise":        0x00CED1,
+	"darkviolet":           0x9400D3,
+	"deeppink":             0xFF1493,
+	"deepskyblue":          0x00BFFF,
+	"dimgray":              0x696969,
+	"dimgrey":              0x696969,
+	"dodgerblue":           0x1E90FF,
+	"firebrick":            0xB22222,
+	"floralwhite":          0xFFFAF0,
+	"forestgreen":          0x228B22,
+	"fuchsia":              0xFF00FF,
+	"gainsboro":            0xDCDCDC,
+	"ghostwhite":           0xF8F8FF,
+	"gold":                 0xFFD700,
+	"goldenrod":            0xDAA520,
+	"gray":                 0x808080,
+	"green":                0x008000,
+	"greenyellow":          0xADFF2F,
+	"grey":                 0x808080,
+	"honeydew":             0xF0FFF0,
+	"hotpink":              0xFF69B4,
+	"indianred":            0xCD5C5C,
+	"indigo":               0x4B0082,
+	"ivory":                0xFFFFF0,
+	"khaki":                0xF0E68C,
+	"lavender":             0xE6E6FA,
+	"lavenderblush":        0xFFF0F5,
+	"lawngreen":            0x7CFC00,
+	"lemonchiffon":         0xFFFACD,
+	"lightblue":            0xADD8E6,
+	"lightcoral":           0xF08080,
+	"lightcyan":            0xE0FFFF,
+	"lightgoldenrodyellow": 0xFAFAD2,
+	"lightgray":            0xD3D3D3,
+	"lightgreen":           0x90EE90,
+	"lightgrey":            0xD3D3D3,
+	"lightpink":            0xFFB6C1,
+	"lightsalmon":          0xFFA07A,
+	"lightseagreen":        0x20B2AA,
+	"lightskyblue":         0x87CEFA,
+	"lightslategray":       0x778899,
+	"lightslategrey":       0x778899,
+	"lightsteelblue":       0xB0C4DE,
+	"lightyellow":          0xFFFFE0,
+	"lime":                 0x00FF00,
+	"limegreen":            0x32CD32,
+	"linen":                0xFAF0E6,
+	"magenta":              0xFF00FF,
+	"maroon":               0x800000,
+	"mediumaquamarine":     0x66CDAA,
+	"mediumblue":           0x0000CD,
+	"mediumorchid":         0xBA55D3,
+	"mediumpurple":         0x9370DB,
+	"mediumseagreen":       0x3CB371,
+	"mediumslateblue":      0x7B68EE,
+	"mediumspringgreen":    0x00FA9A,
+	"mediumturquoise":      0x48D1CC,
+	"mediumvioletred":      0xC71585,
+	"midnightblue":         0x191970,
+	"mintcream":            0xF5FFFA,
+	"mistyrose":            0xFFE4E1,
+	"moccasin":             0xFFE4B5,
+	"navajowhite":          0xFFDEAD,
+	"navy":                 0x000080,
+	"oldlace":              0xFDF5E6,
+	"olive":                0x808000,
+	"olivedrab":            0x6B8E23,
+	"orange":               0xFFA500,
+	"orangered":            0xFF4500,
+	"orchid":               0xDA70D6,
+	"palegoldenrod":        0xEEE8AA,
+	"palegreen":            0x98FB98,
+	"paleturquoise":        0xAFEEEE,
+	"palevioletred":        0xDB7093,
+	"papayawhip":           0xFFEFD5,
+	"peachpuff":            0xFFDAB9,
+	"peru":                 0xCD853F,
+	"pink":                 0xFFC0CB,
+	"plum":                 0xDDA0DD,
+	"powderblue":           0xB0E0E6,
+	"purple":               0x800080,
+	"rebeccapurple":        0x663399,
+	"red":                  0xFF0000,
+	"rosybrown":            0xBC8F8F,
+	"royalblue":            0x4169E1,
+	"saddlebrown":          0x8B4513,
+	"salmon":               0xFA8072,
+	"sandybrown":           0xF4A460,
+	"seagreen":             0x2E8B57,
+	"seashell":             0xFFF5EE,
+	"sienna":               0xA0522D,
+	"silver":               0xC0C0C0,
+	"skyblue":              0x87CEEB,
+	"slateblue":            0x6A5ACD,
+	"slategray":            0x708090,
+	"slategrey":            0x708090,
+	"snow":                 0xFFFAFA,
+	"springgreen":          0x00FF7F,
+	"steelblue":            0x4682B4,
+	"tan":                  0xD2B48C,
+	"teal":                 0x008080,
+	"thistle":              0xD8BFD8,
+	"tomato":               0xFF6347,
+	"turquoise":            0x40E0D0,
+	"violet":               0xEE82EE,
+	"wheat":                0xF5DEB3,
+	"white":                0xFFFFFF,
+	"whitesmoke":           0xF5F5F5,
+	"yellow":               0xFFFF00,
+	"yellowgreen":          0x9ACD32,
+}
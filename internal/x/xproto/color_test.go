@@ -0,0 +1,120 @@
+package xproto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func Test_parseColor(t *testing.T) {
+	md := (&tableaupb.Color{}).ProtoReflect().Descriptor()
+	tests := []struct {
+		name  string
+		value string
+		wantV *tableaupb.Color
+		err   error
+	}{
+		{
+			name:  "hex-RRGGBB",
+			value: "#FF8000",
+			wantV: &tableaupb.Color{R: 255, G: 128, B: 0, A: 255},
+		},
+		{
+			name:  "hex-RRGGBBAA",
+			value: "#ff800080",
+			wantV: &tableaupb.Color{R: 255, G: 128, B: 0, A: 128},
+		},
+		{
+			name:  "hex-RGB",
+			value: "#F80",
+			wantV: &tableaupb.Color{R: 255, G: 136, B: 0, A: 255},
+		},
+		{
+			name:  "hex-RGBA",
+			value: "#F808",
+			wantV: &tableaupb.Color{R: 255, G: 136, B: 0, A: 136},
+		},
+		{
+			name:  "rgb",
+			value: "rgb(255, 128, 0)",
+			wantV: &tableaupb.Color{R: 255, G: 128, B: 0, A: 255},
+		},
+		{
+			name:  "rgba",
+			value: "RGBA(255,128,0,0.5)",
+			wantV: &tableaupb.Color{R: 255, G: 128, B: 0, A: 128},
+		},
+		{
+			name:  "named",
+			value: "Orange",
+			wantV: &tableaupb.Color{R: 255, G: 165, B: 0, A: 255},
+		},
+		{
+			name:  "transparent",
+			value: "transparent",
+			wantV: &tableaupb.Color{},
+		},
+		{
+			name:  "hex-invalid-count",
+			value: "#FF800",
+			err:   xerrors.ErrE2037,
+		},
+		{
+			name:  "hex-invalid-digit",
+			value: "#GG8000",
+			err:   xerrors.ErrE2037,
+		},
+		{
+			name:  "rgb-out-of-range",
+			value: "rgb(256, 0, 0)",
+			err:   xerrors.ErrE2037,
+		},
+		{
+			name:  "rgb-negative",
+			value: "rgb(-1, 0, 0)",
+			err:   xerrors.ErrE2037,
+		},
+		{
+			name:  "rgba-alpha-out-of-range",
+			value: "rgba(255, 0, 0, 1.5)",
+			err:   xerrors.ErrE2037,
+		},
+		{
+			name:  "rgb-channel-count-mismatch",
+			value: "rgb(255, 0, 0, 1)",
+			err:   xerrors.ErrE2037,
+		},
+		{
+			name:  "rgb-missing-parenthesis",
+			value: "rgb(255, 0, 0",
+			err:   xerrors.ErrE2037,
+		},
+		{
+			name:  "unknown-name",
+			value: "unknowncolor",
+			err:   xerrors.ErrE2037,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotV, present, err := parseColor(md, tt.value)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, present)
+			assert.True(t, gotV.Equal(pref.ValueOfMessage(tt.wantV.ProtoReflect())), "got %v, want %v", gotV, tt.wantV)
+		})
+	}
+}
+
+func TestFormatColor(t *testing.T) {
+	assert.Equal(t, "#FF8000", FormatColor((&tableaupb.Color{R: 255, G: 128, B: 0, A: 255}).ProtoReflect()))
+	assert.Equal(t, "#FF800080", FormatColor((&tableaupb.Color{R: 255, G: 128, B: 0, A: 128}).ProtoReflect()))
+	assert.Equal(t, "#00000000", FormatColor((&tableaupb.Color{}).ProtoReflect()))
+}
@@ -0,0 +1,178 @@
+package xproto
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// ParseDate parses a civil date (at midnight UTC) from following forms:
+//   - yyyy-MM-dd, e.g.: 2020-01-01
+//   - yyyyMMdd, e.g.: 20200101
+//
+// NOTE: date has no time zone, so it is not affected by location.
+func ParseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	layout := time.DateOnly
+	if !strings.Contains(value, "-") {
+		layout = "20060102"
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if t.Year() < 1 || t.Year() > 9999 {
+		return time.Time{}, fmt.Errorf("year %d is out of range [1,9999]", t.Year())
+	}
+	return t, nil
+}
+
+// parseDate parses the well-known google.type.Date by [ParseDate].
+func parseDate(md pref.MessageDescriptor, value string) (v pref.Value, present bool, err error) {
+	t, err := ParseDate(value)
+	if err != nil {
+		return DefaultDateValue, false, xerrors.E2044(value, err)
+	}
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("year"), pref.ValueOfInt32(int32(t.Year())))
+	msg.Set(md.Fields().ByName("month"), pref.ValueOfInt32(int32(t.Month())))
+	msg.Set(md.Fields().ByName("day"), pref.ValueOfInt32(int32(t.Day())))
+	return pref.ValueOfMessage(msg.ProtoReflect()), true, nil
+}
+
+// DateOf converts the well-known google.type.Date message to time at
+// midnight UTC.
+func DateOf(msg pref.Message) time.Time {
+	fields := msg.Descriptor().Fields()
+	year := msg.Get(fields.ByName("year")).Int()
+	month := msg.Get(fields.ByName("month")).Int()
+	day := msg.Get(fields.ByName("day")).Int()
+	return time.Date(int(year), time.Month(month), int(day), 0, 0, 0, 0, time.UTC)
+}
+
+// FormatDate formats the well-known google.type.Date message to the form
+// "yyyy-MM-dd".
+func FormatDate(msg pref.Message) string {
+	return DateOf(msg).Format(time.DateOnly)
+}
+
+// ParseTimeOfDay parses a time of day as the duration since midnight from
+// following forms:
+//   - HH:mm, e.g.: 10:30
+//   - HH:mm:ss, e.g.: 10:30:00
+//   - HH:mm:ss.fff, e.g.: 10:30:00.5
+//   - HHmm, e.g.: 1030
+//   - HHmmss, e.g.: 103000
+//
+// The time of day should be in range [00:00:00, 24:00:00], where 24:00:00 is
+// allowed for scenarios like closing time.
+func ParseTimeOfDay(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	var parts []string
+	if strings.Contains(value, ":") {
+		parts = strings.Split(value, ":")
+	} else {
+		switch len(value) {
+		case 4:
+			parts = []string{value[0:2], value[2:4]}
+		case 6:
+			parts = []string{value[0:2], value[2:4], value[4:6]}
+		default:
+			return 0, fmt.Errorf(`compact form should be "HHmm" or "HHmmss"`)
+		}
+	}
+	if len(parts) != 2 && len(parts) != 3 {
+		return 0, fmt.Errorf(`colon-separated form should be "HH:mm" or "HH:mm:ss"`)
+	}
+	hours, err := parseTimeOfDayPart("hours", parts[0], 24)
+	if err != nil {
+		return 0, err
+	}
+	minutes, err := parseTimeOfDayPart("minutes", parts[1], 59)
+	if err != nil {
+		return 0, err
+	}
+	var seconds, nanos int
+	if len(parts) == 3 {
+		secStr, fracStr, found := strings.Cut(parts[2], ".")
+		seconds, err = parseTimeOfDayPart("seconds", secStr, 59)
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			if fracStr == "" || len(fracStr) > 9 || !isDigits(fracStr) {
+				return 0, fmt.Errorf("invalid fraction of seconds %q", fracStr)
+			}
+			nanos, _ = strconv.Atoi(fracStr + strings.Repeat("0", 9-len(fracStr)))
+		}
+	}
+	d := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second + time.Duration(nanos)
+	if d > 24*time.Hour {
+		return 0, fmt.Errorf("time of day is out of range [00:00:00, 24:00:00]")
+	}
+	return d, nil
+}
+
+func parseTimeOfDayPart(name, value string, max int) (int, error) {
+	if value == "" || len(value) > 2 || !isDigits(value) {
+		return 0, fmt.Errorf("invalid %s %q", name, value)
+	}
+	n, _ := strconv.Atoi(value)
+	if n > max {
+		return 0, fmt.Errorf("%s %d is out of range [0,%d]", name, n, max)
+	}
+	return n, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTimeOfDay parses the well-known google.type.TimeOfDay by
+// [ParseTimeOfDay].
+func parseTimeOfDay(md pref.MessageDescriptor, value string) (v pref.Value, present bool, err error) {
+	d, err := ParseTimeOfDay(value)
+	if err != nil {
+		return DefaultTimeOfDayValue, false, xerrors.E2045(value, err)
+	}
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("hours"), pref.ValueOfInt32(int32(d/time.Hour)))
+	msg.Set(md.Fields().ByName("minutes"), pref.ValueOfInt32(int32(d%time.Hour/time.Minute)))
+	msg.Set(md.Fields().ByName("seconds"), pref.ValueOfInt32(int32(d%time.Minute/time.Second)))
+	msg.Set(md.Fields().ByName("nanos"), pref.ValueOfInt32(int32(d%time.Second)))
+	return pref.ValueOfMessage(msg.ProtoReflect()), true, nil
+}
+
+// TimeOfDayOf converts the well-known google.type.TimeOfDay message to the
+// duration since midnight.
+func TimeOfDayOf(msg pref.Message) time.Duration {
+	fields := msg.Descriptor().Fields()
+	hours := msg.Get(fields.ByName("hours")).Int()
+	minutes := msg.Get(fields.ByName("minutes")).Int()
+	seconds := msg.Get(fields.ByName("seconds")).Int()
+	nanos := msg.Get(fields.ByName("nanos")).Int()
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second + time.Duration(nanos)
+}
+
+// FormatTimeOfDay formats the well-known google.type.TimeOfDay message to the
+// form "HH:mm:ss", with fraction of seconds if not zero, e.g.: "10:30:00.5".
+func FormatTimeOfDay(msg pref.Message) string {
+	d := TimeOfDayOf(msg)
+	text := fmt.Sprintf("%02d:%02d:%02d", d/time.Hour, d%time.Hour/time.Minute, d%time.Minute/time.Second)
+	if nanos := d % time.Second; nanos != 0 {
+		text += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+	}
+	return text
+}
@@ -0,0 +1,102 @@
+package xproto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto/protoc"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func findTestMessage(t *testing.T, fullName pref.FullName) pref.MessageDescriptor {
+	t.Helper()
+	files, err := protoc.NewFiles([]string{"testdata"}, []string{"testdata/date.proto"})
+	require.NoError(t, err)
+	desc, err := files.FindDescriptorByName(fullName)
+	require.NoError(t, err)
+	return desc.(pref.MessageDescriptor)
+}
+
+func Test_parseDate(t *testing.T) {
+	md := findTestMessage(t, "google.type.Date")
+	tests := []struct {
+		name  string
+		value string
+		want  string
+		err   error
+	}{
+		{name: "dash", value: "2020-01-31", want: "2020-01-31"},
+		{name: "compact", value: "20221010", want: "2022-10-10"},
+		{name: "leap-day", value: "2024-02-29", want: "2024-02-29"},
+		{name: "invalid-day", value: "2023-02-29", err: xerrors.ErrE2044},
+		{name: "invalid-month", value: "2023-13-01", err: xerrors.ErrE2044},
+		{name: "with-time", value: "2023-01-01 10:00:00", err: xerrors.ErrE2044},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, present, err := parseDate(md, tt.value)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, present)
+			assert.Equal(t, tt.want, FormatDate(v.Message()))
+		})
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	md := findTestMessage(t, "google.type.Date")
+	v, _, err := parseDate(md, "2020-01-01")
+	require.NoError(t, err)
+	// date is not affected by any time zone
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), DateOf(v.Message()))
+}
+
+func Test_parseTimeOfDay(t *testing.T) {
+	md := findTestMessage(t, "google.type.TimeOfDay")
+	tests := []struct {
+		name  string
+		value string
+		want  string
+		err   error
+	}{
+		{name: "HH:mm", value: "10:25", want: "10:25:00"},
+		{name: "HH:mm:ss", value: "10:25:30", want: "10:25:30"},
+		{name: "HH:mm:ss.fff", value: "10:25:30.5", want: "10:25:30.5"},
+		{name: "HHmm", value: "1125", want: "11:25:00"},
+		{name: "HHmmss", value: "112530", want: "11:25:30"},
+		{name: "midnight", value: "00:00", want: "00:00:00"},
+		{name: "closing-time", value: "24:00:00", want: "24:00:00"},
+		{name: "after-closing-time", value: "24:00:01", err: xerrors.ErrE2045},
+		{name: "hours-out-of-range", value: "25:00", err: xerrors.ErrE2045},
+		{name: "minutes-out-of-range", value: "10:60", err: xerrors.ErrE2045},
+		{name: "seconds-out-of-range", value: "10:00:60", err: xerrors.ErrE2045},
+		{name: "negative", value: "-1:00", err: xerrors.ErrE2045},
+		{name: "duration-form", value: "10h25m", err: xerrors.ErrE2045},
+		{name: "too-many-parts", value: "10:25:30:00", err: xerrors.ErrE2045},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, present, err := parseTimeOfDay(md, tt.value)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, present)
+			assert.Equal(t, tt.want, FormatTimeOfDay(v.Message()))
+		})
+	}
+}
+
+func TestTimeOfDayOf(t *testing.T) {
+	md := findTestMessage(t, "google.type.TimeOfDay")
+	v, _, err := parseTimeOfDay(md, "10:25:30.5")
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Hour+25*time.Minute+30*time.Second+500*time.Millisecond, TimeOfDayOf(v.Message()))
+}
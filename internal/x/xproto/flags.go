@@ -0,0 +1,105 @@
+package xproto
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// flagsEnums caches the enum descriptor of bit flags field:
+// flagsEnumKey -> pref.EnumDescriptor
+var flagsEnums sync.Map
+
+type flagsEnumKey struct {
+	fd   pref.FieldDescriptor
+	enum pref.FullName
+}
+
+// parseFlags parses bit flags of the enum type (specified by prop flags) into
+// a uint64 bitmask, with bit N set for the enum value numbered N. Flags are
+// separated by "|" or ",", and each flag can be the enum value number, name,
+// or alias, e.g.: "IOS|ANDROID" or "iOS,Android".
+func parseFlags(fd pref.FieldDescriptor, value string, enumFullName string) (v pref.Value, present bool, err error) {
+	if value == "" {
+		return DefaultUint64Value, false, nil
+	}
+	ed, err := flagsEnumOf(fd, pref.FullName(enumFullName))
+	if err != nil {
+		return DefaultUint64Value, false, err
+	}
+	flags := strings.FieldsFunc(value, func(r rune) bool {
+		return r == '|' || r == ','
+	})
+	var bitmask uint64
+	for _, flag := range flags {
+		flag = strings.TrimSpace(flag)
+		if flag == "" {
+			continue
+		}
+		ev, _, err := parseEnumValueOf(ed, flag)
+		if err != nil {
+			return DefaultUint64Value, false, err
+		}
+		num := ev.Enum()
+		if num < 0 || num > 63 {
+			return DefaultUint64Value, false, xerrors.E2043(flag, ed.FullName(), int32(num))
+		}
+		bit := uint64(1) << uint(num)
+		if bitmask&bit != 0 {
+			return DefaultUint64Value, false, xerrors.E2042(value, flag, ed.FullName())
+		}
+		bitmask |= bit
+	}
+	return pref.ValueOfUint64(bitmask), true, nil
+}
+
+// flagsEnumOf returns the enum descriptor of bit flags field, which should be
+// defined in the field's parent file or its imports.
+func flagsEnumOf(fd pref.FieldDescriptor, enumFullName pref.FullName) (pref.EnumDescriptor, error) {
+	key := flagsEnumKey{fd: fd, enum: enumFullName}
+	if ed, ok := flagsEnums.Load(key); ok {
+		return ed.(pref.EnumDescriptor), nil
+	}
+	ed := findEnum(fd.ParentFile(), enumFullName, map[string]bool{})
+	if ed == nil {
+		return nil, xerrors.Newf("flags enum %s not found in file %s or its imports", enumFullName, fd.ParentFile().Path())
+	}
+	flagsEnums.Store(key, ed)
+	return ed, nil
+}
+
+// findEnum finds the enum descriptor by full name in the file and its imports
+// recursively.
+func findEnum(file pref.FileDescriptor, fullName pref.FullName, visited map[string]bool) pref.EnumDescriptor {
+	if file == nil || visited[file.Path()] {
+		return nil
+	}
+	visited[file.Path()] = true
+	if ed := findEnumIn(file.Enums(), file.Messages(), fullName); ed != nil {
+		return ed
+	}
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if ed := findEnum(imports.Get(i).FileDescriptor, fullName, visited); ed != nil {
+			return ed
+		}
+	}
+	return nil
+}
+
+func findEnumIn(enums pref.EnumDescriptors, messages pref.MessageDescriptors, fullName pref.FullName) pref.EnumDescriptor {
+	for i := 0; i < enums.Len(); i++ {
+		if ed := enums.Get(i); ed.FullName() == fullName {
+			return ed
+		}
+	}
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if ed := findEnumIn(md.Enums(), md.Messages(), fullName); ed != nil {
+			return ed
+		}
+	}
+	return nil
+}
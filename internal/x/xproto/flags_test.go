@@ -0,0 +1,92 @@
+package xproto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
+)
+
+func Test_parseFlags(t *testing.T) {
+	fd := (&unittestpb.YamlScalarConf{}).ProtoReflect().Descriptor().Fields().ByName("value")
+	tests := []struct {
+		name        string
+		value       string
+		enum        string
+		want        uint64
+		wantPresent bool
+		err         error
+	}{
+		{
+			name:  "empty",
+			value: "",
+			enum:  "unittest.FruitType",
+		},
+		{
+			name:        "names",
+			value:       "FRUIT_TYPE_APPLE|FRUIT_TYPE_BANANA",
+			enum:        "unittest.FruitType",
+			want:        1<<1 | 1<<4,
+			wantPresent: true,
+		},
+		{
+			name:        "aliases-and-numbers",
+			value:       "Apple, 3",
+			enum:        "unittest.FruitType",
+			want:        1<<1 | 1<<3,
+			wantPresent: true,
+		},
+		{
+			name:        "zero-value",
+			value:       "Unknown",
+			enum:        "unittest.FruitType",
+			want:        1,
+			wantPresent: true,
+		},
+		{
+			name:        "nested-enum",
+			value:       "PVP|PVE",
+			enum:        "unittest.Target.Type",
+			want:        1<<1 | 1<<2,
+			wantPresent: true,
+		},
+		{
+			name:  "duplicate-flag",
+			value: "Apple|FRUIT_TYPE_APPLE",
+			enum:  "unittest.FruitType",
+			err:   xerrors.ErrE2042,
+		},
+		{
+			name:  "flag-out-of-range",
+			value: "Android|Web",
+			enum:  "unittest.PlatformType",
+			err:   xerrors.ErrE2043,
+		},
+		{
+			name:  "undefined-flag",
+			value: "Apple|Pear",
+			enum:  "unittest.FruitType",
+			err:   xerrors.ErrE2006,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, present, err := parseFlags(fd, tt.value, tt.enum)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPresent, present)
+			assert.Equal(t, tt.want, got.Uint())
+		})
+	}
+}
+
+func Test_parseFlags_EnumNotFound(t *testing.T) {
+	fd := (&unittestpb.YamlScalarConf{}).ProtoReflect().Descriptor().Fields().ByName("value")
+	_, _, err := parseFlags(fd, "Apple", "unittest.NotExistedType")
+	require.Error(t, err)
+}
@@ -0,0 +1,165 @@
+package xproto
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tableauio/tableau/internal/types"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// parseInterval parses a well-known interval from following forms:
+//   - bracketed: [1,10], [1,10), (1,10], (1,10)
+//   - tilde separated: 1~10, which is the same as [1,10]
+//
+// The bounds are parsed by the interval's type: int64 (tableau.Interval),
+// double (tableau.DoubleInterval), or timestamp (tableau.TimestampInterval)
+// in the given location.
+func parseInterval(md pref.MessageDescriptor, value string, locationName string) (v pref.Value, present bool, err error) {
+	lowerStr, upperStr, lowerExclusive, upperExclusive, err := splitInterval(value)
+	if err != nil {
+		return DefaultIntervalValue, false, xerrors.E2038(value, err)
+	}
+	lowerFd, upperFd := md.Fields().ByName("lower"), md.Fields().ByName("upper")
+	lower, err := parseIntervalBound(lowerFd, lowerStr, locationName)
+	if err != nil {
+		return DefaultIntervalValue, false, xerrors.E2038(value, err)
+	}
+	upper, err := parseIntervalBound(upperFd, upperStr, locationName)
+	if err != nil {
+		return DefaultIntervalValue, false, xerrors.E2038(value, err)
+	}
+	switch c := compareIntervalBound(lower, upper); {
+	case c > 0:
+		return DefaultIntervalValue, false, xerrors.E2038(value, fmt.Errorf("lower bound %s is greater than upper bound %s", lowerStr, upperStr))
+	case c == 0 && (lowerExclusive || upperExclusive):
+		return DefaultIntervalValue, false, xerrors.E2038(value, fmt.Errorf("interval is empty"))
+	}
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(lowerFd, lower)
+	msg.Set(upperFd, upper)
+	msg.Set(md.Fields().ByName("lower_exclusive"), pref.ValueOfBool(lowerExclusive))
+	msg.Set(md.Fields().ByName("upper_exclusive"), pref.ValueOfBool(upperExclusive))
+	return pref.ValueOfMessage(msg.ProtoReflect()), true, nil
+}
+
+// splitInterval splits the interval text into lower and upper bounds, along
+// with whether each bound is exclusive.
+func splitInterval(value string) (lower, upper string, lowerExclusive, upperExclusive bool, err error) {
+	text := strings.TrimSpace(value)
+	sep := "~"
+	if strings.HasPrefix(text, "[") || strings.HasPrefix(text, "(") {
+		if !strings.HasSuffix(text, "]") && !strings.HasSuffix(text, ")") {
+			return "", "", false, false, fmt.Errorf("missing closing bracket")
+		}
+		lowerExclusive = text[0] == '('
+		upperExclusive = text[len(text)-1] == ')'
+		text = text[1 : len(text)-1]
+		sep = ","
+	}
+	lower, upper, found := strings.Cut(text, sep)
+	if !found {
+		return "", "", false, false, fmt.Errorf("missing separator %q between bounds", sep)
+	}
+	return strings.TrimSpace(lower), strings.TrimSpace(upper), lowerExclusive, upperExclusive, nil
+}
+
+func parseIntervalBound(fd pref.FieldDescriptor, value string, locationName string) (pref.Value, error) {
+	switch fd.Kind() {
+	case pref.Int64Kind:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return pref.Value{}, fmt.Errorf("bound %q should be an integer", value)
+		}
+		return pref.ValueOfInt64(n), nil
+	case pref.DoubleKind:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return pref.Value{}, fmt.Errorf("bound %q should be a number", value)
+		}
+		return pref.ValueOfFloat64(f), nil
+	case pref.MessageKind:
+		// google.protobuf.Timestamp
+		t, err := parseTimeWithLocation(locationName, value)
+		if err != nil {
+			return pref.Value{}, fmt.Errorf("bound %q should be a datetime: %w", value, err)
+		}
+		ts := timestamppb.New(t)
+		if err := ts.CheckValid(); err != nil {
+			return pref.Value{}, err
+		}
+		md := fd.Message()
+		msg := dynamicpb.NewMessage(md)
+		msg.Set(md.Fields().ByName("seconds"), pref.ValueOfInt64(ts.Seconds))
+		msg.Set(md.Fields().ByName("nanos"), pref.ValueOfInt32(ts.Nanos))
+		return pref.ValueOfMessage(msg.ProtoReflect()), nil
+	default:
+		return pref.Value{}, fmt.Errorf("unsupported interval bound kind: %s", fd.Kind())
+	}
+}
+
+// compareIntervalBound compares two bounds of the same interval type, and
+// returns -1, 0, or +1.
+func compareIntervalBound(x, y pref.Value) int {
+	switch xv := x.Interface().(type) {
+	case int64:
+		return cmp.Compare(xv, y.Int())
+	case float64:
+		return cmp.Compare(xv, y.Float())
+	case pref.Message:
+		// google.protobuf.Timestamp
+		xt, yt := timestampOf(xv), timestampOf(y.Message())
+		return xt.Compare(yt)
+	default:
+		return 0
+	}
+}
+
+func timestampOf(msg pref.Message) time.Time {
+	fields := msg.Descriptor().Fields()
+	return time.Unix(msg.Get(fields.ByName("seconds")).Int(), msg.Get(fields.ByName("nanos")).Int())
+}
+
+// IntervalsOverlap reports whether the two well-known intervals of the same
+// type overlap, e.g.: [1,10] and [10,20) overlap, but [1,10) and [10,20)
+// don't.
+func IntervalsOverlap(x, y pref.Message) bool {
+	return lowerBeforeUpper(x, y) && lowerBeforeUpper(y, x)
+}
+
+// lowerBeforeUpper reports whether x's lower bound is before y's upper bound.
+func lowerBeforeUpper(x, y pref.Message) bool {
+	xFields, yFields := x.Descriptor().Fields(), y.Descriptor().Fields()
+	c := compareIntervalBound(x.Get(xFields.ByName("lower")), y.Get(yFields.ByName("upper")))
+	if c != 0 {
+		return c < 0
+	}
+	return !x.Get(xFields.ByName("lower_exclusive")).Bool() && !y.Get(yFields.ByName("upper_exclusive")).Bool()
+}
+
+// FormatInterval formats a well-known interval message to the bracketed
+// form, e.g.: "[1,10)". Timestamp bounds are formatted in RFC 3339 (UTC).
+func FormatInterval(msg pref.Message) string {
+	fields := msg.Descriptor().Fields()
+	formatBound := func(name pref.Name) string {
+		v := msg.Get(fields.ByName(name))
+		if msg.Descriptor().FullName() == types.WellKnownMessageTimestampInterval {
+			return timestampOf(v.Message()).UTC().Format(time.RFC3339Nano)
+		}
+		return fmt.Sprint(v.Interface())
+	}
+	left, right := "[", "]"
+	if msg.Get(fields.ByName("lower_exclusive")).Bool() {
+		left = "("
+	}
+	if msg.Get(fields.ByName("upper_exclusive")).Bool() {
+		right = ")"
+	}
+	return left + formatBound("lower") + "," + formatBound("upper") + right
+}
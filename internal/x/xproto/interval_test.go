@@ -0,0 +1,139 @@
+package xproto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_parseInterval(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		wantV proto.Message
+		err   error
+	}{
+		{
+			name:  "int-tilde",
+			value: "1~10",
+			wantV: &tableaupb.Interval{Lower: 1, Upper: 10},
+		},
+		{
+			name:  "int-tilde-negative",
+			value: "-10 ~ -1",
+			wantV: &tableaupb.Interval{Lower: -10, Upper: -1},
+		},
+		{
+			name:  "int-half-open",
+			value: "[1,10)",
+			wantV: &tableaupb.Interval{Lower: 1, Upper: 10, UpperExclusive: true},
+		},
+		{
+			name:  "int-open",
+			value: "( 1, 10 )",
+			wantV: &tableaupb.Interval{Lower: 1, Upper: 10, LowerExclusive: true, UpperExclusive: true},
+		},
+		{
+			name:  "int-single-point",
+			value: "[5,5]",
+			wantV: &tableaupb.Interval{Lower: 5, Upper: 5},
+		},
+		{
+			name:  "double",
+			value: "(0.5,1.5]",
+			wantV: &tableaupb.DoubleInterval{Lower: 0.5, Upper: 1.5, LowerExclusive: true},
+		},
+		{
+			name:  "timestamp",
+			value: "[2024-01-01 00:00:00,2024-02-01 00:00:00)",
+			wantV: &tableaupb.TimestampInterval{
+				Lower:          timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Upper:          timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+				UpperExclusive: true,
+			},
+		},
+		{
+			name:  "lower-greater-than-upper",
+			value: "10~1",
+			wantV: &tableaupb.Interval{},
+			err:   xerrors.ErrE2038,
+		},
+		{
+			name:  "empty",
+			value: "[5,5)",
+			wantV: &tableaupb.Interval{},
+			err:   xerrors.ErrE2038,
+		},
+		{
+			name:  "missing-closing-bracket",
+			value: "[1,10",
+			wantV: &tableaupb.Interval{},
+			err:   xerrors.ErrE2038,
+		},
+		{
+			name:  "missing-separator",
+			value: "[1~10]",
+			wantV: &tableaupb.Interval{},
+			err:   xerrors.ErrE2038,
+		},
+		{
+			name:  "invalid-int",
+			value: "1.5~10",
+			wantV: &tableaupb.Interval{},
+			err:   xerrors.ErrE2038,
+		},
+		{
+			name:  "invalid-timestamp",
+			value: "2024-01-01~2024-13-01",
+			wantV: &tableaupb.TimestampInterval{},
+			err:   xerrors.ErrE2038,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := tt.wantV.ProtoReflect().Descriptor()
+			gotV, present, err := parseInterval(md, tt.value, "UTC")
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, present)
+			assert.True(t, gotV.Equal(pref.ValueOfMessage(tt.wantV.ProtoReflect())), "got %v, want %v", gotV, tt.wantV)
+		})
+	}
+}
+
+func TestIntervalsOverlap(t *testing.T) {
+	tests := []struct {
+		x, y *tableaupb.Interval
+		want bool
+	}{
+		{x: &tableaupb.Interval{Lower: 1, Upper: 10}, y: &tableaupb.Interval{Lower: 10, Upper: 20}, want: true},
+		{x: &tableaupb.Interval{Lower: 1, Upper: 10, UpperExclusive: true}, y: &tableaupb.Interval{Lower: 10, Upper: 20}, want: false},
+		{x: &tableaupb.Interval{Lower: 1, Upper: 10}, y: &tableaupb.Interval{Lower: 10, Upper: 20, LowerExclusive: true}, want: false},
+		{x: &tableaupb.Interval{Lower: 1, Upper: 100}, y: &tableaupb.Interval{Lower: 10, Upper: 20}, want: true},
+		{x: &tableaupb.Interval{Lower: 30, Upper: 40}, y: &tableaupb.Interval{Lower: 10, Upper: 20}, want: false},
+	}
+	for _, tt := range tests {
+		x, y := tt.x.ProtoReflect(), tt.y.ProtoReflect()
+		assert.Equal(t, tt.want, IntervalsOverlap(x, y), "%s and %s", FormatInterval(x), FormatInterval(y))
+		assert.Equal(t, tt.want, IntervalsOverlap(y, x), "%s and %s", FormatInterval(y), FormatInterval(x))
+	}
+}
+
+func TestFormatInterval(t *testing.T) {
+	assert.Equal(t, "[1,10)", FormatInterval((&tableaupb.Interval{Lower: 1, Upper: 10, UpperExclusive: true}).ProtoReflect()))
+	assert.Equal(t, "(0.5,1.5]", FormatInterval((&tableaupb.DoubleInterval{Lower: 0.5, Upper: 1.5, LowerExclusive: true}).ProtoReflect()))
+	assert.Equal(t, "[2024-01-01T00:00:00Z,2024-02-01T00:00:00Z]", FormatInterval((&tableaupb.TimestampInterval{
+		Lower: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Upper: timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)),
+	}).ProtoReflect()))
+}
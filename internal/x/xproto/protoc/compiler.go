@@ -2,6 +2,7 @@ package protoc
 
 import (
 	"context"
+	"embed"
 	"io"
 	"io/fs"
 	"os"
@@ -80,6 +81,7 @@ func parseProtos(protoPaths []string, protoFilesMap map[string]string) (*protore
 	compiler := protocompile.Compiler{
 		Resolver: protocompile.CompositeResolver{
 			protocompile.ResolverFunc(resolveGlobalFiles),
+			protocompile.ResolverFunc(resolveGoogleAPIsFiles),
 			&protocompile.SourceResolver{
 				ImportPaths: protoPaths,
 				Accessor: func(path string) (io.ReadCloser, error) {
@@ -90,6 +92,8 @@ func parseProtos(protoPaths []string, protoFilesMap map[string]string) (*protore
 				},
 			},
 		},
+		// NOTE: source info is kept for reading comments (e.g.: field notes).
+		SourceInfoMode: protocompile.SourceInfoStandard,
 		MaxParallelism: 1,
 	}
 	results, err := compiler.Compile(context.Background(), protoFiles...)
@@ -107,6 +111,21 @@ func resolveGlobalFiles(path string) (protocompile.SearchResult, error) {
 	return protocompile.SearchResult{Desc: fd}, nil
 }
 
+// googleAPIsFiles embeds the well-known googleapis proto files (e.g.:
+// "google/type/date.proto"), which are not registered in global files unless
+// package "google.golang.org/genproto/googleapis/type/..." is linked.
+//
+//go:embed googleapis
+var googleAPIsFiles embed.FS
+
+func resolveGoogleAPIsFiles(path string) (protocompile.SearchResult, error) {
+	f, err := googleAPIsFiles.Open("googleapis/" + path)
+	if err != nil {
+		return protocompile.SearchResult{}, err
+	}
+	return protocompile.SearchResult{Source: f}, nil
+}
+
 // toFDS converts linker.Files to *descriptorpb.FileDescriptorSet.
 func toFDS(results linker.Files) *descriptorpb.FileDescriptorSet {
 	fdpMap := make(fileDescriptorProtoMap)
@@ -96,3 +96,14 @@ func Test_parseProtos(t *testing.T) {
 		})
 	}
 }
+
+func Test_resolveGoogleAPIsFiles(t *testing.T) {
+	for _, path := range []string{"google/type/date.proto", "google/type/timeofday.proto"} {
+		if _, err := resolveGoogleAPIsFiles(path); err != nil {
+			t.Errorf("resolveGoogleAPIsFiles(%q) error = %v", path, err)
+		}
+	}
+	if _, err := resolveGoogleAPIsFiles("google/type/unknown.proto"); err == nil {
+		t.Errorf("resolveGoogleAPIsFiles() should return error for unknown file")
+	}
+}
@@ -0,0 +1,57 @@
+package xproto
+
+import (
+	"time"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// parseSchedule parses the well-known tableau.Schedule from a cron expression,
+// which is evaluated in the given location. If prop schedule specifies
+// occurrences, the next occurrences after prop's since are precomputed.
+func parseSchedule(md pref.MessageDescriptor, value string, locationName string, prop *tableaupb.FieldProp_Schedule) (v pref.Value, present bool, err error) {
+	s, err := tableaupb.ParseCron(value)
+	if err != nil {
+		return DefaultScheduleValue, false, xerrors.E2046(value, err)
+	}
+	loc, err := time.LoadLocation(locationName)
+	if err != nil {
+		return DefaultScheduleValue, false, xerrors.WrapKV(err)
+	}
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("cron"), pref.ValueOfString(s.String()))
+	msg.Set(md.Fields().ByName("timezone"), pref.ValueOfString(locationName))
+	if n := prop.GetOccurrences(); n > 0 {
+		if prop.GetSince() == "" {
+			return DefaultScheduleValue, false, xerrors.Newf("prop schedule: since is required if occurrences is greater than 0")
+		}
+		since, err := parseTimeWithLocation(locationName, prop.GetSince())
+		if err != nil {
+			return DefaultScheduleValue, false, xerrors.Wrapf(err, "prop schedule: invalid since %q", prop.GetSince())
+		}
+		occurrencesFd := md.Fields().ByName("occurrences")
+		occurrences := msg.Mutable(occurrencesFd).List()
+		t := since.In(loc)
+		for i := int32(0); i < n; i++ {
+			if t = s.Next(t); t.IsZero() {
+				break
+			}
+			ts := timestamppb.New(t)
+			tsMsg := dynamicpb.NewMessage(occurrencesFd.Message())
+			tsMsg.Set(tsMsg.Descriptor().Fields().ByName("seconds"), pref.ValueOfInt64(ts.Seconds))
+			tsMsg.Set(tsMsg.Descriptor().Fields().ByName("nanos"), pref.ValueOfInt32(ts.Nanos))
+			occurrences.Append(pref.ValueOfMessage(tsMsg.ProtoReflect()))
+		}
+	}
+	return pref.ValueOfMessage(msg.ProtoReflect()), true, nil
+}
+
+// FormatSchedule formats the well-known tableau.Schedule message to its
+// normalized cron expression, e.g.: "0 0 5 * * MON".
+func FormatSchedule(msg pref.Message) string {
+	return msg.Get(msg.Descriptor().Fields().ByName("cron")).String()
+}
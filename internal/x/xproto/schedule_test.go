@@ -0,0 +1,102 @@
+package xproto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+)
+
+func Test_parseSchedule(t *testing.T) {
+	md := (&tableaupb.Schedule{}).ProtoReflect().Descriptor()
+	tests := []struct {
+		name            string
+		value           string
+		locationName    string
+		prop            *tableaupb.FieldProp_Schedule
+		wantCron        string
+		wantOccurrences []string // in RFC 3339 of location
+		wantErr         bool
+		err             error
+	}{
+		{
+			name:         "cron",
+			value:        "0 5 * * MON",
+			locationName: "Asia/Shanghai",
+			wantCron:     "0 0 5 * * MON",
+		},
+		{
+			name:         "occurrences",
+			value:        "0 5 * * MON",
+			locationName: "Asia/Shanghai",
+			prop:         &tableaupb.FieldProp_Schedule{Occurrences: 3, Since: "2024-01-01 05:00:00"},
+			wantCron:     "0 0 5 * * MON",
+			wantOccurrences: []string{
+				"2024-01-08T05:00:00+08:00",
+				"2024-01-15T05:00:00+08:00",
+				"2024-01-22T05:00:00+08:00",
+			},
+		},
+		{
+			name:         "invalid-cron",
+			value:        "0 25 * * *",
+			locationName: "UTC",
+			err:          xerrors.ErrE2046,
+		},
+		{
+			name:         "never-occurs",
+			value:        "0 0 31 4 *",
+			locationName: "UTC",
+			prop:         &tableaupb.FieldProp_Schedule{Occurrences: 1, Since: "2024-01-01 00:00:00"},
+			err:          xerrors.ErrE2046,
+		},
+		{
+			name:         "missing-since",
+			value:        "@daily",
+			locationName: "UTC",
+			prop:         &tableaupb.FieldProp_Schedule{Occurrences: 3},
+			wantErr:      true,
+		},
+		{
+			name:         "invalid-since",
+			value:        "@daily",
+			locationName: "UTC",
+			prop:         &tableaupb.FieldProp_Schedule{Occurrences: 3, Since: "yesterday"},
+			wantErr:      true,
+		},
+		{
+			name:         "invalid-location",
+			value:        "@daily",
+			locationName: "Mars/Olympus",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, present, err := parseSchedule(md, tt.value, tt.locationName, tt.prop)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, present)
+			assert.Equal(t, tt.wantCron, FormatSchedule(v.Message()))
+			assert.Equal(t, tt.locationName, v.Message().Get(md.Fields().ByName("timezone")).String())
+			loc, err := time.LoadLocation(tt.locationName)
+			require.NoError(t, err)
+			var occurrences []string
+			list := v.Message().Get(md.Fields().ByName("occurrences")).List()
+			for i := 0; i < list.Len(); i++ {
+				occurrences = append(occurrences, timestampOf(list.Get(i).Message()).In(loc).Format(time.RFC3339))
+			}
+			assert.Equal(t, tt.wantOccurrences, occurrences)
+		})
+	}
+}
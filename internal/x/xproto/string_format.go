@@ -0,0 +1,154 @@
+package xproto
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	pref "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// defaultURLSchemes are the allowed URL schemes if prop schemes is not set.
+var defaultURLSchemes = []string{"http", "https"}
+
+// defaultURLPorts are the default ports of URL schemes, which are removed
+// when canonicalized.
+var defaultURLPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+}
+
+// parseStringFormat validates the string value by prop string_format, and
+// canonicalizes it if prop canonical is set. Only UUID is trimmed, as spaces
+// are significant in regular expressions and invalid in URLs.
+func parseStringFormat(fd pref.FieldDescriptor, value string, fprop *tableaupb.FieldProp) (v pref.Value, present bool, err error) {
+	if fprop.GetStringFormat() == tableaupb.StringFormat_STRING_FORMAT_UUID {
+		value = strings.TrimSpace(value)
+	}
+	if value == "" {
+		return DefaultStringValue, fd.HasPresence(), nil
+	}
+	var canonical string
+	switch fprop.GetStringFormat() {
+	case tableaupb.StringFormat_STRING_FORMAT_UUID:
+		if canonical, err = ParseUUID(value); err != nil {
+			return DefaultStringValue, false, xerrors.E2049(value, err)
+		}
+	case tableaupb.StringFormat_STRING_FORMAT_REGEXP:
+		if canonical, err = ParseRegexp(value); err != nil {
+			return DefaultStringValue, false, xerrors.E2050(value, err)
+		}
+	case tableaupb.StringFormat_STRING_FORMAT_URL:
+		var schemes []string
+		if fprop.GetSchemes() != "" {
+			schemes = strings.Split(fprop.GetSchemes(), ",")
+		}
+		if canonical, err = ParseURL(value, schemes); err != nil {
+			return DefaultStringValue, false, xerrors.E2051(value, err)
+		}
+	default:
+		return DefaultStringValue, false, xerrors.Newf("unknown string format: %s", fprop.GetStringFormat())
+	}
+	if fprop.GetCanonical() {
+		value = canonical
+	}
+	return pref.ValueOfString(value), true, nil
+}
+
+// ParseUUID parses a UUID of 32 hexadecimal digits, which can be hyphenated
+// as 8-4-4-4-12, wrapped in braces, or prefixed with "urn:uuid:", e.g.:
+//   - 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+//   - 6BA7B8109DAD11D180B400C04FD430C8
+//   - {6ba7b810-9dad-11d1-80b4-00c04fd430c8}
+//   - urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8
+//
+// It returns the canonical form: lowercase hyphenated 8-4-4-4-12.
+func ParseUUID(value string) (string, error) {
+	text := value
+	if len(text) >= 9 && strings.EqualFold(text[:9], "urn:uuid:") {
+		text = text[9:]
+	} else if strings.HasPrefix(text, "{") && strings.HasSuffix(text, "}") {
+		text = text[1 : len(text)-1]
+	}
+	switch len(text) {
+	case 36:
+		for _, i := range []int{8, 13, 18, 23} {
+			if text[i] != '-' {
+				return "", fmt.Errorf("hyphen expected at position %d", i+1)
+			}
+		}
+		text = strings.ReplaceAll(text, "-", "")
+	case 32:
+	default:
+		return "", fmt.Errorf("should be 32 hexadecimal digits")
+	}
+	b, err := hex.DecodeString(text)
+	if err != nil {
+		return "", fmt.Errorf("should be 32 hexadecimal digits")
+	}
+	h := hex.EncodeToString(b)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32], nil
+}
+
+// ParseRegexp compiles the regular expression in RE2 syntax, and returns the
+// source pattern as the canonical form, as a rewritten pattern may behave
+// differently in the regexp engines of other languages.
+func ParseRegexp(value string) (string, error) {
+	re, err := regexp.Compile(value)
+	if err != nil {
+		return "", err
+	}
+	return re.String(), nil
+}
+
+// ParseURL parses an absolute URL with host, whose scheme should be one of
+// schemes (case-insensitive), or "http" and "https" if schemes is empty.
+//
+// It returns the canonical form: lowercase scheme and host, and default port
+// removed, e.g.: "HTTPS://Example.com:443/a" -> "https://example.com/a".
+func ParseURL(value string, schemes []string) (string, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme == "" {
+		return "", fmt.Errorf("missing scheme")
+	}
+	if len(schemes) == 0 {
+		schemes = defaultURLSchemes
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if !slices.ContainsFunc(schemes, func(scheme string) bool {
+		return strings.EqualFold(strings.TrimSpace(scheme), u.Scheme)
+	}) {
+		return "", fmt.Errorf("scheme %q is not allowed in %v", u.Scheme, schemes)
+	}
+	if u.Opaque != "" {
+		// e.g.: mailto:user@example.com
+		return u.String(), nil
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("missing host")
+	}
+	host, port := strings.ToLower(u.Hostname()), u.Port()
+	if port == defaultURLPorts[u.Scheme] {
+		port = ""
+	}
+	if port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		// IPv6 literal
+		u.Host = "[" + host + "]"
+	} else {
+		u.Host = host
+	}
+	return u.String(), nil
+}
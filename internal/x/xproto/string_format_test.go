@@ -0,0 +1,181 @@
+package xproto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/proto/tableaupb"
+	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
+)
+
+func TestParseUUID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "hyphenated", value: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", want: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{name: "uppercase-compact", value: "6BA7B8109DAD11D180B400C04FD430C8", want: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{name: "braces", value: "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}", want: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{name: "urn", value: "URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8", want: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{name: "nil-uuid", value: "00000000-0000-0000-0000-000000000000", want: "00000000-0000-0000-0000-000000000000"},
+		{name: "too-short", value: "6ba7b810-9dad-11d1-80b4", wantErr: true},
+		{name: "misplaced-hyphen", value: "6ba7b8109-dad-11d1-80b4-00c04fd430c8", wantErr: true},
+		{name: "non-hex", value: "6ba7b810-9dad-11d1-80b4-00c04fd430cg", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseUUID(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseRegexp(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "simple", value: "foo|bar", want: "foo|bar"},
+		{name: "source-pattern", value: "a{1,}b{0,1}", want: "a{1,}b{0,1}"},
+		{name: "spaces", value: " a b ", want: " a b "},
+		{name: "unclosed", value: "(abc", wantErr: true},
+		{name: "backreference-not-supported", value: `(a)\1`, wantErr: true},
+		{name: "lookahead-not-supported", value: `a(?=b)`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRegexp(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		schemes []string
+		want    string
+		wantErr bool
+	}{
+		{name: "https", value: "https://example.com/a?b=c#d", want: "https://example.com/a?b=c#d"},
+		{name: "canonical", value: "HTTPS://Example.COM:443/a", want: "https://example.com/a"},
+		{name: "non-default-port", value: "http://Example.com:8080", want: "http://example.com:8080"},
+		{name: "ipv6", value: "http://[::1]:80/", want: "http://[::1]/"},
+		{name: "allowed-scheme", value: "wss://chat.example.com:443/ws", schemes: []string{"wss"}, want: "wss://chat.example.com/ws"},
+		{name: "opaque", value: "mailto:admin@example.com", schemes: []string{"mailto"}, want: "mailto:admin@example.com"},
+		{name: "relative", value: "/a/b", wantErr: true},
+		{name: "not-allowed-scheme", value: "ftp://example.com", wantErr: true},
+		{name: "missing-host", value: "https:///a", wantErr: true},
+		{name: "invalid", value: "https://exa mple.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseURL(tt.value, tt.schemes)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseStringFormat(t *testing.T) {
+	fd := (&unittestpb.ValidateConf{}).ProtoReflect().Descriptor().Fields().ByName("name")
+	tests := []struct {
+		name        string
+		value       string
+		prop        *tableaupb.FieldProp
+		want        string
+		wantPresent bool
+		err         error
+	}{
+		{
+			name:  "empty",
+			value: "  ",
+			prop:  &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_UUID},
+		},
+		{
+			name:        "uuid-as-is",
+			value:       " 6BA7B8109DAD11D180B400C04FD430C8 ",
+			prop:        &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_UUID},
+			want:        "6BA7B8109DAD11D180B400C04FD430C8",
+			wantPresent: true,
+		},
+		{
+			name:        "uuid-canonical",
+			value:       "6BA7B8109DAD11D180B400C04FD430C8",
+			prop:        &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_UUID, Canonical: true},
+			want:        "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			wantPresent: true,
+		},
+		{
+			name:  "invalid-uuid",
+			value: "6ba7b810",
+			prop:  &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_UUID},
+			err:   xerrors.ErrE2049,
+		},
+		{
+			name:  "invalid-regexp",
+			value: "[a-",
+			prop:  &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_REGEXP},
+			err:   xerrors.ErrE2050,
+		},
+		{
+			name:        "regexp-not-trimmed",
+			value:       "^foo bar $",
+			prop:        &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_REGEXP, Canonical: true},
+			want:        "^foo bar $",
+			wantPresent: true,
+		},
+		{
+			name:  "url-not-trimmed",
+			value: " https://example.com",
+			prop:  &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_URL},
+			err:   xerrors.ErrE2051,
+		},
+		{
+			name:        "url-schemes",
+			value:       "WSS://Chat.example.com",
+			prop:        &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_URL, Schemes: "ws, wss", Canonical: true},
+			want:        "wss://chat.example.com",
+			wantPresent: true,
+		},
+		{
+			name:  "url-scheme-not-allowed",
+			value: "http://example.com",
+			prop:  &tableaupb.FieldProp{StringFormat: tableaupb.StringFormat_STRING_FORMAT_URL, Schemes: "https"},
+			err:   xerrors.ErrE2051,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, present, err := ParseFieldValue(fd, tt.value, "UTC", tt.prop)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPresent, present)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package xproto
 
 import (
+	"slices"
 	"strings"
 	"sync"
 
@@ -17,7 +18,25 @@ type TypeInfo struct {
 	ParentFilename string
 	Kind           types.Kind
 
-	FirstFieldOptionName string // only for MessageKind
+	FirstFieldOptionName string           // only for MessageKind
+	EnumValues           []*EnumValueInfo // only for EnumKind
+}
+
+// EnumValueInfo is the name and number of an enum value.
+type EnumValueInfo struct {
+	Name   string
+	Number int32
+}
+
+// NewEnumValueInfos creates enum value infos of the enum descriptor.
+func NewEnumValueInfos(ed protoreflect.EnumDescriptor) []*EnumValueInfo {
+	values := ed.Values()
+	infos := make([]*EnumValueInfo, 0, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		evd := values.Get(i)
+		infos = append(infos, &EnumValueInfo{Name: string(evd.Name()), Number: int32(evd.Number())})
+	}
+	return infos
 }
 
 func NewTypeInfos(protoPackage string) *TypeInfos {
@@ -65,6 +84,23 @@ func (x *TypeInfos) GetByFullName(fullName protoreflect.FullName) *TypeInfo {
 	return x.infos[fullName]
 }
 
+// ListByParentFilename returns all type infos defined in the parent file,
+// sorted by full name.
+func (x *TypeInfos) ListByParentFilename(filename string) []*TypeInfo {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	var infos []*TypeInfo
+	for _, info := range x.infos {
+		if info.ParentFilename == filename {
+			infos = append(infos, info)
+		}
+	}
+	slices.SortFunc(infos, func(a, b *TypeInfo) int {
+		return strings.Compare(string(a.FullName), string(b.FullName))
+	})
+	return infos
+}
+
 func GetAllTypeInfo(files *protoregistry.Files, protoPackage string) *TypeInfos {
 	typeInfos := NewTypeInfos(protoPackage)
 	files.RangeFiles(func(fileDesc protoreflect.FileDescriptor) bool {
@@ -75,6 +111,7 @@ func GetAllTypeInfo(files *protoregistry.Files, protoPackage string) *TypeInfos
 				FullName:       ed.FullName(),
 				ParentFilename: ed.ParentFile().Path(),
 				Kind:           types.EnumKind,
+				EnumValues:     NewEnumValueInfos(ed),
 			}
 			typeInfos.Put(info)
 		}
@@ -113,6 +150,7 @@ func extractTypeInfosFromMessage(md protoreflect.MessageDescriptor, typeInfos *T
 			FullName:       ed.FullName(),
 			ParentFilename: ed.ParentFile().Path(),
 			Kind:           types.EnumKind,
+			EnumValues:     NewEnumValueInfos(ed),
 		}
 		typeInfos.Put(info)
 	}
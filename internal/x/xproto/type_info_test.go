@@ -71,6 +71,11 @@ func Test_extractTypeInfosFromMessage(t *testing.T) {
 						FullName:       "unittest.Target.Type",
 						ParentFilename: "tableau/protobuf/unittest/common.proto",
 						Kind:           types.EnumKind,
+						EnumValues: []*EnumValueInfo{
+							{Name: "TYPE_NIL", Number: 0},
+							{Name: "TYPE_PVP", Number: 1},
+							{Name: "TYPE_PVE", Number: 2},
+						},
 					},
 					"unittest.Target.Pvp": {
 						FullName:       "unittest.Target.Pvp",
@@ -185,6 +190,25 @@ func TestTypeInfos_Get(t *testing.T) {
 	}
 }
 
+func TestTypeInfos_ListByParentFilename(t *testing.T) {
+	typeInfos := NewTypeInfos("protoconf")
+	typeInfos.Put(&TypeInfo{FullName: "protoconf.Reward", ParentFilename: "common.proto", Kind: types.MessageKind})
+	typeInfos.Put(&TypeInfo{FullName: "protoconf.ItemType", ParentFilename: "common.proto", Kind: types.EnumKind})
+	typeInfos.Put(&TypeInfo{FullName: "protoconf.ItemConf", ParentFilename: "item.proto", Kind: types.MessageKind})
+
+	got := typeInfos.ListByParentFilename("common.proto")
+	want := []*TypeInfo{
+		{FullName: "protoconf.ItemType", ParentFilename: "common.proto", Kind: types.EnumKind},
+		{FullName: "protoconf.Reward", ParentFilename: "common.proto", Kind: types.MessageKind},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TypeInfos.ListByParentFilename() = %v, want %v", got, want)
+	}
+	if got := typeInfos.ListByParentFilename("hero.proto"); len(got) != 0 {
+		t.Errorf("TypeInfos.ListByParentFilename() = %v, want empty", got)
+	}
+}
+
 func TestCloneWellknownTypes(t *testing.T) {
 	importPaths := []string{
 		"../../../proto", // tableau
@@ -1,10 +1,13 @@
 package xproto
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // Clone returns a deep copy of m. If the top-level message is invalid, it
@@ -52,3 +55,41 @@ func GetFieldTypeName(fd protoreflect.FieldDescriptor) string {
 		return fd.Kind().String()
 	}
 }
+
+// HasExplicitPresence reports whether the field presence is explicitly
+// specified by label "optional" in proto3, or by feature
+// "field_presence = EXPLICIT" at field level in editions.
+func HasExplicitPresence(fd protoreflect.FieldDescriptor) bool {
+	if fd.HasOptionalKeyword() {
+		return true
+	}
+	opts, _ := fd.Options().(*descriptorpb.FieldOptions)
+	return opts.GetFeatures().GetFieldPresence() == descriptorpb.FeatureSet_EXPLICIT
+}
+
+// SortedMapKeys returns the keys of map m in ascending order.
+func SortedMapKeys(m protoreflect.Map) []protoreflect.MapKey {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	slices.SortFunc(keys, func(x, y protoreflect.MapKey) int {
+		switch xv := x.Interface().(type) {
+		case bool:
+			if xv == y.Bool() {
+				return 0
+			} else if xv {
+				return 1
+			}
+			return -1
+		case int32, int64:
+			return cmp.Compare(x.Int(), y.Int())
+		case uint32, uint64:
+			return cmp.Compare(x.Uint(), y.Uint())
+		default:
+			return cmp.Compare(x.String(), y.String())
+		}
+	})
+	return keys
+}
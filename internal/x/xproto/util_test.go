@@ -3,8 +3,10 @@ package xproto
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestGetFieldTypeName(t *testing.T) {
@@ -75,3 +77,19 @@ func TestGetFieldTypeName(t *testing.T) {
 		})
 	}
 }
+
+func TestSortedMapKeys(t *testing.T) {
+	intMap := &unittestpb.IncellMap{FlavorMap: map[int64]unittestpb.FruitFlavor{10: 0, -1: 0, 2: 0}}
+	var intKeys []int64
+	for _, key := range SortedMapKeys(intMap.ProtoReflect().Get(intMap.ProtoReflect().Descriptor().Fields().ByName("flavor_map")).Map()) {
+		intKeys = append(intKeys, key.Int())
+	}
+	assert.Equal(t, []int64{-1, 2, 10}, intKeys)
+
+	strMap := &structpb.Struct{Fields: map[string]*structpb.Value{"b": {}, "c": {}, "a": {}}}
+	var strKeys []string
+	for _, key := range SortedMapKeys(strMap.ProtoReflect().Get(strMap.ProtoReflect().Descriptor().Fields().ByName("fields")).Map()) {
+		strKeys = append(strKeys, key.String())
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, strKeys)
+}
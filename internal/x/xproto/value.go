@@ -37,6 +37,13 @@ var DefaultDurationValue pref.Value
 var DefaultFractionValue pref.Value
 var DefaultComparatorValue pref.Value
 var DefaultVersionValue pref.Value
+var DefaultVectorValue pref.Value
+var DefaultDecimalValue pref.Value
+var DefaultColorValue pref.Value
+var DefaultIntervalValue pref.Value
+var DefaultDateValue pref.Value
+var DefaultTimeOfDayValue pref.Value
+var DefaultScheduleValue pref.Value
 
 func init() {
 	DefaultBoolValue = pref.ValueOfBool(false)
@@ -64,7 +71,7 @@ func init() {
 //
 //   - Numbers: int32, uint32, int64, uint64, float, double
 //   - Booleans: bool
-//   - Strings: string
+//   - Strings: string, validated by prop string_format: uuid, regexp, and url
 //   - Bytes: bytes
 //
 // # Enum types
@@ -135,6 +142,9 @@ func ParseFieldValue(fd pref.FieldDescriptor, rawValue string, locationName stri
 
 	case pref.Uint64Kind, pref.Fixed64Kind:
 		value := getTrimmedValue()
+		if flags := fprop.GetFlags(); flags != "" {
+			return parseFlags(fd, value, flags)
+		}
 		if value == "" {
 			return DefaultUint64Value, false, nil
 		}
@@ -173,6 +183,9 @@ func ParseFieldValue(fd pref.FieldDescriptor, rawValue string, locationName stri
 		return pref.ValueOfFloat64(val), true, xerrors.E2012("double", value, err)
 
 	case pref.StringKind:
+		if fprop.GetStringFormat() != tableaupb.StringFormat_STRING_FORMAT_NONE {
+			return parseStringFormat(fd, getValue(), fprop)
+		}
 		value := getValue()
 		var present bool
 		if value != "" {
@@ -260,6 +273,42 @@ func ParseFieldValue(fd pref.FieldDescriptor, rawValue string, locationName stri
 				return DefaultVersionValue, false, nil
 			}
 			return parseVersion(fd.Message(), value, fprop.GetPattern())
+		case types.WellKnownMessageVector2, types.WellKnownMessageVector3, types.WellKnownMessageVector4,
+			types.WellKnownMessageVector2i, types.WellKnownMessageVector3i, types.WellKnownMessageVector4i:
+			if value == "" {
+				return DefaultVectorValue, false, nil
+			}
+			return parseVector(fd.Message(), value)
+		case types.WellKnownMessageDecimal:
+			if value == "" {
+				return DefaultDecimalValue, false, nil
+			}
+			return parseDecimal(fd.Message(), value, fprop.GetPattern())
+		case types.WellKnownMessageColor:
+			if value == "" {
+				return DefaultColorValue, false, nil
+			}
+			return parseColor(fd.Message(), value)
+		case types.WellKnownMessageInterval, types.WellKnownMessageDoubleInterval, types.WellKnownMessageTimestampInterval:
+			if value == "" {
+				return DefaultIntervalValue, false, nil
+			}
+			return parseInterval(fd.Message(), value, locationName)
+		case types.WellKnownMessageDate:
+			if value == "" {
+				return DefaultDateValue, false, nil
+			}
+			return parseDate(fd.Message(), value)
+		case types.WellKnownMessageTimeOfDay:
+			if value == "" {
+				return DefaultTimeOfDayValue, false, nil
+			}
+			return parseTimeOfDay(fd.Message(), value)
+		case types.WellKnownMessageSchedule:
+			if value == "" {
+				return DefaultScheduleValue, false, nil
+			}
+			return parseSchedule(fd.Message(), value, locationName, fprop.GetSchedule())
 		default:
 			return pref.Value{}, false, xerrors.Newf("not supported message type: %s", msgName)
 		}
@@ -295,8 +344,11 @@ func parseEnumValue(fd pref.FieldDescriptor, value string) (v pref.Value, presen
 	if value == "" {
 		return DefaultEnumValue, false, nil
 	}
-	ed := fd.Enum() // get enum descriptor
+	return parseEnumValueOf(fd.Enum(), value)
+}
 
+// parseEnumValueOf parses enum value by enum value number, name, or alias.
+func parseEnumValueOf(ed pref.EnumDescriptor, value string) (v pref.Value, present bool, err error) {
 	// try enum value number
 	// val, err := strconv.ParseInt(value, 10, 32)
 
@@ -563,6 +615,143 @@ func parseVersion(md pref.MessageDescriptor, value string, pattern string) (v pr
 	return pref.ValueOfMessage(msg.ProtoReflect()), true, nil
 }
 
+// parseVector parses a well-known vector from following forms:
+//   - comma separated: 1.5,2,3
+//   - parenthesized: (1.5, 2, 3)
+//   - space separated: 1.5 2 3, which can be used in incell list or map
+//     separated by comma, e.g.: "(1 2 3),(4 5 6)"
+//
+// The count of components should be equal to the vector's dimension, e.g.:
+// 3 for tableau.Vector3.
+func parseVector(md pref.MessageDescriptor, value string) (v pref.Value, present bool, err error) {
+	fields := md.Fields()
+	dimension := fields.Len()
+	text := value
+	if strings.HasPrefix(text, "(") && strings.HasSuffix(text, ")") {
+		text = text[1 : len(text)-1]
+	}
+	var components []string
+	if strings.Contains(text, ",") {
+		components = strings.Split(text, ",")
+	} else {
+		components = strings.Fields(text)
+	}
+	if len(components) != dimension {
+		err := fmt.Errorf("got %d components", len(components))
+		return DefaultVectorValue, false, xerrors.E2034(value, dimension, err)
+	}
+	msg := dynamicpb.NewMessage(md)
+	for i, component := range components {
+		fd := fields.Get(i)
+		component = strings.TrimSpace(component)
+		switch fd.Kind() {
+		case pref.FloatKind:
+			f, err := strconv.ParseFloat(component, 32)
+			if err != nil {
+				return DefaultVectorValue, false, xerrors.E2034(value, dimension, err)
+			}
+			msg.Set(fd, pref.ValueOfFloat32(float32(f)))
+		default:
+			n, err := parseInt32(component)
+			if err != nil {
+				return DefaultVectorValue, false, xerrors.E2034(value, dimension, err)
+			}
+			msg.Set(fd, pref.ValueOfInt32(n))
+		}
+	}
+	return pref.ValueOfMessage(msg.ProtoReflect()), true, nil
+}
+
+// FormatVector formats a well-known vector message to the parenthesized
+// form, e.g.: "(1.5,2,3)".
+func FormatVector(msg pref.Message) string {
+	fields := msg.Descriptor().Fields()
+	components := make([]string, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		components = append(components, fmt.Sprint(msg.Get(fields.Get(i)).Interface()))
+	}
+	return "(" + strings.Join(components, ",") + ")"
+}
+
+// parseDecimal parses a decimal exactly from its string form, e.g.: "123.45".
+// If pattern "P,S" is specified, the decimal is rescaled to scale S, and the
+// count of digits should not exceed precision P.
+func parseDecimal(md pref.MessageDescriptor, value string, pattern string) (v pref.Value, present bool, err error) {
+	d, err := tableaupb.ParseDecimal(value)
+	if err != nil {
+		return DefaultDecimalValue, false, xerrors.E2035(value, err)
+	}
+	if pattern != "" {
+		precision, scale, err := parseDecimalPattern(pattern)
+		if err != nil {
+			return DefaultDecimalValue, false, err
+		}
+		unscaled, ok := rescaleDecimal(d.Unscaled, d.Scale, scale)
+		if !ok {
+			return DefaultDecimalValue, false, xerrors.E2036(value, pattern)
+		}
+		digits := strconv.FormatInt(unscaled, 10)
+		if len(strings.TrimPrefix(digits, "-")) > precision {
+			return DefaultDecimalValue, false, xerrors.E2036(value, pattern)
+		}
+		d = tableaupb.NewDecimal(unscaled, scale)
+	}
+	msg := dynamicpb.NewMessage(md)
+	msg.Set(md.Fields().ByName("unscaled"), pref.ValueOfInt64(d.Unscaled))
+	msg.Set(md.Fields().ByName("scale"), pref.ValueOfInt32(d.Scale))
+	return pref.ValueOfMessage(msg.ProtoReflect()), true, nil
+}
+
+// parseDecimalPattern parses decimal pattern "P,S" or "P" (same as "P,0").
+// The precision P is in range [1,18], and the scale S is in range [0,P].
+func parseDecimalPattern(pattern string) (precision int, scale int32, err error) {
+	precisionStr, scaleStr, hasScale := strings.Cut(pattern, ",")
+	precision, err = strconv.Atoi(strings.TrimSpace(precisionStr))
+	if err != nil || precision < 1 || precision > 18 {
+		return 0, 0, xerrors.Newf(`invalid decimal pattern: %q, precision should be in range [1,18]`, pattern)
+	}
+	if hasScale {
+		s, err := strconv.Atoi(strings.TrimSpace(scaleStr))
+		if err != nil || s < 0 || s > precision {
+			return 0, 0, xerrors.Newf(`invalid decimal pattern: %q, scale should be in range [0,%d]`, pattern, precision)
+		}
+		scale = int32(s)
+	}
+	return precision, scale, nil
+}
+
+// rescaleDecimal rescales the unscaled value from scale "from" to scale
+// "to". It reports false if digits would be lost or the value overflows.
+func rescaleDecimal(unscaled int64, from, to int32) (int64, bool) {
+	for ; from > to; from-- {
+		if unscaled%10 != 0 {
+			return 0, false
+		}
+		unscaled /= 10
+	}
+	for ; from < to; from++ {
+		if unscaled > math.MaxInt64/10 || unscaled < math.MinInt64/10 {
+			return 0, false
+		}
+		unscaled *= 10
+	}
+	return unscaled, true
+}
+
+// DecimalOf converts a well-known decimal message to [tableaupb.Decimal].
+func DecimalOf(msg pref.Message) *tableaupb.Decimal {
+	fields := msg.Descriptor().Fields()
+	unscaled := msg.Get(fields.ByName("unscaled")).Int()
+	scale := int32(msg.Get(fields.ByName("scale")).Int())
+	return tableaupb.NewDecimal(unscaled, scale)
+}
+
+// FormatDecimal formats a well-known decimal message to its string form,
+// e.g.: "123.45".
+func FormatDecimal(msg pref.Message) string {
+	return DecimalOf(msg).Text()
+}
+
 var versionPatterns = &versionPatternCache{
 	cache: map[string][]uint32{},
 }
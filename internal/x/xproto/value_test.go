@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tableauio/tableau/internal/x/xerrors"
 	"github.com/tableauio/tableau/proto/tableaupb"
+	"google.golang.org/protobuf/proto"
 	pref "google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -797,3 +798,154 @@ func Test_parseVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseVector(t *testing.T) {
+	tests := []struct {
+		name  string
+		md    pref.MessageDescriptor
+		value string
+		wantV proto.Message
+		err   error
+	}{
+		{
+			name:  "vector3-comma-separated",
+			md:    (&tableaupb.Vector3{}).ProtoReflect().Descriptor(),
+			value: "1.5,2,3",
+			wantV: &tableaupb.Vector3{X: 1.5, Y: 2, Z: 3},
+		},
+		{
+			name:  "vector3-parenthesized",
+			md:    (&tableaupb.Vector3{}).ProtoReflect().Descriptor(),
+			value: "(1.5, 2, -3)",
+			wantV: &tableaupb.Vector3{X: 1.5, Y: 2, Z: -3},
+		},
+		{
+			name:  "vector2-space-separated",
+			md:    (&tableaupb.Vector2{}).ProtoReflect().Descriptor(),
+			value: "(0.5 1)",
+			wantV: &tableaupb.Vector2{X: 0.5, Y: 1},
+		},
+		{
+			name:  "vector4i",
+			md:    (&tableaupb.Vector4I{}).ProtoReflect().Descriptor(),
+			value: "1,2,3,4",
+			wantV: &tableaupb.Vector4I{X: 1, Y: 2, Z: 3, W: 4},
+		},
+		{
+			name:  "dimension-mismatch",
+			md:    (&tableaupb.Vector3{}).ProtoReflect().Descriptor(),
+			value: "1,2",
+			err:   xerrors.ErrE2034,
+		},
+		{
+			name:  "empty-component",
+			md:    (&tableaupb.Vector3{}).ProtoReflect().Descriptor(),
+			value: "1,,3",
+			err:   xerrors.ErrE2034,
+		},
+		{
+			name:  "float-in-integer-vector",
+			md:    (&tableaupb.Vector2I{}).ProtoReflect().Descriptor(),
+			value: "1.5,2",
+			err:   xerrors.ErrE2034,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotV, present, err := parseVector(tt.md, tt.value)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, present)
+			assert.True(t, gotV.Equal(pref.ValueOfMessage(tt.wantV.ProtoReflect())), "got %v, want %v", gotV, tt.wantV)
+		})
+	}
+}
+
+func TestFormatVector(t *testing.T) {
+	assert.Equal(t, "(1.5,2,-3)", FormatVector((&tableaupb.Vector3{X: 1.5, Y: 2, Z: -3}).ProtoReflect()))
+	assert.Equal(t, "(1,2)", FormatVector((&tableaupb.Vector2I{X: 1, Y: 2}).ProtoReflect()))
+}
+
+func Test_parseDecimal(t *testing.T) {
+	md := (&tableaupb.Decimal{}).ProtoReflect().Descriptor()
+	tests := []struct {
+		name    string
+		value   string
+		pattern string
+		wantV   *tableaupb.Decimal
+		err     error
+		wantErr bool
+	}{
+		{
+			name:  "no-pattern",
+			value: "123.45",
+			wantV: tableaupb.NewDecimal(12345, 2),
+		},
+		{
+			name:  "no-pattern-negative",
+			value: "-0.05",
+			wantV: tableaupb.NewDecimal(-5, 2),
+		},
+		{
+			name:    "pattern-scale-up",
+			value:   "12.5",
+			pattern: "10,2",
+			wantV:   tableaupb.NewDecimal(1250, 2),
+		},
+		{
+			name:    "pattern-trailing-zeros-trimmed",
+			value:   "12.500",
+			pattern: "10,2",
+			wantV:   tableaupb.NewDecimal(1250, 2),
+		},
+		{
+			name:    "pattern-precision-only",
+			value:   "99999",
+			pattern: "5",
+			wantV:   tableaupb.NewDecimal(99999, 0),
+		},
+		{
+			name:    "exceeds-scale",
+			value:   "12.345",
+			pattern: "10,2",
+			err:     xerrors.ErrE2036,
+		},
+		{
+			name:    "exceeds-precision",
+			value:   "123456789.5",
+			pattern: "10,2",
+			err:     xerrors.ErrE2036,
+		},
+		{
+			name:  "invalid-decimal",
+			value: "1.2.3",
+			err:   xerrors.ErrE2035,
+		},
+		{
+			name:    "invalid-pattern",
+			value:   "1.5",
+			pattern: "2,3",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotV, present, err := parseDecimal(md, tt.value, tt.pattern)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, present)
+			assert.True(t, gotV.Equal(pref.ValueOfMessage(tt.wantV.ProtoReflect())), "got %v, want %v", gotV, tt.wantV)
+			assert.Equal(t, tt.wantV.Text(), FormatDecimal(gotV.Message()))
+		})
+	}
+}
@@ -34,6 +34,23 @@ type Options struct {
 
 	Proto *ProtoOption `yaml:"proto"` // Proto generation options.
 	Conf  *ConfOption  `yaml:"conf"`  // Conf generation options.
+	Cache *CacheOption `yaml:"cache"` // Build cache options.
+}
+
+// Options for the build cache, shared by protogen and confgen.
+type CacheOption struct {
+	// Enable the content-hash build cache for incremental builds. The cache
+	// records workbook content hashes, the proto descriptors used, and the
+	// output hashes, so unchanged workbooks are skipped (and their outputs
+	// are restored from the cache if missing or modified) in the next run.
+	//
+	// Default: false.
+	Enable bool `yaml:"enable"`
+
+	// Directory to store the build cache.
+	//
+	// Default: ".tableau-cache".
+	Dir string `yaml:"dir"`
 }
 
 type HeaderOption struct {
@@ -223,6 +240,16 @@ type ProtoOutputOption struct {
 	//
 	// Default: false.
 	PreserveFieldNumbers bool `yaml:"preserveFieldNumbers"`
+
+	// Specify when deprecated fields (marked by field prop "deprecated")
+	// get turned into reserved field numbers:
+	//  - "keep": deprecated fields are kept generating with option
+	//    "[deprecated = true]" until their columns are deleted.
+	//  - "reserve": deprecated fields are not generated any more, and their
+	//    field numbers are reserved if PreserveFieldNumbers is set.
+	//
+	// Default: "keep".
+	DeprecatedFieldPolicy DeprecatedFieldPolicy `yaml:"deprecatedFieldPolicy"`
 }
 
 // Options for generating conf files. Only for confgen.
@@ -340,6 +367,19 @@ type ConfOutputOption struct {
 	// Default: false.
 	EmitTimezones bool `yaml:"emitTimezones"`
 
+	// EmitColorHex specifies whether to emit well-known color (tableau.Color)
+	// in the canonical hex string format, e.g.: "#FF8000" and "#FF800080".
+	//
+	// Default: false.
+	EmitColorHex bool `yaml:"emitColorHex"`
+
+	// EmitDecimalString specifies whether to emit well-known decimal
+	// (tableau.Decimal) in the string format, e.g.: "123.45", which can be
+	// parsed losslessly.
+	//
+	// Default: false.
+	EmitDecimalString bool `yaml:"emitDecimalString"`
+
 	// UseProtoNames uses proto field name instead of lowerCamelCase name
 	// in JSON field names.
 	UseProtoNames bool `yaml:"useProtoNames"`
@@ -352,6 +392,72 @@ type ConfOutputOption struct {
 	//
 	// Default: "".
 	DryRun DryRun `yaml:"dryRun"`
+
+	// Specify the file path of the JUnit XML report of validation results,
+	// e.g.: for CI test dashboards. Each workbook is a test suite and each
+	// worksheet is a test case. Workbooks skipped by the build cache are not
+	// reported.
+	//
+	// Default: "" (no report).
+	JUnitReport string `yaml:"junitReport"`
+
+	// Specify the file path of the reverse reference report, which lists the
+	// values (e.g.: rows' keys) of each referred column which are not
+	// referred by any field with prop "refer". The report is in JSON format
+	// if the file extension is ".json", otherwise in plain text. It is only
+	// generated when converting all workbooks, and the build cache is
+	// disabled as the refer checks of all workbooks are needed.
+	//
+	// Default: "" (no report).
+	ReferenceReport string `yaml:"referenceReport"`
+
+	// Specify the allowlist of the reverse reference report. Each entry is a
+	// glob pattern (see path.Match) matching the referred target (e.g.:
+	// "ItemConf.ID"), or a value of the target (e.g.: "ItemConf.ID:1001"),
+	// which is excluded from the unreferenced values.
+	//
+	// Default: nil.
+	ReferenceAllowlist []string `yaml:"referenceAllowlist"`
+
+	// Specify the localization settings of fields with prop "text" (type
+	// alias "text" or "i18n"). If set, the source texts are replaced by stable
+	// generated keys and extracted into string tables, which are only
+	// generated when converting all workbooks, and the build cache is
+	// disabled as the texts of all workbooks are needed. If not set, the
+	// source texts are output as is.
+	//
+	// Default: nil.
+	Localization *LocalizationOption `yaml:"localization"`
+}
+
+// Localization options for extracting texts into string tables.
+type LocalizationOption struct {
+	// Source language of texts in workbooks, e.g.: "zh". All source texts are
+	// extracted into the string table "Text_<SourceLang>.json" (key ->
+	// source text).
+	//
+	// Default: "".
+	SourceLang string `yaml:"sourceLang"`
+
+	// Target languages to be translated into, e.g.: ["en", "ja"]. For each
+	// target language, the translated CSV "Text_<lang>.csv" with columns
+	// "Key", "Source", and "Translation" is merged from TranslationDir if
+	// existed, then the string table "Text_<lang>.json" (key -> translation)
+	// and the translation CSV to send out "Text_<lang>.csv" are generated.
+	//
+	// Default: nil.
+	TargetLangs []string `yaml:"targetLangs"`
+
+	// Specify the dir (relative to input dir) of translated CSVs.
+	//
+	// Default: "" (input dir).
+	TranslationDir string `yaml:"translationDir"`
+
+	// Whether to report missing and stale translations as errors, otherwise
+	// they are logged as warnings.
+	//
+	// Default: false.
+	Strict bool `yaml:"strict"`
 }
 
 type FirstPassMode = string
@@ -362,6 +468,13 @@ const (
 	FirstPassModeAdvanced FirstPassMode = "advanced" // parse based on all previous generated proto files
 )
 
+type DeprecatedFieldPolicy = string
+
+const (
+	DeprecatedFieldPolicyKeep    DeprecatedFieldPolicy = "keep"    // keep deprecated fields until columns are deleted
+	DeprecatedFieldPolicyReserve DeprecatedFieldPolicy = "reserve" // turn deprecated fields into reserved field numbers
+)
+
 type DryRun = string
 
 const (
@@ -375,6 +488,10 @@ const (
 	DefaultDataRow = 4 // Start row number of data at a worksheet.
 )
 
+const (
+	DefaultCacheDir = ".tableau-cache" // Default directory of the build cache.
+)
+
 const (
 	DefaultSep    = ","
 	DefaultSubsep = ":"
@@ -429,6 +546,13 @@ func Conf(o *ConfOption) Option {
 	}
 }
 
+// Cache sets CacheOption.
+func Cache(o *CacheOption) Option {
+	return func(opts *Options) {
+		opts.Cache = o
+	}
+}
+
 // NewDefault returns a default Options.
 func NewDefault() *Options {
 	return &Options{
@@ -463,6 +587,9 @@ func NewDefault() *Options {
 				Pretty:  true,
 			},
 		},
+		Cache: &CacheOption{
+			Dir: DefaultCacheDir,
+		},
 	}
 }
 
@@ -380,6 +380,59 @@ func (Order) EnumDescriptor() ([]byte, []int) {
 	return file_tableau_protobuf_tableau_proto_rawDescGZIP(), []int{5}
 }
 
+// Validated format of string field.
+type StringFormat int32
+
+const (
+	StringFormat_STRING_FORMAT_NONE   StringFormat = 0
+	StringFormat_STRING_FORMAT_UUID   StringFormat = 1 // UUID, e.g.: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	StringFormat_STRING_FORMAT_REGEXP StringFormat = 2 // Regular expression in RE2 syntax, e.g.: "^[a-z]+$"
+	StringFormat_STRING_FORMAT_URL    StringFormat = 3 // Absolute URL, e.g.: "https://example.com/a"
+)
+
+// Enum value maps for StringFormat.
+var (
+	StringFormat_name = map[int32]string{
+		0: "STRING_FORMAT_NONE",
+		1: "STRING_FORMAT_UUID",
+		2: "STRING_FORMAT_REGEXP",
+		3: "STRING_FORMAT_URL",
+	}
+	StringFormat_value = map[string]int32{
+		"STRING_FORMAT_NONE":   0,
+		"STRING_FORMAT_UUID":   1,
+		"STRING_FORMAT_REGEXP": 2,
+		"STRING_FORMAT_URL":    3,
+	}
+)
+
+func (x StringFormat) Enum() *StringFormat {
+	p := new(StringFormat)
+	*p = x
+	return p
+}
+
+func (x StringFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StringFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_tableau_protobuf_tableau_proto_enumTypes[6].Descriptor()
+}
+
+func (StringFormat) Type() protoreflect.EnumType {
+	return &file_tableau_protobuf_tableau_proto_enumTypes[6]
+}
+
+func (x StringFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StringFormat.Descriptor instead.
+func (StringFormat) EnumDescriptor() ([]byte, []int) {
+	return file_tableau_protobuf_tableau_proto_rawDescGZIP(), []int{6}
+}
+
 type EnumOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1307,6 +1360,16 @@ type FieldProp struct {
 	//     the sheet name is the generated protobuf message name.
 	//   - "SheetName(SheetAlias).ColumnName": e.g. "Item(ItemConf).ID", with
 	//     sheet alias, and sheet alias is the generated protobuf message name.
+	//   - "SheetName.(ColumnName1,ColumnName2)": e.g. "Item.(ID,Quality)",
+	//     composite key, which should be set on a struct field, and the
+	//     struct's field values in declaration order form the composite key.
+	//   - "SheetName.FieldName1.FieldName2": e.g. "HeroConf.Hero.Skill.ID",
+	//     nested field path. For document sheets (YAML/XML), it can refer to
+	//     nested map keys, list elements, or struct fields; for table sheets,
+	//     the column name is the concatenation of the path, e.g.: "HeroSkillID".
+	//
+	// Multiple refers are comma separated, and the field's value should be in at
+	// least one of the referred value spaces.
 	Refer string `protobuf:"bytes,3,opt,name=refer,proto3" json:"refer,omitempty"`
 	// Ensure this field's value is a sequence and begins with this value.
 	// Mainly used for map key and list element.
@@ -1360,6 +1423,12 @@ type FieldProp struct {
 	//	number ranges from 0 to the corresponding part (MAX) of pattern.
 	//	Default pattern: "255.255.255".
 	//
+	// For decimal (tableau.Decimal) field:
+	//
+	//	Specify the precision and scale as "P,S" (or "P" as "P,0"), e.g.:
+	//	"10,2" means at most 10 digits, of which 2 are after the decimal point.
+	//	Type alias "decimal(10,2)" is the same as pattern "10,2".
+	//
 	// TODO: use cases for more field types.
 	Pattern string `protobuf:"bytes,16,opt,name=pattern,proto3" json:"pattern,omitempty"`
 	// Ensure this field's value is in order.
@@ -1399,6 +1468,108 @@ type FieldProp struct {
 	//   - incell keyed-list: appends elements from each visit; duplicate keys
 	//     across visits report error E2028.
 	Aggregate bool `protobuf:"varint,21,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	// Whether this field is deprecated. It is the first step of removing a
+	// column, instead of deleting it silently. If set to true, then:
+	//  - protogen: the generated field is marked as "[deprecated = true]",
+	//    which is a protobuf built-in option.
+	//  - confgen: the field data is still parsed, but a warning is emitted if
+	//    the field still contains non-default data.
+	//
+	// When deprecated fields get turned into reserved field numbers is
+	// controlled by option "proto.output.deprecatedFieldPolicy".
+	Deprecated bool `protobuf:"varint,22,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// In order to track field presence of basic types (numeric, string, bytes,
+	// and enums), the generated field will be labeled "optional" in proto3, or
+	// with feature "field_presence = EXPLICIT" in editions. Then unset cells
+	// are left unpopulated, so that loaders can tell "missing" from "zero".
+	//
+	// It is the field-level counterpart of WorksheetOptions.field_presence.
+	//
+	// See https://protobuf.dev/programming-guides/field_presence/
+	FieldPresence bool `protobuf:"varint,23,opt,name=field_presence,json=fieldPresence,proto3" json:"field_presence,omitempty"`
+	// Compute this field's value by a CEL expression over other fields of the
+	// same message, which is referenced as "this". It is evaluated as soon as
+	// the message is parsed, so that field props (range, refer, unique, order,
+	// etc.) and cross-field rules are checked against the computed value, e.g.:
+	//   - "this.unit_cost * this.count": total cost of items.
+	//   - "this.start + this.duration": end time (google.protobuf.Timestamp)
+	//     from start time and duration.
+	//
+	// Computed fields are evaluated in field declaration order, so a computed
+	// field can refer to the preceding computed fields. Only scalar, enum, and
+	// well-known message (e.g.: google.protobuf.Timestamp) fields are supported.
+	//
+	// See https://github.com/google/cel-spec.
+	Compute string `protobuf:"bytes,24,opt,name=compute,proto3" json:"compute,omitempty"`
+	// Ensure this field's value is unique in the named uniqueness domain, which
+	// spans all sheets (including scatter and merger files) converted in one
+	// generation, e.g.: all reward IDs across event workbooks are in the
+	// domain "RewardID". Conflicts are reported with both source locations.
+	//
+	// Values in the same sheet are also checked against each other. Only present
+	// scalar and enum values (including map keys and list elements) are checked.
+	UniqueDomain string `protobuf:"bytes,25,opt,name=unique_domain,json=uniqueDomain,proto3" json:"unique_domain,omitempty"`
+	// Cross-field rules, which are checked on every parsed message (e.g.: the
+	// top-level message, a struct, a map value, or a list element), and refer
+	// to the sibling fields by their names (option "name") in the same message.
+	// They are compiled into CEL rules, and broken rules are reported with
+	// localized errors at this field's cell.
+	//
+	// This field's value is required (present) if the condition is met.
+	//
+	// Format: "Column=Value" or "Column!=Value", e.g.: "Type=TYPE_PVE".
+	RequiredIf string `protobuf:"bytes,26,opt,name=required_if,json=requiredIf,proto3" json:"required_if,omitempty"`
+	// This field and the comma separated sibling fields are mutually exclusive,
+	// i.e. they cannot be present at the same time.
+	//
+	// Format: "Column1,Column2", e.g.: "ItemID,EquipID".
+	ExclusiveWith string `protobuf:"bytes,27,opt,name=exclusive_with,json=exclusiveWith,proto3" json:"exclusive_with,omitempty"`
+	// Compare this field's value with the sibling field's value, which is only
+	// checked if both are present. Operators: <, <=, >, >=, ==, and !=.
+	//
+	// Format: "<Operator>Column", e.g.: "<=MaxLevel".
+	Compare string `protobuf:"bytes,28,opt,name=compare,proto3" json:"compare,omitempty"`
+	// Ensure this interval field's values (tableau.Interval, tableau.DoubleInterval,
+	// and tableau.TimestampInterval) don't overlap with each other in the
+	// enclosing list or map, e.g.: level brackets "[1,10)" and "[10,20)".
+	Disjoint bool `protobuf:"varint,29,opt,name=disjoint,proto3" json:"disjoint,omitempty"`
+	// Weight of random sampling over the enclosing list elements or map values,
+	// e.g.: drop tables of (item, weight) pairs. Only integer and floating-point
+	// fields are supported. Weights should not be negative, and the total of
+	// weights should be greater than 0.
+	//
+	// Usage: {weight:{total:100 cumulative:"CumWeight" probability:"Prob"}}
+	Weight *FieldProp_Weight `protobuf:"bytes,30,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Full name of the enum type, whose values are bit flags of this uint64
+	// field, which is generated by type syntax "flags<EnumType>". Bit N of the
+	// bitmask is set for the enum value numbered N, so enum value numbers
+	// should be in range [0,63].
+	//
+	// Flags are separated by "|" or ",", and each flag can be the enum value
+	// name, number, or alias, e.g.: "IOS|ANDROID" or "iOS,Android".
+	Flags string `protobuf:"bytes,31,opt,name=flags,proto3" json:"flags,omitempty"`
+	// Precompute the next occurrences of this tableau.Schedule field, for
+	// clients that can't parse cron expressions.
+	//
+	// Usage: {schedule:{occurrences:10 since:"2024-01-01 00:00:00"}}
+	Schedule *FieldProp_Schedule `protobuf:"bytes,32,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Localized text of this string field, which is generated by type alias
+	// "text" or "i18n". If localization is enabled, the cell (source text) is
+	// replaced by a stable generated key, and extracted into string tables.
+	Text bool `protobuf:"varint,33,opt,name=text,proto3" json:"text,omitempty"`
+	// Validated format of this string field, which is generated by type alias
+	// "uuid", "regexp", or "url". Invalid values are reported at parse time.
+	StringFormat StringFormat `protobuf:"varint,34,opt,name=string_format,json=stringFormat,proto3,enum=tableau.StringFormat" json:"string_format,omitempty"`
+	// Canonicalize the value of this string field with string format:
+	//  - uuid: lowercase hyphenated form, e.g.:
+	//    "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}" -> "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+	//  - regexp: kept as the source pattern, as it is only validated.
+	//  - url: lowercase scheme and host, and default port removed, e.g.:
+	//    "HTTPS://Example.com:443/a" -> "https://example.com/a".
+	Canonical bool `protobuf:"varint,35,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	// Allowed schemes of the url string format, separated by comma, e.g.:
+	// "https,wss". Default: "http,https".
+	Schemes string `protobuf:"bytes,36,opt,name=schemes,proto3" json:"schemes,omitempty"`
 }
 
 func (x *FieldProp) Reset() {
@@ -1573,6 +1744,242 @@ func (x *FieldProp) GetAggregate() bool {
 	return false
 }
 
+func (x *FieldProp) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *FieldProp) GetFieldPresence() bool {
+	if x != nil {
+		return x.FieldPresence
+	}
+	return false
+}
+
+func (x *FieldProp) GetCompute() string {
+	if x != nil {
+		return x.Compute
+	}
+	return ""
+}
+
+func (x *FieldProp) GetUniqueDomain() string {
+	if x != nil {
+		return x.UniqueDomain
+	}
+	return ""
+}
+
+func (x *FieldProp) GetRequiredIf() string {
+	if x != nil {
+		return x.RequiredIf
+	}
+	return ""
+}
+
+func (x *FieldProp) GetExclusiveWith() string {
+	if x != nil {
+		return x.ExclusiveWith
+	}
+	return ""
+}
+
+func (x *FieldProp) GetCompare() string {
+	if x != nil {
+		return x.Compare
+	}
+	return ""
+}
+
+func (x *FieldProp) GetDisjoint() bool {
+	if x != nil {
+		return x.Disjoint
+	}
+	return false
+}
+
+func (x *FieldProp) GetWeight() *FieldProp_Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *FieldProp) GetFlags() string {
+	if x != nil {
+		return x.Flags
+	}
+	return ""
+}
+
+func (x *FieldProp) GetSchedule() *FieldProp_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *FieldProp) GetText() bool {
+	if x != nil {
+		return x.Text
+	}
+	return false
+}
+
+func (x *FieldProp) GetStringFormat() StringFormat {
+	if x != nil {
+		return x.StringFormat
+	}
+	return StringFormat_STRING_FORMAT_NONE
+}
+
+func (x *FieldProp) GetCanonical() bool {
+	if x != nil {
+		return x.Canonical
+	}
+	return false
+}
+
+func (x *FieldProp) GetSchemes() string {
+	if x != nil {
+		return x.Schemes
+	}
+	return ""
+}
+
+type FieldProp_Weight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The exact total of weights required, e.g.: 100 for percentages. The
+	// default 0 means any total greater than 0.
+	Total float64 `protobuf:"fixed64,1,opt,name=total,proto3" json:"total,omitempty"`
+	// The sibling field (by option "name") to store the cumulative weight,
+	// which is the total of weights of preceding and current elements. Map
+	// values are accumulated in ascending key order. Loaders can sample by
+	// binary searching a random number in [0, total) over the cumulative
+	// weights in O(log n).
+	Cumulative string `protobuf:"bytes,2,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
+	// The sibling floating-point field (by option "name") to store the
+	// normalized probability, which is weight divided by total.
+	Probability string `protobuf:"bytes,3,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (x *FieldProp_Weight) Reset() {
+	*x = FieldProp_Weight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_tableau_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldProp_Weight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldProp_Weight) ProtoMessage() {}
+
+func (x *FieldProp_Weight) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_tableau_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldProp_Weight.ProtoReflect.Descriptor instead.
+func (*FieldProp_Weight) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_tableau_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *FieldProp_Weight) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FieldProp_Weight) GetCumulative() string {
+	if x != nil {
+		return x.Cumulative
+	}
+	return ""
+}
+
+func (x *FieldProp_Weight) GetProbability() string {
+	if x != nil {
+		return x.Probability
+	}
+	return ""
+}
+
+type FieldProp_Schedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Count of the next occurrences to precompute.
+	Occurrences int32 `protobuf:"varint,1,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	// The datetime (in LocationName) after which the next occurrences are
+	// computed, e.g.: "2024-01-01 00:00:00". It is required if occurrences
+	// is greater than 0, so that the output is reproducible.
+	Since string `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *FieldProp_Schedule) Reset() {
+	*x = FieldProp_Schedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_tableau_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldProp_Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldProp_Schedule) ProtoMessage() {}
+
+func (x *FieldProp_Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_tableau_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldProp_Schedule.ProtoReflect.Descriptor instead.
+func (*FieldProp_Schedule) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_tableau_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *FieldProp_Schedule) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *FieldProp_Schedule) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
 var file_tableau_protobuf_tableau_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FileOptions)(nil),
@@ -1803,7 +2210,7 @@ var file_tableau_protobuf_tableau_proto_rawDesc = []byte{
 	0x65, 0x61, 0x75, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x12, 0x26,
 	0x0a, 0x04, 0x70, 0x72, 0x6f, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
 	0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70,
-	0x52, 0x04, 0x70, 0x72, 0x6f, 0x70, 0x22, 0xed, 0x04, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x04, 0x70, 0x72, 0x6f, 0x70, 0x22, 0xa1, 0x0a, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64,
 	0x50, 0x72, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x6e,
 	0x69, 0x71, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x75, 0x6e,
@@ -1840,95 +2247,145 @@ var file_tableau_protobuf_tableau_proto_rawDesc = []byte{
 	0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x67,
 	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x2a, 0x5b, 0x0a, 0x06, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74,
-	0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55,
-	0x4c, 0x54, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x56,
-	0x45, 0x52, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x41, 0x59,
-	0x4f, 0x55, 0x54, 0x5f, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x4f, 0x4e, 0x54, 0x41, 0x4c, 0x10, 0x02,
-	0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x49, 0x4e, 0x43, 0x45, 0x4c,
-	0x4c, 0x10, 0x03, 0x2a, 0x42, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x53,
-	0x50, 0x41, 0x4e, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x13, 0x0a,
-	0x0f, 0x53, 0x50, 0x41, 0x4e, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x43, 0x45, 0x4c, 0x4c,
-	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x50, 0x41, 0x4e, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52,
-	0x5f, 0x43, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x2a, 0xcb, 0x01, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45,
-	0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x10, 0x02,
-	0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53,
-	0x54, 0x52, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49,
-	0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x4f, 0x4e,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
-	0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49,
-	0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x45, 0x5f, 0x43, 0x53,
-	0x56, 0x10, 0x0a, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x45, 0x5f, 0x4a,
-	0x53, 0x4f, 0x4e, 0x10, 0x0b, 0x2a, 0x36, 0x0a, 0x04, 0x46, 0x6f, 0x72, 0x6d, 0x12, 0x10, 0x0a,
-	0x0c, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12,
-	0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x0d,
-	0x0a, 0x09, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x3b, 0x0a,
-	0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f,
-	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f,
-	0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x41, 0x54,
-	0x43, 0x48, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x02, 0x2a, 0x67, 0x0a, 0x05, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x4e,
-	0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x43,
-	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x43,
-	0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x49,
-	0x43, 0x54, 0x4c, 0x59, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x4c, 0x59, 0x5f, 0x44, 0x45, 0x53,
-	0x43, 0x10, 0x04, 0x3a, 0x54, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b, 0x12,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd0, 0x86,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x08, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b, 0x3a, 0x5a, 0x0a, 0x09, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x66, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x49, 0x66, 0x12,
+	0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x57, 0x69, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70,
+	0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61,
+	0x75, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x61, 0x75, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x23, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x1a, 0x60, 0x0a, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0x42, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x0f, 0x10, 0x10, 0x2a, 0x5b, 0x0a, 0x06, 0x4c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x41, 0x59, 0x4f,
+	0x55, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x4f, 0x4e, 0x54,
+	0x41, 0x4c, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x49,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x42, 0x0a, 0x04, 0x53, 0x70, 0x61, 0x6e, 0x12,
+	0x10, 0x0a, 0x0c, 0x53, 0x50, 0x41, 0x4e, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10,
+	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x50, 0x41, 0x4e, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f,
+	0x43, 0x45, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x50, 0x41, 0x4e, 0x5f, 0x49,
+	0x4e, 0x4e, 0x45, 0x52, 0x5f, 0x43, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x2a, 0xcb, 0x01, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45,
+	0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c,
+	0x54, 0x49, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x52,
+	0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
+	0x55, 0x4c, 0x54, 0x49, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
+	0x55, 0x4c, 0x54, 0x49, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x45, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x0a, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x45, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x0b, 0x2a, 0x36, 0x0a, 0x04, 0x46, 0x6f, 0x72,
+	0x6d, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x54, 0x45, 0x58, 0x54,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x02, 0x2a, 0x3b, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x02, 0x2a, 0x67,
+	0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x4c, 0x59, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x03, 0x12, 0x17,
+	0x0a, 0x13, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x4c, 0x59,
+	0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x04, 0x2a, 0x6f, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x52, 0x49, 0x4e,
+	0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x49, 0x4e,
+	0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x50, 0x10,
+	0x02, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x03, 0x3a, 0x54, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x61, 0x75, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x62, 0x6f, 0x6f, 0x6b, 0x3a, 0x5a,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x68, 0x65, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd0, 0x86, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x68, 0x65, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x68, 0x65, 0x65, 0x74, 0x3a, 0x51, 0x0a, 0x06, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd1, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x3a, 0x4e, 0x0a,
+	0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd2, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x4c, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4a, 0x0a, 0x05, 0x65,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x61, 0x75, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x56, 0x0a, 0x06, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x4f, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
 	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x68,
-	0x65, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
-	0x73, 0x68, 0x65, 0x65, 0x74, 0x3a, 0x51, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x12,
-	0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0xd1, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x61, 0x75, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x3a, 0x4e, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x6f,
-	0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0xd2, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x61, 0x75, 0x2e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x4c, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x61, 0x75, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4a, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd0, 0x86,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x65, 0x74, 0x79,
-	0x70, 0x65, 0x3a, 0x56, 0x0a, 0x06, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61,
-	0x75, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x06, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x4f, 0x0a, 0x05, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0xd0, 0x86, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x61, 0x75, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x88, 0x01, 0x01, 0x42, 0x75, 0x0a, 0x14, 0x63,
-	0x6f, 0x6d, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x42, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x69, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61,
-	0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x70,
-	0x62, 0xa2, 0x02, 0x03, 0x54, 0x50, 0x42, 0xaa, 0x02, 0x18, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61,
-	0x75, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x61, 0x75, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x15, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x88, 0x01, 0x01,
+	0x42, 0x75, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x42, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61,
+	0x75, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x69, 0x6f, 0x2f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x61, 0x75, 0x70, 0x62, 0xa2, 0x02, 0x03, 0x54, 0x50, 0x42, 0xaa, 0x02, 0x18, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1943,8 +2400,8 @@ func file_tableau_protobuf_tableau_proto_rawDescGZIP() []byte {
 	return file_tableau_protobuf_tableau_proto_rawDescData
 }
 
-var file_tableau_protobuf_tableau_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_tableau_protobuf_tableau_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_tableau_protobuf_tableau_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_tableau_protobuf_tableau_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_tableau_protobuf_tableau_proto_goTypes = []interface{}{
 	(Layout)(0),                           // 0: tableau.Layout
 	(Span)(0),                             // 1: tableau.Span
@@ -1952,56 +2409,62 @@ var file_tableau_protobuf_tableau_proto_goTypes = []interface{}{
 	(Form)(0),                             // 3: tableau.Form
 	(Patch)(0),                            // 4: tableau.Patch
 	(Order)(0),                            // 5: tableau.Order
-	(*EnumOptions)(nil),                   // 6: tableau.EnumOptions
-	(*EnumValueOptions)(nil),              // 7: tableau.EnumValueOptions
-	(*StructOptions)(nil),                 // 8: tableau.StructOptions
-	(*UnionOptions)(nil),                  // 9: tableau.UnionOptions
-	(*OneofOptions)(nil),                  // 10: tableau.OneofOptions
-	(*WorkbookOptions)(nil),               // 11: tableau.WorkbookOptions
-	(*WorksheetOptions)(nil),              // 12: tableau.WorksheetOptions
-	(*FieldOptions)(nil),                  // 13: tableau.FieldOptions
-	(*FieldProp)(nil),                     // 14: tableau.FieldProp
-	nil,                                   // 15: tableau.WorksheetOptions.LabelsEntry
-	nil,                                   // 16: tableau.WorksheetOptions.LangOptionsEntry
-	(*descriptorpb.FileOptions)(nil),      // 17: google.protobuf.FileOptions
-	(*descriptorpb.MessageOptions)(nil),   // 18: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),     // 19: google.protobuf.FieldOptions
-	(*descriptorpb.EnumOptions)(nil),      // 20: google.protobuf.EnumOptions
-	(*descriptorpb.EnumValueOptions)(nil), // 21: google.protobuf.EnumValueOptions
-	(*descriptorpb.OneofOptions)(nil),     // 22: google.protobuf.OneofOptions
+	(StringFormat)(0),                     // 6: tableau.StringFormat
+	(*EnumOptions)(nil),                   // 7: tableau.EnumOptions
+	(*EnumValueOptions)(nil),              // 8: tableau.EnumValueOptions
+	(*StructOptions)(nil),                 // 9: tableau.StructOptions
+	(*UnionOptions)(nil),                  // 10: tableau.UnionOptions
+	(*OneofOptions)(nil),                  // 11: tableau.OneofOptions
+	(*WorkbookOptions)(nil),               // 12: tableau.WorkbookOptions
+	(*WorksheetOptions)(nil),              // 13: tableau.WorksheetOptions
+	(*FieldOptions)(nil),                  // 14: tableau.FieldOptions
+	(*FieldProp)(nil),                     // 15: tableau.FieldProp
+	nil,                                   // 16: tableau.WorksheetOptions.LabelsEntry
+	nil,                                   // 17: tableau.WorksheetOptions.LangOptionsEntry
+	(*FieldProp_Weight)(nil),              // 18: tableau.FieldProp.Weight
+	(*FieldProp_Schedule)(nil),            // 19: tableau.FieldProp.Schedule
+	(*descriptorpb.FileOptions)(nil),      // 20: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil),   // 21: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 22: google.protobuf.FieldOptions
+	(*descriptorpb.EnumOptions)(nil),      // 23: google.protobuf.EnumOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 24: google.protobuf.EnumValueOptions
+	(*descriptorpb.OneofOptions)(nil),     // 25: google.protobuf.OneofOptions
 }
 var file_tableau_protobuf_tableau_proto_depIdxs = []int32{
-	15, // 0: tableau.WorksheetOptions.labels:type_name -> tableau.WorksheetOptions.LabelsEntry
+	16, // 0: tableau.WorksheetOptions.labels:type_name -> tableau.WorksheetOptions.LabelsEntry
 	2,  // 1: tableau.WorksheetOptions.mode:type_name -> tableau.Mode
 	4,  // 2: tableau.WorksheetOptions.patch:type_name -> tableau.Patch
-	16, // 3: tableau.WorksheetOptions.lang_options:type_name -> tableau.WorksheetOptions.LangOptionsEntry
+	17, // 3: tableau.WorksheetOptions.lang_options:type_name -> tableau.WorksheetOptions.LangOptionsEntry
 	0,  // 4: tableau.FieldOptions.layout:type_name -> tableau.Layout
 	1,  // 5: tableau.FieldOptions.span:type_name -> tableau.Span
-	14, // 6: tableau.FieldOptions.prop:type_name -> tableau.FieldProp
+	15, // 6: tableau.FieldOptions.prop:type_name -> tableau.FieldProp
 	3,  // 7: tableau.FieldProp.form:type_name -> tableau.Form
 	4,  // 8: tableau.FieldProp.patch:type_name -> tableau.Patch
 	5,  // 9: tableau.FieldProp.order:type_name -> tableau.Order
-	17, // 10: tableau.workbook:extendee -> google.protobuf.FileOptions
-	18, // 11: tableau.worksheet:extendee -> google.protobuf.MessageOptions
-	18, // 12: tableau.struct:extendee -> google.protobuf.MessageOptions
-	18, // 13: tableau.union:extendee -> google.protobuf.MessageOptions
-	19, // 14: tableau.field:extendee -> google.protobuf.FieldOptions
-	20, // 15: tableau.etype:extendee -> google.protobuf.EnumOptions
-	21, // 16: tableau.evalue:extendee -> google.protobuf.EnumValueOptions
-	22, // 17: tableau.oneof:extendee -> google.protobuf.OneofOptions
-	11, // 18: tableau.workbook:type_name -> tableau.WorkbookOptions
-	12, // 19: tableau.worksheet:type_name -> tableau.WorksheetOptions
-	8,  // 20: tableau.struct:type_name -> tableau.StructOptions
-	9,  // 21: tableau.union:type_name -> tableau.UnionOptions
-	13, // 22: tableau.field:type_name -> tableau.FieldOptions
-	6,  // 23: tableau.etype:type_name -> tableau.EnumOptions
-	7,  // 24: tableau.evalue:type_name -> tableau.EnumValueOptions
-	10, // 25: tableau.oneof:type_name -> tableau.OneofOptions
-	26, // [26:26] is the sub-list for method output_type
-	26, // [26:26] is the sub-list for method input_type
-	18, // [18:26] is the sub-list for extension type_name
-	10, // [10:18] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	18, // 10: tableau.FieldProp.weight:type_name -> tableau.FieldProp.Weight
+	19, // 11: tableau.FieldProp.schedule:type_name -> tableau.FieldProp.Schedule
+	6,  // 12: tableau.FieldProp.string_format:type_name -> tableau.StringFormat
+	20, // 13: tableau.workbook:extendee -> google.protobuf.FileOptions
+	21, // 14: tableau.worksheet:extendee -> google.protobuf.MessageOptions
+	21, // 15: tableau.struct:extendee -> google.protobuf.MessageOptions
+	21, // 16: tableau.union:extendee -> google.protobuf.MessageOptions
+	22, // 17: tableau.field:extendee -> google.protobuf.FieldOptions
+	23, // 18: tableau.etype:extendee -> google.protobuf.EnumOptions
+	24, // 19: tableau.evalue:extendee -> google.protobuf.EnumValueOptions
+	25, // 20: tableau.oneof:extendee -> google.protobuf.OneofOptions
+	12, // 21: tableau.workbook:type_name -> tableau.WorkbookOptions
+	13, // 22: tableau.worksheet:type_name -> tableau.WorksheetOptions
+	9,  // 23: tableau.struct:type_name -> tableau.StructOptions
+	10, // 24: tableau.union:type_name -> tableau.UnionOptions
+	14, // 25: tableau.field:type_name -> tableau.FieldOptions
+	7,  // 26: tableau.etype:type_name -> tableau.EnumOptions
+	8,  // 27: tableau.evalue:type_name -> tableau.EnumValueOptions
+	11, // 28: tableau.oneof:type_name -> tableau.OneofOptions
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	21, // [21:29] is the sub-list for extension type_name
+	13, // [13:21] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_tableau_protobuf_tableau_proto_init() }
@@ -2118,6 +2581,30 @@ func file_tableau_protobuf_tableau_proto_init() {
 				return nil
 			}
 		}
+		file_tableau_protobuf_tableau_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldProp_Weight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_tableau_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldProp_Schedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_tableau_protobuf_tableau_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	type x struct{}
@@ -2125,8 +2612,8 @@ func file_tableau_protobuf_tableau_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tableau_protobuf_tableau_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   11,
+			NumEnums:      7,
+			NumMessages:   13,
 			NumExtensions: 8,
 			NumServices:   0,
 		},
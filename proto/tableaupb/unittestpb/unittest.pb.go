@@ -10,7 +10,7 @@ package unittestpb
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
-	_ "github.com/tableauio/tableau/proto/tableaupb"
+	tableaupb "github.com/tableauio/tableau/proto/tableaupb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -26,6 +26,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlatformType int32
+
+const (
+	PlatformType_PLATFORM_TYPE_UNKNOWN PlatformType = 0
+	PlatformType_PLATFORM_TYPE_IOS     PlatformType = 1
+	PlatformType_PLATFORM_TYPE_ANDROID PlatformType = 2
+	PlatformType_PLATFORM_TYPE_WEB     PlatformType = 64
+)
+
+// Enum value maps for PlatformType.
+var (
+	PlatformType_name = map[int32]string{
+		0:  "PLATFORM_TYPE_UNKNOWN",
+		1:  "PLATFORM_TYPE_IOS",
+		2:  "PLATFORM_TYPE_ANDROID",
+		64: "PLATFORM_TYPE_WEB",
+	}
+	PlatformType_value = map[string]int32{
+		"PLATFORM_TYPE_UNKNOWN": 0,
+		"PLATFORM_TYPE_IOS":     1,
+		"PLATFORM_TYPE_ANDROID": 2,
+		"PLATFORM_TYPE_WEB":     64,
+	}
+)
+
+func (x PlatformType) Enum() *PlatformType {
+	p := new(PlatformType)
+	*p = x
+	return p
+}
+
+func (x PlatformType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlatformType) Descriptor() protoreflect.EnumDescriptor {
+	return file_tableau_protobuf_unittest_unittest_proto_enumTypes[0].Descriptor()
+}
+
+func (PlatformType) Type() protoreflect.EnumType {
+	return &file_tableau_protobuf_unittest_unittest_proto_enumTypes[0]
+}
+
+func (x PlatformType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlatformType.Descriptor instead.
+func (PlatformType) EnumDescriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{0}
+}
+
 type SimpleIncellMap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1875,32 +1927,32 @@ func (x *HorizontalAggregateList) GetHeroMap() map[uint32]*HorizontalAggregateLi
 	return nil
 }
 
-type IncellMap_Fruit struct {
+type RuleConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   FruitType `protobuf:"varint,1,opt,name=key,proto3,enum=unittest.FruitType" json:"key,omitempty"`
-	Value int64     `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	RewardMap map[uint32]*RuleConf_Reward `protobuf:"bytes,1,rep,name=reward_map,json=rewardMap,proto3" json:"reward_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Level     *RuleConf_Level             `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
 }
 
-func (x *IncellMap_Fruit) Reset() {
-	*x = IncellMap_Fruit{}
+func (x *RuleConf) Reset() {
+	*x = RuleConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[36]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IncellMap_Fruit) String() string {
+func (x *RuleConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncellMap_Fruit) ProtoMessage() {}
+func (*RuleConf) ProtoMessage() {}
 
-func (x *IncellMap_Fruit) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[36]
+func (x *RuleConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,51 +1963,51 @@ func (x *IncellMap_Fruit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncellMap_Fruit.ProtoReflect.Descriptor instead.
-func (*IncellMap_Fruit) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use RuleConf.ProtoReflect.Descriptor instead.
+func (*RuleConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *IncellMap_Fruit) GetKey() FruitType {
+func (x *RuleConf) GetRewardMap() map[uint32]*RuleConf_Reward {
 	if x != nil {
-		return x.Key
+		return x.RewardMap
 	}
-	return FruitType_FRUIT_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *IncellMap_Fruit) GetValue() int64 {
+func (x *RuleConf) GetLevel() *RuleConf_Level {
 	if x != nil {
-		return x.Value
+		return x.Level
 	}
-	return 0
+	return nil
 }
 
-type IncellMap_Item struct {
+type RuleKVConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   FruitType   `protobuf:"varint,1,opt,name=key,proto3,enum=unittest.FruitType" json:"key,omitempty"`
-	Value FruitFlavor `protobuf:"varint,2,opt,name=value,proto3,enum=unittest.FruitFlavor" json:"value,omitempty"`
+	MinLevel int32 `protobuf:"varint,1,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	MaxLevel int32 `protobuf:"varint,2,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
 }
 
-func (x *IncellMap_Item) Reset() {
-	*x = IncellMap_Item{}
+func (x *RuleKVConf) Reset() {
+	*x = RuleKVConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[39]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IncellMap_Item) String() string {
+func (x *RuleKVConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncellMap_Item) ProtoMessage() {}
+func (*RuleKVConf) ProtoMessage() {}
 
-func (x *IncellMap_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[39]
+func (x *RuleKVConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1966,51 +2018,50 @@ func (x *IncellMap_Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncellMap_Item.ProtoReflect.Descriptor instead.
-func (*IncellMap_Item) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{1, 4}
+// Deprecated: Use RuleKVConf.ProtoReflect.Descriptor instead.
+func (*RuleKVConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *IncellMap_Item) GetKey() FruitType {
+func (x *RuleKVConf) GetMinLevel() int32 {
 	if x != nil {
-		return x.Key
+		return x.MinLevel
 	}
-	return FruitType_FRUIT_TYPE_UNKNOWN
+	return 0
 }
 
-func (x *IncellMap_Item) GetValue() FruitFlavor {
+func (x *RuleKVConf) GetMaxLevel() int32 {
 	if x != nil {
-		return x.Value
+		return x.MaxLevel
 	}
-	return FruitFlavor_FRUIT_FLAVOR_UNKNOWN
+	return 0
 }
 
-type MallConf_Shop struct {
+type VectorConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShopId   uint32                          `protobuf:"varint,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
-	GoodsMap map[uint32]*MallConf_Shop_Goods `protobuf:"bytes,2,rep,name=goods_map,json=goodsMap,proto3" json:"goods_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SpawnMap map[uint32]*VectorConf_Spawn `protobuf:"bytes,1,rep,name=spawn_map,json=spawnMap,proto3" json:"spawn_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *MallConf_Shop) Reset() {
-	*x = MallConf_Shop{}
+func (x *VectorConf) Reset() {
+	*x = VectorConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[42]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MallConf_Shop) String() string {
+func (x *VectorConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MallConf_Shop) ProtoMessage() {}
+func (*VectorConf) ProtoMessage() {}
 
-func (x *MallConf_Shop) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[42]
+func (x *VectorConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2021,51 +2072,43 @@ func (x *MallConf_Shop) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MallConf_Shop.ProtoReflect.Descriptor instead.
-func (*MallConf_Shop) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{5, 1}
-}
-
-func (x *MallConf_Shop) GetShopId() uint32 {
-	if x != nil {
-		return x.ShopId
-	}
-	return 0
+// Deprecated: Use VectorConf.ProtoReflect.Descriptor instead.
+func (*VectorConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MallConf_Shop) GetGoodsMap() map[uint32]*MallConf_Shop_Goods {
+func (x *VectorConf) GetSpawnMap() map[uint32]*VectorConf_Spawn {
 	if x != nil {
-		return x.GoodsMap
+		return x.SpawnMap
 	}
 	return nil
 }
 
-type MallConf_Shop_Goods struct {
+type DecimalConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GoodsId uint32 `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
-	Price   int32  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	GoodsMap map[uint32]*DecimalConf_Goods `protobuf:"bytes,1,rep,name=goods_map,json=goodsMap,proto3" json:"goods_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *MallConf_Shop_Goods) Reset() {
-	*x = MallConf_Shop_Goods{}
+func (x *DecimalConf) Reset() {
+	*x = DecimalConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[44]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MallConf_Shop_Goods) String() string {
+func (x *DecimalConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MallConf_Shop_Goods) ProtoMessage() {}
+func (*DecimalConf) ProtoMessage() {}
 
-func (x *MallConf_Shop_Goods) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[44]
+func (x *DecimalConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2076,52 +2119,43 @@ func (x *MallConf_Shop_Goods) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MallConf_Shop_Goods.ProtoReflect.Descriptor instead.
-func (*MallConf_Shop_Goods) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{5, 1, 1}
-}
-
-func (x *MallConf_Shop_Goods) GetGoodsId() uint32 {
-	if x != nil {
-		return x.GoodsId
-	}
-	return 0
+// Deprecated: Use DecimalConf.ProtoReflect.Descriptor instead.
+func (*DecimalConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *MallConf_Shop_Goods) GetPrice() int32 {
+func (x *DecimalConf) GetGoodsMap() map[uint32]*DecimalConf_Goods {
 	if x != nil {
-		return x.Price
+		return x.GoodsMap
 	}
-	return 0
+	return nil
 }
 
-type ActivityConf_Activity struct {
+type ColorConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActivityId   uint32                                    `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
-	ActivityName string                                    `protobuf:"bytes,2,opt,name=activity_name,json=activityName,proto3" json:"activity_name,omitempty"`
-	ChapterMap   map[uint32]*ActivityConf_Activity_Chapter `protobuf:"bytes,3,rep,name=chapter_map,json=chapterMap,proto3" json:"chapter_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ThemeMap map[uint32]*ColorConf_Theme `protobuf:"bytes,1,rep,name=theme_map,json=themeMap,proto3" json:"theme_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ActivityConf_Activity) Reset() {
-	*x = ActivityConf_Activity{}
+func (x *ColorConf) Reset() {
+	*x = ColorConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[46]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivityConf_Activity) String() string {
+func (x *ColorConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityConf_Activity) ProtoMessage() {}
+func (*ColorConf) ProtoMessage() {}
 
-func (x *ActivityConf_Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[46]
+func (x *ColorConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2132,59 +2166,43 @@ func (x *ActivityConf_Activity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityConf_Activity.ProtoReflect.Descriptor instead.
-func (*ActivityConf_Activity) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{6, 1}
-}
-
-func (x *ActivityConf_Activity) GetActivityId() uint32 {
-	if x != nil {
-		return x.ActivityId
-	}
-	return 0
-}
-
-func (x *ActivityConf_Activity) GetActivityName() string {
-	if x != nil {
-		return x.ActivityName
-	}
-	return ""
+// Deprecated: Use ColorConf.ProtoReflect.Descriptor instead.
+func (*ColorConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ActivityConf_Activity) GetChapterMap() map[uint32]*ActivityConf_Activity_Chapter {
+func (x *ColorConf) GetThemeMap() map[uint32]*ColorConf_Theme {
 	if x != nil {
-		return x.ChapterMap
+		return x.ThemeMap
 	}
 	return nil
 }
 
-type ActivityConf_Activity_Chapter struct {
+type IntervalConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChapterId   uint32                                   `protobuf:"varint,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
-	ChapterName string                                   `protobuf:"bytes,2,opt,name=chapter_name,json=chapterName,proto3" json:"chapter_name,omitempty"`
-	SectionList []*ActivityConf_Activity_Chapter_Section `protobuf:"bytes,3,rep,name=section_list,json=sectionList,proto3" json:"section_list,omitempty"`
+	BracketMap map[uint32]*IntervalConf_Bracket `protobuf:"bytes,1,rep,name=bracket_map,json=bracketMap,proto3" json:"bracket_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ActivityConf_Activity_Chapter) Reset() {
-	*x = ActivityConf_Activity_Chapter{}
+func (x *IntervalConf) Reset() {
+	*x = IntervalConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[48]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivityConf_Activity_Chapter) String() string {
+func (x *IntervalConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityConf_Activity_Chapter) ProtoMessage() {}
+func (*IntervalConf) ProtoMessage() {}
 
-func (x *ActivityConf_Activity_Chapter) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[48]
+func (x *IntervalConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,59 +2213,43 @@ func (x *ActivityConf_Activity_Chapter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityConf_Activity_Chapter.ProtoReflect.Descriptor instead.
-func (*ActivityConf_Activity_Chapter) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{6, 1, 1}
-}
-
-func (x *ActivityConf_Activity_Chapter) GetChapterId() uint32 {
-	if x != nil {
-		return x.ChapterId
-	}
-	return 0
-}
-
-func (x *ActivityConf_Activity_Chapter) GetChapterName() string {
-	if x != nil {
-		return x.ChapterName
-	}
-	return ""
+// Deprecated: Use IntervalConf.ProtoReflect.Descriptor instead.
+func (*IntervalConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ActivityConf_Activity_Chapter) GetSectionList() []*ActivityConf_Activity_Chapter_Section {
+func (x *IntervalConf) GetBracketMap() map[uint32]*IntervalConf_Bracket {
 	if x != nil {
-		return x.SectionList
+		return x.BracketMap
 	}
 	return nil
 }
 
-type ActivityConf_Activity_Chapter_Section struct {
+type WeightConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SectionId   uint32                                                   `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
-	SectionName string                                                   `protobuf:"bytes,2,opt,name=section_name,json=sectionName,proto3" json:"section_name,omitempty"`
-	RewardMap   map[uint32]*ActivityConf_Activity_Chapter_Section_Reward `protobuf:"bytes,3,rep,name=reward_map,json=rewardMap,proto3" json:"reward_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DropMap map[uint32]*WeightConf_Drop `protobuf:"bytes,1,rep,name=drop_map,json=dropMap,proto3" json:"drop_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ActivityConf_Activity_Chapter_Section) Reset() {
-	*x = ActivityConf_Activity_Chapter_Section{}
+func (x *WeightConf) Reset() {
+	*x = WeightConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[49]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivityConf_Activity_Chapter_Section) String() string {
+func (x *WeightConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityConf_Activity_Chapter_Section) ProtoMessage() {}
+func (*WeightConf) ProtoMessage() {}
 
-func (x *ActivityConf_Activity_Chapter_Section) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[49]
+func (x *WeightConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,58 +2260,43 @@ func (x *ActivityConf_Activity_Chapter_Section) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityConf_Activity_Chapter_Section.ProtoReflect.Descriptor instead.
-func (*ActivityConf_Activity_Chapter_Section) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{6, 1, 1, 0}
-}
-
-func (x *ActivityConf_Activity_Chapter_Section) GetSectionId() uint32 {
-	if x != nil {
-		return x.SectionId
-	}
-	return 0
-}
-
-func (x *ActivityConf_Activity_Chapter_Section) GetSectionName() string {
-	if x != nil {
-		return x.SectionName
-	}
-	return ""
+// Deprecated: Use WeightConf.ProtoReflect.Descriptor instead.
+func (*WeightConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ActivityConf_Activity_Chapter_Section) GetRewardMap() map[uint32]*ActivityConf_Activity_Chapter_Section_Reward {
+func (x *WeightConf) GetDropMap() map[uint32]*WeightConf_Drop {
 	if x != nil {
-		return x.RewardMap
+		return x.DropMap
 	}
 	return nil
 }
 
-type ActivityConf_Activity_Chapter_Section_Reward struct {
+type FlagsConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id  uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Num int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+	ActivityMap map[uint32]*FlagsConf_Activity `protobuf:"bytes,1,rep,name=activity_map,json=activityMap,proto3" json:"activity_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ActivityConf_Activity_Chapter_Section_Reward) Reset() {
-	*x = ActivityConf_Activity_Chapter_Section_Reward{}
+func (x *FlagsConf) Reset() {
+	*x = FlagsConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[51]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivityConf_Activity_Chapter_Section_Reward) String() string {
+func (x *FlagsConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityConf_Activity_Chapter_Section_Reward) ProtoMessage() {}
+func (*FlagsConf) ProtoMessage() {}
 
-func (x *ActivityConf_Activity_Chapter_Section_Reward) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[51]
+func (x *FlagsConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2320,51 +2307,43 @@ func (x *ActivityConf_Activity_Chapter_Section_Reward) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityConf_Activity_Chapter_Section_Reward.ProtoReflect.Descriptor instead.
-func (*ActivityConf_Activity_Chapter_Section_Reward) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{6, 1, 1, 0, 1}
-}
-
-func (x *ActivityConf_Activity_Chapter_Section_Reward) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use FlagsConf.ProtoReflect.Descriptor instead.
+func (*FlagsConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ActivityConf_Activity_Chapter_Section_Reward) GetNum() int32 {
+func (x *FlagsConf) GetActivityMap() map[uint32]*FlagsConf_Activity {
 	if x != nil {
-		return x.Num
+		return x.ActivityMap
 	}
-	return 0
+	return nil
 }
 
-type RewardConf_Reward struct {
+type ScheduleConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RewardId uint32           `protobuf:"varint,1,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
-	ItemMap  map[uint32]*Item `protobuf:"bytes,2,rep,name=item_map,json=itemMap,proto3" json:"item_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	EventMap map[uint32]*ScheduleConf_Event `protobuf:"bytes,1,rep,name=event_map,json=eventMap,proto3" json:"event_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RewardConf_Reward) Reset() {
-	*x = RewardConf_Reward{}
+func (x *ScheduleConf) Reset() {
+	*x = ScheduleConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[53]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardConf_Reward) String() string {
+func (x *ScheduleConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardConf_Reward) ProtoMessage() {}
+func (*ScheduleConf) ProtoMessage() {}
 
-func (x *RewardConf_Reward) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[53]
+func (x *ScheduleConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2375,51 +2354,43 @@ func (x *RewardConf_Reward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardConf_Reward.ProtoReflect.Descriptor instead.
-func (*RewardConf_Reward) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{7, 1}
-}
-
-func (x *RewardConf_Reward) GetRewardId() uint32 {
-	if x != nil {
-		return x.RewardId
-	}
-	return 0
+// Deprecated: Use ScheduleConf.ProtoReflect.Descriptor instead.
+func (*ScheduleConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *RewardConf_Reward) GetItemMap() map[uint32]*Item {
+func (x *ScheduleConf) GetEventMap() map[uint32]*ScheduleConf_Event {
 	if x != nil {
-		return x.ItemMap
+		return x.EventMap
 	}
 	return nil
 }
 
-type PatchMergeConf_Time struct {
+type TextConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	Expiry *durationpb.Duration   `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	ItemMap map[uint32]*TextConf_Item `protobuf:"bytes,1,rep,name=item_map,json=itemMap,proto3" json:"item_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *PatchMergeConf_Time) Reset() {
-	*x = PatchMergeConf_Time{}
+func (x *TextConf) Reset() {
+	*x = TextConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[55]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PatchMergeConf_Time) String() string {
+func (x *TextConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchMergeConf_Time) ProtoMessage() {}
+func (*TextConf) ProtoMessage() {}
 
-func (x *PatchMergeConf_Time) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[55]
+func (x *TextConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,51 +2401,43 @@ func (x *PatchMergeConf_Time) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchMergeConf_Time.ProtoReflect.Descriptor instead.
-func (*PatchMergeConf_Time) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{10, 0}
-}
-
-func (x *PatchMergeConf_Time) GetStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Start
-	}
-	return nil
+// Deprecated: Use TextConf.ProtoReflect.Descriptor instead.
+func (*TextConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *PatchMergeConf_Time) GetExpiry() *durationpb.Duration {
+func (x *TextConf) GetItemMap() map[uint32]*TextConf_Item {
 	if x != nil {
-		return x.Expiry
+		return x.ItemMap
 	}
 	return nil
 }
 
-type RecursivePatchConf_Shop struct {
+type StringFormatConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShopId   uint32                                    `protobuf:"varint,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
-	GoodsMap map[uint32]*RecursivePatchConf_Shop_Goods `protobuf:"bytes,2,rep,name=goods_map,json=goodsMap,proto3" json:"goods_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FilterMap map[uint32]*StringFormatConf_Filter `protobuf:"bytes,1,rep,name=filter_map,json=filterMap,proto3" json:"filter_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RecursivePatchConf_Shop) Reset() {
-	*x = RecursivePatchConf_Shop{}
+func (x *StringFormatConf) Reset() {
+	*x = StringFormatConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[59]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecursivePatchConf_Shop) String() string {
+func (x *StringFormatConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecursivePatchConf_Shop) ProtoMessage() {}
+func (*StringFormatConf) ProtoMessage() {}
 
-func (x *RecursivePatchConf_Shop) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[59]
+func (x *StringFormatConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,54 +2448,43 @@ func (x *RecursivePatchConf_Shop) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecursivePatchConf_Shop.ProtoReflect.Descriptor instead.
-func (*RecursivePatchConf_Shop) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{11, 1}
-}
-
-func (x *RecursivePatchConf_Shop) GetShopId() uint32 {
-	if x != nil {
-		return x.ShopId
-	}
-	return 0
+// Deprecated: Use StringFormatConf.ProtoReflect.Descriptor instead.
+func (*StringFormatConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RecursivePatchConf_Shop) GetGoodsMap() map[uint32]*RecursivePatchConf_Shop_Goods {
+func (x *StringFormatConf) GetFilterMap() map[uint32]*StringFormatConf_Filter {
 	if x != nil {
-		return x.GoodsMap
+		return x.FilterMap
 	}
 	return nil
 }
 
-type RecursivePatchConf_Shop_Goods struct {
+type ComputeConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GoodsId     uint32                                             `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
-	Desc        []byte                                             `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
-	CurrencyMap map[uint32]*RecursivePatchConf_Shop_Goods_Currency `protobuf:"bytes,3,rep,name=currency_map,json=currencyMap,proto3" json:"currency_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	TagList     [][]byte                                           `protobuf:"bytes,4,rep,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty"`
-	AwardList   []*RecursivePatchConf_Shop_Goods_Award             `protobuf:"bytes,5,rep,name=award_list,json=awardList,proto3" json:"award_list,omitempty"`
+	ItemMap map[uint32]*ComputeConf_Item `protobuf:"bytes,1,rep,name=item_map,json=itemMap,proto3" json:"item_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RecursivePatchConf_Shop_Goods) Reset() {
-	*x = RecursivePatchConf_Shop_Goods{}
+func (x *ComputeConf) Reset() {
+	*x = ComputeConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[61]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecursivePatchConf_Shop_Goods) String() string {
+func (x *ComputeConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecursivePatchConf_Shop_Goods) ProtoMessage() {}
+func (*ComputeConf) ProtoMessage() {}
 
-func (x *RecursivePatchConf_Shop_Goods) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[61]
+func (x *ComputeConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,74 +2495,43 @@ func (x *RecursivePatchConf_Shop_Goods) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecursivePatchConf_Shop_Goods.ProtoReflect.Descriptor instead.
-func (*RecursivePatchConf_Shop_Goods) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{11, 1, 1}
-}
-
-func (x *RecursivePatchConf_Shop_Goods) GetGoodsId() uint32 {
-	if x != nil {
-		return x.GoodsId
-	}
-	return 0
-}
-
-func (x *RecursivePatchConf_Shop_Goods) GetDesc() []byte {
-	if x != nil {
-		return x.Desc
-	}
-	return nil
-}
-
-func (x *RecursivePatchConf_Shop_Goods) GetCurrencyMap() map[uint32]*RecursivePatchConf_Shop_Goods_Currency {
-	if x != nil {
-		return x.CurrencyMap
-	}
-	return nil
-}
-
-func (x *RecursivePatchConf_Shop_Goods) GetTagList() [][]byte {
-	if x != nil {
-		return x.TagList
-	}
-	return nil
+// Deprecated: Use ComputeConf.ProtoReflect.Descriptor instead.
+func (*ComputeConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RecursivePatchConf_Shop_Goods) GetAwardList() []*RecursivePatchConf_Shop_Goods_Award {
+func (x *ComputeConf) GetItemMap() map[uint32]*ComputeConf_Item {
 	if x != nil {
-		return x.AwardList
+		return x.ItemMap
 	}
 	return nil
 }
 
-type RecursivePatchConf_Shop_Goods_Currency struct {
+type UniqueDomainMapConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type        uint32           `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	PriceList   []int32          `protobuf:"varint,2,rep,packed,name=price_list,json=priceList,proto3" json:"price_list,omitempty"`
-	ValueList   map[int32]int32  `protobuf:"bytes,3,rep,name=value_list,json=valueList,proto3" json:"value_list,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	MessageList map[int32][]byte `protobuf:"bytes,4,rep,name=message_list,json=messageList,proto3" json:"message_list,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RewardMap map[uint32]*UniqueDomainMapConf_Reward `protobuf:"bytes,1,rep,name=reward_map,json=rewardMap,proto3" json:"reward_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RecursivePatchConf_Shop_Goods_Currency) Reset() {
-	*x = RecursivePatchConf_Shop_Goods_Currency{}
+func (x *UniqueDomainMapConf) Reset() {
+	*x = UniqueDomainMapConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[63]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecursivePatchConf_Shop_Goods_Currency) String() string {
+func (x *UniqueDomainMapConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecursivePatchConf_Shop_Goods_Currency) ProtoMessage() {}
+func (*UniqueDomainMapConf) ProtoMessage() {}
 
-func (x *RecursivePatchConf_Shop_Goods_Currency) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[63]
+func (x *UniqueDomainMapConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2621,65 +2542,43 @@ func (x *RecursivePatchConf_Shop_Goods_Currency) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecursivePatchConf_Shop_Goods_Currency.ProtoReflect.Descriptor instead.
-func (*RecursivePatchConf_Shop_Goods_Currency) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{11, 1, 1, 1}
-}
-
-func (x *RecursivePatchConf_Shop_Goods_Currency) GetType() uint32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
-}
-
-func (x *RecursivePatchConf_Shop_Goods_Currency) GetPriceList() []int32 {
-	if x != nil {
-		return x.PriceList
-	}
-	return nil
-}
-
-func (x *RecursivePatchConf_Shop_Goods_Currency) GetValueList() map[int32]int32 {
-	if x != nil {
-		return x.ValueList
-	}
-	return nil
+// Deprecated: Use UniqueDomainMapConf.ProtoReflect.Descriptor instead.
+func (*UniqueDomainMapConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *RecursivePatchConf_Shop_Goods_Currency) GetMessageList() map[int32][]byte {
+func (x *UniqueDomainMapConf) GetRewardMap() map[uint32]*UniqueDomainMapConf_Reward {
 	if x != nil {
-		return x.MessageList
+		return x.RewardMap
 	}
 	return nil
 }
 
-type RecursivePatchConf_Shop_Goods_Award struct {
+type UniqueDomainListConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id  uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Num int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+	RewardList []*UniqueDomainListConf_Reward `protobuf:"bytes,1,rep,name=reward_list,json=rewardList,proto3" json:"reward_list,omitempty"`
 }
 
-func (x *RecursivePatchConf_Shop_Goods_Award) Reset() {
-	*x = RecursivePatchConf_Shop_Goods_Award{}
+func (x *UniqueDomainListConf) Reset() {
+	*x = UniqueDomainListConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[64]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecursivePatchConf_Shop_Goods_Award) String() string {
+func (x *UniqueDomainListConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecursivePatchConf_Shop_Goods_Award) ProtoMessage() {}
+func (*UniqueDomainListConf) ProtoMessage() {}
 
-func (x *RecursivePatchConf_Shop_Goods_Award) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[64]
+func (x *UniqueDomainListConf) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2690,52 +2589,44 @@ func (x *RecursivePatchConf_Shop_Goods_Award) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecursivePatchConf_Shop_Goods_Award.ProtoReflect.Descriptor instead.
-func (*RecursivePatchConf_Shop_Goods_Award) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{11, 1, 1, 2}
-}
-
-func (x *RecursivePatchConf_Shop_Goods_Award) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use UniqueDomainListConf.ProtoReflect.Descriptor instead.
+func (*UniqueDomainListConf) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RecursivePatchConf_Shop_Goods_Award) GetNum() int32 {
+func (x *UniqueDomainListConf) GetRewardList() []*UniqueDomainListConf_Reward {
 	if x != nil {
-		return x.Num
+		return x.RewardList
 	}
-	return 0
+	return nil
 }
 
-type UniqueFieldInVerticalStructList_Item struct {
+type IncellMap_Fruit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Desc string `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Key   FruitType `protobuf:"varint,1,opt,name=key,proto3,enum=unittest.FruitType" json:"key,omitempty"`
+	Value int64     `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *UniqueFieldInVerticalStructList_Item) Reset() {
-	*x = UniqueFieldInVerticalStructList_Item{}
+func (x *IncellMap_Fruit) Reset() {
+	*x = IncellMap_Fruit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[68]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UniqueFieldInVerticalStructList_Item) String() string {
+func (x *IncellMap_Fruit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UniqueFieldInVerticalStructList_Item) ProtoMessage() {}
+func (*IncellMap_Fruit) ProtoMessage() {}
 
-func (x *UniqueFieldInVerticalStructList_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[68]
+func (x *IncellMap_Fruit) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2746,60 +2637,51 @@ func (x *UniqueFieldInVerticalStructList_Item) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UniqueFieldInVerticalStructList_Item.ProtoReflect.Descriptor instead.
-func (*UniqueFieldInVerticalStructList_Item) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *UniqueFieldInVerticalStructList_Item) GetId() uint32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use IncellMap_Fruit.ProtoReflect.Descriptor instead.
+func (*IncellMap_Fruit) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *UniqueFieldInVerticalStructList_Item) GetName() string {
+func (x *IncellMap_Fruit) GetKey() FruitType {
 	if x != nil {
-		return x.Name
+		return x.Key
 	}
-	return ""
+	return FruitType_FRUIT_TYPE_UNKNOWN
 }
 
-func (x *UniqueFieldInVerticalStructList_Item) GetDesc() string {
+func (x *IncellMap_Fruit) GetValue() int64 {
 	if x != nil {
-		return x.Desc
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-type VerticalUniqueFieldStructMap_Main struct {
+type IncellMap_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MainId    uint32                                            `protobuf:"varint,1,opt,name=main_id,json=mainId,proto3" json:"main_id,omitempty"`
-	MainName  string                                            `protobuf:"bytes,2,opt,name=main_name,json=mainName,proto3" json:"main_name,omitempty"`
-	MainKvMap map[int32]int32                                   `protobuf:"bytes,3,rep,name=main_kv_map,json=mainKvMap,proto3" json:"main_kv_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	SubMap    map[uint32]*VerticalUniqueFieldStructMap_Main_Sub `protobuf:"bytes,4,rep,name=sub_map,json=subMap,proto3" json:"sub_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Key   FruitType   `protobuf:"varint,1,opt,name=key,proto3,enum=unittest.FruitType" json:"key,omitempty"`
+	Value FruitFlavor `protobuf:"varint,2,opt,name=value,proto3,enum=unittest.FruitFlavor" json:"value,omitempty"`
 }
 
-func (x *VerticalUniqueFieldStructMap_Main) Reset() {
-	*x = VerticalUniqueFieldStructMap_Main{}
+func (x *IncellMap_Item) Reset() {
+	*x = IncellMap_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[70]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerticalUniqueFieldStructMap_Main) String() string {
+func (x *IncellMap_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerticalUniqueFieldStructMap_Main) ProtoMessage() {}
+func (*IncellMap_Item) ProtoMessage() {}
 
-func (x *VerticalUniqueFieldStructMap_Main) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[70]
+func (x *IncellMap_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2810,65 +2692,51 @@ func (x *VerticalUniqueFieldStructMap_Main) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerticalUniqueFieldStructMap_Main.ProtoReflect.Descriptor instead.
-func (*VerticalUniqueFieldStructMap_Main) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{14, 1}
-}
-
-func (x *VerticalUniqueFieldStructMap_Main) GetMainId() uint32 {
-	if x != nil {
-		return x.MainId
-	}
-	return 0
-}
-
-func (x *VerticalUniqueFieldStructMap_Main) GetMainName() string {
-	if x != nil {
-		return x.MainName
-	}
-	return ""
+// Deprecated: Use IncellMap_Item.ProtoReflect.Descriptor instead.
+func (*IncellMap_Item) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{1, 4}
 }
 
-func (x *VerticalUniqueFieldStructMap_Main) GetMainKvMap() map[int32]int32 {
+func (x *IncellMap_Item) GetKey() FruitType {
 	if x != nil {
-		return x.MainKvMap
+		return x.Key
 	}
-	return nil
+	return FruitType_FRUIT_TYPE_UNKNOWN
 }
 
-func (x *VerticalUniqueFieldStructMap_Main) GetSubMap() map[uint32]*VerticalUniqueFieldStructMap_Main_Sub {
+func (x *IncellMap_Item) GetValue() FruitFlavor {
 	if x != nil {
-		return x.SubMap
+		return x.Value
 	}
-	return nil
+	return FruitFlavor_FRUIT_FLAVOR_UNKNOWN
 }
 
-type VerticalUniqueFieldStructMap_Main_Sub struct {
+type MallConf_Shop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubId   uint32 `protobuf:"varint,1,opt,name=sub_id,json=subId,proto3" json:"sub_id,omitempty"`
-	SubName string `protobuf:"bytes,2,opt,name=sub_name,json=subName,proto3" json:"sub_name,omitempty"`
+	ShopId   uint32                          `protobuf:"varint,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	GoodsMap map[uint32]*MallConf_Shop_Goods `protobuf:"bytes,2,rep,name=goods_map,json=goodsMap,proto3" json:"goods_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *VerticalUniqueFieldStructMap_Main_Sub) Reset() {
-	*x = VerticalUniqueFieldStructMap_Main_Sub{}
+func (x *MallConf_Shop) Reset() {
+	*x = MallConf_Shop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[73]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerticalUniqueFieldStructMap_Main_Sub) String() string {
+func (x *MallConf_Shop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerticalUniqueFieldStructMap_Main_Sub) ProtoMessage() {}
+func (*MallConf_Shop) ProtoMessage() {}
 
-func (x *VerticalUniqueFieldStructMap_Main_Sub) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[73]
+func (x *MallConf_Shop) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2879,52 +2747,51 @@ func (x *VerticalUniqueFieldStructMap_Main_Sub) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerticalUniqueFieldStructMap_Main_Sub.ProtoReflect.Descriptor instead.
-func (*VerticalUniqueFieldStructMap_Main_Sub) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{14, 1, 2}
+// Deprecated: Use MallConf_Shop.ProtoReflect.Descriptor instead.
+func (*MallConf_Shop) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (x *VerticalUniqueFieldStructMap_Main_Sub) GetSubId() uint32 {
+func (x *MallConf_Shop) GetShopId() uint32 {
 	if x != nil {
-		return x.SubId
+		return x.ShopId
 	}
 	return 0
 }
 
-func (x *VerticalUniqueFieldStructMap_Main_Sub) GetSubName() string {
+func (x *MallConf_Shop) GetGoodsMap() map[uint32]*MallConf_Shop_Goods {
 	if x != nil {
-		return x.SubName
+		return x.GoodsMap
 	}
-	return ""
+	return nil
 }
 
-type DocumentUniqueFieldStructList_Item struct {
+type MallConf_Shop_Goods struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Num  int32  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
+	GoodsId uint32 `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	Price   int32  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (x *DocumentUniqueFieldStructList_Item) Reset() {
-	*x = DocumentUniqueFieldStructList_Item{}
+func (x *MallConf_Shop_Goods) Reset() {
+	*x = MallConf_Shop_Goods{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[74]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentUniqueFieldStructList_Item) String() string {
+func (x *MallConf_Shop_Goods) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentUniqueFieldStructList_Item) ProtoMessage() {}
+func (*MallConf_Shop_Goods) ProtoMessage() {}
 
-func (x *DocumentUniqueFieldStructList_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[74]
+func (x *MallConf_Shop_Goods) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2935,59 +2802,52 @@ func (x *DocumentUniqueFieldStructList_Item) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentUniqueFieldStructList_Item.ProtoReflect.Descriptor instead.
-func (*DocumentUniqueFieldStructList_Item) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use MallConf_Shop_Goods.ProtoReflect.Descriptor instead.
+func (*MallConf_Shop_Goods) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{5, 1, 1}
 }
 
-func (x *DocumentUniqueFieldStructList_Item) GetId() uint32 {
+func (x *MallConf_Shop_Goods) GetGoodsId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.GoodsId
 	}
 	return 0
 }
 
-func (x *DocumentUniqueFieldStructList_Item) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *DocumentUniqueFieldStructList_Item) GetNum() int32 {
+func (x *MallConf_Shop_Goods) GetPrice() int32 {
 	if x != nil {
-		return x.Num
+		return x.Price
 	}
 	return 0
 }
 
-type DocumentUniqueFieldStructMap_Chapter struct {
+type ActivityConf_Activity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key     string                                                   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name    string                                                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Section map[string]*DocumentUniqueFieldStructMap_Chapter_Section `protobuf:"bytes,3,rep,name=section,proto3" json:"section,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ActivityId   uint32                                    `protobuf:"varint,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	ActivityName string                                    `protobuf:"bytes,2,opt,name=activity_name,json=activityName,proto3" json:"activity_name,omitempty"`
+	ChapterMap   map[uint32]*ActivityConf_Activity_Chapter `protobuf:"bytes,3,rep,name=chapter_map,json=chapterMap,proto3" json:"chapter_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter) Reset() {
-	*x = DocumentUniqueFieldStructMap_Chapter{}
+func (x *ActivityConf_Activity) Reset() {
+	*x = ActivityConf_Activity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[76]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter) String() string {
+func (x *ActivityConf_Activity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentUniqueFieldStructMap_Chapter) ProtoMessage() {}
+func (*ActivityConf_Activity) ProtoMessage() {}
 
-func (x *DocumentUniqueFieldStructMap_Chapter) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[76]
+func (x *ActivityConf_Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2998,59 +2858,59 @@ func (x *DocumentUniqueFieldStructMap_Chapter) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentUniqueFieldStructMap_Chapter.ProtoReflect.Descriptor instead.
-func (*DocumentUniqueFieldStructMap_Chapter) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 1}
+// Deprecated: Use ActivityConf_Activity.ProtoReflect.Descriptor instead.
+func (*ActivityConf_Activity) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{6, 1}
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter) GetKey() string {
+func (x *ActivityConf_Activity) GetActivityId() uint32 {
 	if x != nil {
-		return x.Key
+		return x.ActivityId
 	}
-	return ""
+	return 0
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter) GetName() string {
+func (x *ActivityConf_Activity) GetActivityName() string {
 	if x != nil {
-		return x.Name
+		return x.ActivityName
 	}
 	return ""
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter) GetSection() map[string]*DocumentUniqueFieldStructMap_Chapter_Section {
+func (x *ActivityConf_Activity) GetChapterMap() map[uint32]*ActivityConf_Activity_Chapter {
 	if x != nil {
-		return x.Section
+		return x.ChapterMap
 	}
 	return nil
 }
 
-type DocumentUniqueFieldStructMap_ChapterInfo struct {
+type ActivityConf_Activity_Chapter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key     string                                                       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name    string                                                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Section map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section `protobuf:"bytes,3,rep,name=section,proto3" json:"section,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ChapterId   uint32                                   `protobuf:"varint,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	ChapterName string                                   `protobuf:"bytes,2,opt,name=chapter_name,json=chapterName,proto3" json:"chapter_name,omitempty"`
+	SectionList []*ActivityConf_Activity_Chapter_Section `protobuf:"bytes,3,rep,name=section_list,json=sectionList,proto3" json:"section_list,omitempty"`
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo) Reset() {
-	*x = DocumentUniqueFieldStructMap_ChapterInfo{}
+func (x *ActivityConf_Activity_Chapter) Reset() {
+	*x = ActivityConf_Activity_Chapter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[80]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo) String() string {
+func (x *ActivityConf_Activity_Chapter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentUniqueFieldStructMap_ChapterInfo) ProtoMessage() {}
+func (*ActivityConf_Activity_Chapter) ProtoMessage() {}
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[80]
+func (x *ActivityConf_Activity_Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3061,58 +2921,59 @@ func (x *DocumentUniqueFieldStructMap_ChapterInfo) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentUniqueFieldStructMap_ChapterInfo.ProtoReflect.Descriptor instead.
-func (*DocumentUniqueFieldStructMap_ChapterInfo) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 5}
+// Deprecated: Use ActivityConf_Activity_Chapter.ProtoReflect.Descriptor instead.
+func (*ActivityConf_Activity_Chapter) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{6, 1, 1}
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo) GetKey() string {
+func (x *ActivityConf_Activity_Chapter) GetChapterId() uint32 {
 	if x != nil {
-		return x.Key
+		return x.ChapterId
 	}
-	return ""
+	return 0
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo) GetName() string {
+func (x *ActivityConf_Activity_Chapter) GetChapterName() string {
 	if x != nil {
-		return x.Name
+		return x.ChapterName
 	}
 	return ""
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo) GetSection() map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section {
+func (x *ActivityConf_Activity_Chapter) GetSectionList() []*ActivityConf_Activity_Chapter_Section {
 	if x != nil {
-		return x.Section
+		return x.SectionList
 	}
 	return nil
 }
 
-type DocumentUniqueFieldStructMap_Chapter_Section struct {
+type ActivityConf_Activity_Chapter_Section struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SectionId   uint32                                                   `protobuf:"varint,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	SectionName string                                                   `protobuf:"bytes,2,opt,name=section_name,json=sectionName,proto3" json:"section_name,omitempty"`
+	RewardMap   map[uint32]*ActivityConf_Activity_Chapter_Section_Reward `protobuf:"bytes,3,rep,name=reward_map,json=rewardMap,proto3" json:"reward_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter_Section) Reset() {
-	*x = DocumentUniqueFieldStructMap_Chapter_Section{}
+func (x *ActivityConf_Activity_Chapter_Section) Reset() {
+	*x = ActivityConf_Activity_Chapter_Section{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[82]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter_Section) String() string {
+func (x *ActivityConf_Activity_Chapter_Section) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentUniqueFieldStructMap_Chapter_Section) ProtoMessage() {}
+func (*ActivityConf_Activity_Chapter_Section) ProtoMessage() {}
 
-func (x *DocumentUniqueFieldStructMap_Chapter_Section) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[82]
+func (x *ActivityConf_Activity_Chapter_Section) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3123,52 +2984,58 @@ func (x *DocumentUniqueFieldStructMap_Chapter_Section) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentUniqueFieldStructMap_Chapter_Section.ProtoReflect.Descriptor instead.
-func (*DocumentUniqueFieldStructMap_Chapter_Section) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 1, 1}
+// Deprecated: Use ActivityConf_Activity_Chapter_Section.ProtoReflect.Descriptor instead.
+func (*ActivityConf_Activity_Chapter_Section) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{6, 1, 1, 0}
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter_Section) GetKey() string {
+func (x *ActivityConf_Activity_Chapter_Section) GetSectionId() uint32 {
 	if x != nil {
-		return x.Key
+		return x.SectionId
 	}
-	return ""
+	return 0
 }
 
-func (x *DocumentUniqueFieldStructMap_Chapter_Section) GetName() string {
+func (x *ActivityConf_Activity_Chapter_Section) GetSectionName() string {
 	if x != nil {
-		return x.Name
+		return x.SectionName
 	}
 	return ""
 }
 
-type DocumentUniqueFieldStructMap_ChapterInfo_Section struct {
+func (x *ActivityConf_Activity_Chapter_Section) GetRewardMap() map[uint32]*ActivityConf_Activity_Chapter_Section_Reward {
+	if x != nil {
+		return x.RewardMap
+	}
+	return nil
+}
+
+type ActivityConf_Activity_Chapter_Section_Reward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key     string                                                               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name    string                                                               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Section map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section `protobuf:"bytes,3,rep,name=section,proto3" json:"section,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id  uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Num int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) Reset() {
-	*x = DocumentUniqueFieldStructMap_ChapterInfo_Section{}
+func (x *ActivityConf_Activity_Chapter_Section_Reward) Reset() {
+	*x = ActivityConf_Activity_Chapter_Section_Reward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[84]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) String() string {
+func (x *ActivityConf_Activity_Chapter_Section_Reward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentUniqueFieldStructMap_ChapterInfo_Section) ProtoMessage() {}
+func (*ActivityConf_Activity_Chapter_Section_Reward) ProtoMessage() {}
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[84]
+func (x *ActivityConf_Activity_Chapter_Section_Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3179,59 +3046,51 @@ func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) ProtoReflect() protor
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentUniqueFieldStructMap_ChapterInfo_Section.ProtoReflect.Descriptor instead.
-func (*DocumentUniqueFieldStructMap_ChapterInfo_Section) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 5, 1}
-}
-
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use ActivityConf_Activity_Chapter_Section_Reward.ProtoReflect.Descriptor instead.
+func (*ActivityConf_Activity_Chapter_Section_Reward) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{6, 1, 1, 0, 1}
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) GetName() string {
+func (x *ActivityConf_Activity_Chapter_Section_Reward) GetId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) GetSection() map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section {
+func (x *ActivityConf_Activity_Chapter_Section_Reward) GetNum() int32 {
 	if x != nil {
-		return x.Section
+		return x.Num
 	}
-	return nil
+	return 0
 }
 
-type DocumentUniqueFieldStructMap_ChapterInfo_Section_Section struct {
+type RewardConf_Reward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key     string                                                                       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name    string                                                                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Section map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section `protobuf:"bytes,3,rep,name=section,proto3" json:"section,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RewardId uint32           `protobuf:"varint,1,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	ItemMap  map[uint32]*Item `protobuf:"bytes,2,rep,name=item_map,json=itemMap,proto3" json:"item_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) Reset() {
-	*x = DocumentUniqueFieldStructMap_ChapterInfo_Section_Section{}
+func (x *RewardConf_Reward) Reset() {
+	*x = RewardConf_Reward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[86]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) String() string {
+func (x *RewardConf_Reward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) ProtoMessage() {}
+func (*RewardConf_Reward) ProtoMessage() {}
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[86]
+func (x *RewardConf_Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3242,58 +3101,51 @@ func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) ProtoReflect(
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentUniqueFieldStructMap_ChapterInfo_Section_Section.ProtoReflect.Descriptor instead.
-func (*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 5, 1, 1}
+// Deprecated: Use RewardConf_Reward.ProtoReflect.Descriptor instead.
+func (*RewardConf_Reward) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{7, 1}
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) GetKey() string {
+func (x *RewardConf_Reward) GetRewardId() uint32 {
 	if x != nil {
-		return x.Key
+		return x.RewardId
 	}
-	return ""
+	return 0
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) GetName() string {
+func (x *RewardConf_Reward) GetItemMap() map[uint32]*Item {
 	if x != nil {
-		return x.Name
+		return x.ItemMap
 	}
-	return ""
+	return nil
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) GetSection() map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section {
-	if x != nil {
-		return x.Section
-	}
-	return nil
-}
-
-type DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section struct {
+type PatchMergeConf_Time struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Start  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Expiry *durationpb.Duration   `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) Reset() {
-	*x = DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section{}
+func (x *PatchMergeConf_Time) Reset() {
+	*x = PatchMergeConf_Time{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[88]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) String() string {
+func (x *PatchMergeConf_Time) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) ProtoMessage() {}
+func (*PatchMergeConf_Time) ProtoMessage() {}
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[88]
+func (x *PatchMergeConf_Time) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3304,52 +3156,51 @@ func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) Proto
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section.ProtoReflect.Descriptor instead.
-func (*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 5, 1, 1, 1}
+// Deprecated: Use PatchMergeConf_Time.ProtoReflect.Descriptor instead.
+func (*PatchMergeConf_Time) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{10, 0}
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) GetKey() string {
+func (x *PatchMergeConf_Time) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Key
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) GetName() string {
+func (x *PatchMergeConf_Time) GetExpiry() *durationpb.Duration {
 	if x != nil {
-		return x.Name
+		return x.Expiry
 	}
-	return ""
+	return nil
 }
 
-type SequenceFieldInVerticalStructList_Item struct {
+type RecursivePatchConf_Shop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Num  int64  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
+	ShopId   uint32                                    `protobuf:"varint,1,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
+	GoodsMap map[uint32]*RecursivePatchConf_Shop_Goods `protobuf:"bytes,2,rep,name=goods_map,json=goodsMap,proto3" json:"goods_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SequenceFieldInVerticalStructList_Item) Reset() {
-	*x = SequenceFieldInVerticalStructList_Item{}
+func (x *RecursivePatchConf_Shop) Reset() {
+	*x = RecursivePatchConf_Shop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[89]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SequenceFieldInVerticalStructList_Item) String() string {
+func (x *RecursivePatchConf_Shop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SequenceFieldInVerticalStructList_Item) ProtoMessage() {}
+func (*RecursivePatchConf_Shop) ProtoMessage() {}
 
-func (x *SequenceFieldInVerticalStructList_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[89]
+func (x *RecursivePatchConf_Shop) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3360,58 +3211,54 @@ func (x *SequenceFieldInVerticalStructList_Item) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SequenceFieldInVerticalStructList_Item.ProtoReflect.Descriptor instead.
-func (*SequenceFieldInVerticalStructList_Item) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use RecursivePatchConf_Shop.ProtoReflect.Descriptor instead.
+func (*RecursivePatchConf_Shop) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{11, 1}
 }
 
-func (x *SequenceFieldInVerticalStructList_Item) GetId() uint32 {
+func (x *RecursivePatchConf_Shop) GetShopId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.ShopId
 	}
 	return 0
 }
 
-func (x *SequenceFieldInVerticalStructList_Item) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SequenceFieldInVerticalStructList_Item) GetNum() int64 {
+func (x *RecursivePatchConf_Shop) GetGoodsMap() map[uint32]*RecursivePatchConf_Shop_Goods {
 	if x != nil {
-		return x.Num
+		return x.GoodsMap
 	}
-	return 0
+	return nil
 }
 
-type SequenceKeyInVerticalKeyedList_Item struct {
+type RecursivePatchConf_Shop_Goods struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      uint32                                              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PropMap map[int32]*SequenceKeyInVerticalKeyedList_Item_Prop `protobuf:"bytes,2,rep,name=prop_map,json=propMap,proto3" json:"prop_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GoodsId     uint32                                             `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	Desc        []byte                                             `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	CurrencyMap map[uint32]*RecursivePatchConf_Shop_Goods_Currency `protobuf:"bytes,3,rep,name=currency_map,json=currencyMap,proto3" json:"currency_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TagList     [][]byte                                           `protobuf:"bytes,4,rep,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty"`
+	AwardList   []*RecursivePatchConf_Shop_Goods_Award             `protobuf:"bytes,5,rep,name=award_list,json=awardList,proto3" json:"award_list,omitempty"`
 }
 
-func (x *SequenceKeyInVerticalKeyedList_Item) Reset() {
-	*x = SequenceKeyInVerticalKeyedList_Item{}
+func (x *RecursivePatchConf_Shop_Goods) Reset() {
+	*x = RecursivePatchConf_Shop_Goods{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[90]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SequenceKeyInVerticalKeyedList_Item) String() string {
+func (x *RecursivePatchConf_Shop_Goods) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SequenceKeyInVerticalKeyedList_Item) ProtoMessage() {}
+func (*RecursivePatchConf_Shop_Goods) ProtoMessage() {}
 
-func (x *SequenceKeyInVerticalKeyedList_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[90]
+func (x *RecursivePatchConf_Shop_Goods) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3422,51 +3269,74 @@ func (x *SequenceKeyInVerticalKeyedList_Item) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SequenceKeyInVerticalKeyedList_Item.ProtoReflect.Descriptor instead.
-func (*SequenceKeyInVerticalKeyedList_Item) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use RecursivePatchConf_Shop_Goods.ProtoReflect.Descriptor instead.
+func (*RecursivePatchConf_Shop_Goods) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{11, 1, 1}
 }
 
-func (x *SequenceKeyInVerticalKeyedList_Item) GetId() uint32 {
+func (x *RecursivePatchConf_Shop_Goods) GetGoodsId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.GoodsId
 	}
 	return 0
 }
 
-func (x *SequenceKeyInVerticalKeyedList_Item) GetPropMap() map[int32]*SequenceKeyInVerticalKeyedList_Item_Prop {
+func (x *RecursivePatchConf_Shop_Goods) GetDesc() []byte {
 	if x != nil {
-		return x.PropMap
+		return x.Desc
 	}
 	return nil
 }
 
-type SequenceKeyInVerticalKeyedList_Item_Prop struct {
+func (x *RecursivePatchConf_Shop_Goods) GetCurrencyMap() map[uint32]*RecursivePatchConf_Shop_Goods_Currency {
+	if x != nil {
+		return x.CurrencyMap
+	}
+	return nil
+}
+
+func (x *RecursivePatchConf_Shop_Goods) GetTagList() [][]byte {
+	if x != nil {
+		return x.TagList
+	}
+	return nil
+}
+
+func (x *RecursivePatchConf_Shop_Goods) GetAwardList() []*RecursivePatchConf_Shop_Goods_Award {
+	if x != nil {
+		return x.AwardList
+	}
+	return nil
+}
+
+type RecursivePatchConf_Shop_Goods_Currency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PropId   int32  `protobuf:"varint,1,opt,name=prop_id,json=propId,proto3" json:"prop_id,omitempty"`
-	PropName string `protobuf:"bytes,2,opt,name=prop_name,json=propName,proto3" json:"prop_name,omitempty"`
+	Type        uint32           `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	PriceList   []int32          `protobuf:"varint,2,rep,packed,name=price_list,json=priceList,proto3" json:"price_list,omitempty"`
+	ValueList   map[int32]int32  `protobuf:"bytes,3,rep,name=value_list,json=valueList,proto3" json:"value_list,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	MessageList map[int32][]byte `protobuf:"bytes,4,rep,name=message_list,json=messageList,proto3" json:"message_list,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SequenceKeyInVerticalKeyedList_Item_Prop) Reset() {
-	*x = SequenceKeyInVerticalKeyedList_Item_Prop{}
+func (x *RecursivePatchConf_Shop_Goods_Currency) Reset() {
+	*x = RecursivePatchConf_Shop_Goods_Currency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[92]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SequenceKeyInVerticalKeyedList_Item_Prop) String() string {
+func (x *RecursivePatchConf_Shop_Goods_Currency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SequenceKeyInVerticalKeyedList_Item_Prop) ProtoMessage() {}
+func (*RecursivePatchConf_Shop_Goods_Currency) ProtoMessage() {}
 
-func (x *SequenceKeyInVerticalKeyedList_Item_Prop) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[92]
+func (x *RecursivePatchConf_Shop_Goods_Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3477,51 +3347,65 @@ func (x *SequenceKeyInVerticalKeyedList_Item_Prop) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SequenceKeyInVerticalKeyedList_Item_Prop.ProtoReflect.Descriptor instead.
-func (*SequenceKeyInVerticalKeyedList_Item_Prop) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{18, 0, 1}
+// Deprecated: Use RecursivePatchConf_Shop_Goods_Currency.ProtoReflect.Descriptor instead.
+func (*RecursivePatchConf_Shop_Goods_Currency) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{11, 1, 1, 1}
 }
 
-func (x *SequenceKeyInVerticalKeyedList_Item_Prop) GetPropId() int32 {
+func (x *RecursivePatchConf_Shop_Goods_Currency) GetType() uint32 {
 	if x != nil {
-		return x.PropId
+		return x.Type
 	}
 	return 0
 }
 
-func (x *SequenceKeyInVerticalKeyedList_Item_Prop) GetPropName() string {
+func (x *RecursivePatchConf_Shop_Goods_Currency) GetPriceList() []int32 {
 	if x != nil {
-		return x.PropName
+		return x.PriceList
 	}
-	return ""
+	return nil
 }
 
-type VerticalSequenceFieldStructMap_Main struct {
+func (x *RecursivePatchConf_Shop_Goods_Currency) GetValueList() map[int32]int32 {
+	if x != nil {
+		return x.ValueList
+	}
+	return nil
+}
+
+func (x *RecursivePatchConf_Shop_Goods_Currency) GetMessageList() map[int32][]byte {
+	if x != nil {
+		return x.MessageList
+	}
+	return nil
+}
+
+type RecursivePatchConf_Shop_Goods_Award struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MainId uint32                                              `protobuf:"varint,1,opt,name=main_id,json=mainId,proto3" json:"main_id,omitempty"`
-	SubMap map[uint32]*VerticalSequenceFieldStructMap_Main_Sub `protobuf:"bytes,2,rep,name=sub_map,json=subMap,proto3" json:"sub_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id  uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Num int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (x *VerticalSequenceFieldStructMap_Main) Reset() {
-	*x = VerticalSequenceFieldStructMap_Main{}
+func (x *RecursivePatchConf_Shop_Goods_Award) Reset() {
+	*x = RecursivePatchConf_Shop_Goods_Award{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[94]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerticalSequenceFieldStructMap_Main) String() string {
+func (x *RecursivePatchConf_Shop_Goods_Award) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerticalSequenceFieldStructMap_Main) ProtoMessage() {}
+func (*RecursivePatchConf_Shop_Goods_Award) ProtoMessage() {}
 
-func (x *VerticalSequenceFieldStructMap_Main) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[94]
+func (x *RecursivePatchConf_Shop_Goods_Award) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3532,51 +3416,52 @@ func (x *VerticalSequenceFieldStructMap_Main) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerticalSequenceFieldStructMap_Main.ProtoReflect.Descriptor instead.
-func (*VerticalSequenceFieldStructMap_Main) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{19, 1}
+// Deprecated: Use RecursivePatchConf_Shop_Goods_Award.ProtoReflect.Descriptor instead.
+func (*RecursivePatchConf_Shop_Goods_Award) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{11, 1, 1, 2}
 }
 
-func (x *VerticalSequenceFieldStructMap_Main) GetMainId() uint32 {
+func (x *RecursivePatchConf_Shop_Goods_Award) GetId() uint32 {
 	if x != nil {
-		return x.MainId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *VerticalSequenceFieldStructMap_Main) GetSubMap() map[uint32]*VerticalSequenceFieldStructMap_Main_Sub {
+func (x *RecursivePatchConf_Shop_Goods_Award) GetNum() int32 {
 	if x != nil {
-		return x.SubMap
+		return x.Num
 	}
-	return nil
+	return 0
 }
 
-type VerticalSequenceFieldStructMap_Main_Sub struct {
+type UniqueFieldInVerticalStructList_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubId   uint32 `protobuf:"varint,1,opt,name=sub_id,json=subId,proto3" json:"sub_id,omitempty"`
-	SubName string `protobuf:"bytes,2,opt,name=sub_name,json=subName,proto3" json:"sub_name,omitempty"`
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Desc string `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
 }
 
-func (x *VerticalSequenceFieldStructMap_Main_Sub) Reset() {
-	*x = VerticalSequenceFieldStructMap_Main_Sub{}
+func (x *UniqueFieldInVerticalStructList_Item) Reset() {
+	*x = UniqueFieldInVerticalStructList_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[96]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerticalSequenceFieldStructMap_Main_Sub) String() string {
+func (x *UniqueFieldInVerticalStructList_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerticalSequenceFieldStructMap_Main_Sub) ProtoMessage() {}
+func (*UniqueFieldInVerticalStructList_Item) ProtoMessage() {}
 
-func (x *VerticalSequenceFieldStructMap_Main_Sub) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[96]
+func (x *UniqueFieldInVerticalStructList_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3587,52 +3472,60 @@ func (x *VerticalSequenceFieldStructMap_Main_Sub) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerticalSequenceFieldStructMap_Main_Sub.ProtoReflect.Descriptor instead.
-func (*VerticalSequenceFieldStructMap_Main_Sub) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{19, 1, 1}
+// Deprecated: Use UniqueFieldInVerticalStructList_Item.ProtoReflect.Descriptor instead.
+func (*UniqueFieldInVerticalStructList_Item) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (x *VerticalSequenceFieldStructMap_Main_Sub) GetSubId() uint32 {
+func (x *UniqueFieldInVerticalStructList_Item) GetId() uint32 {
 	if x != nil {
-		return x.SubId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *VerticalSequenceFieldStructMap_Main_Sub) GetSubName() string {
+func (x *UniqueFieldInVerticalStructList_Item) GetName() string {
 	if x != nil {
-		return x.SubName
+		return x.Name
 	}
 	return ""
 }
 
-type DocumentSequenceFieldStructList_Item struct {
+func (x *UniqueFieldInVerticalStructList_Item) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type VerticalUniqueFieldStructMap_Main struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Num  int32  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
+	MainId    uint32                                            `protobuf:"varint,1,opt,name=main_id,json=mainId,proto3" json:"main_id,omitempty"`
+	MainName  string                                            `protobuf:"bytes,2,opt,name=main_name,json=mainName,proto3" json:"main_name,omitempty"`
+	MainKvMap map[int32]int32                                   `protobuf:"bytes,3,rep,name=main_kv_map,json=mainKvMap,proto3" json:"main_kv_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	SubMap    map[uint32]*VerticalUniqueFieldStructMap_Main_Sub `protobuf:"bytes,4,rep,name=sub_map,json=subMap,proto3" json:"sub_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DocumentSequenceFieldStructList_Item) Reset() {
-	*x = DocumentSequenceFieldStructList_Item{}
+func (x *VerticalUniqueFieldStructMap_Main) Reset() {
+	*x = VerticalUniqueFieldStructMap_Main{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[97]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentSequenceFieldStructList_Item) String() string {
+func (x *VerticalUniqueFieldStructMap_Main) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentSequenceFieldStructList_Item) ProtoMessage() {}
+func (*VerticalUniqueFieldStructMap_Main) ProtoMessage() {}
 
-func (x *DocumentSequenceFieldStructList_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[97]
+func (x *VerticalUniqueFieldStructMap_Main) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3643,58 +3536,65 @@ func (x *DocumentSequenceFieldStructList_Item) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentSequenceFieldStructList_Item.ProtoReflect.Descriptor instead.
-func (*DocumentSequenceFieldStructList_Item) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use VerticalUniqueFieldStructMap_Main.ProtoReflect.Descriptor instead.
+func (*VerticalUniqueFieldStructMap_Main) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{14, 1}
 }
 
-func (x *DocumentSequenceFieldStructList_Item) GetId() uint32 {
+func (x *VerticalUniqueFieldStructMap_Main) GetMainId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.MainId
 	}
 	return 0
 }
 
-func (x *DocumentSequenceFieldStructList_Item) GetName() string {
+func (x *VerticalUniqueFieldStructMap_Main) GetMainName() string {
 	if x != nil {
-		return x.Name
+		return x.MainName
 	}
 	return ""
 }
 
-func (x *DocumentSequenceFieldStructList_Item) GetNum() int32 {
+func (x *VerticalUniqueFieldStructMap_Main) GetMainKvMap() map[int32]int32 {
 	if x != nil {
-		return x.Num
+		return x.MainKvMap
 	}
-	return 0
+	return nil
 }
 
-type Transpose_Hero struct {
+func (x *VerticalUniqueFieldStructMap_Main) GetSubMap() map[uint32]*VerticalUniqueFieldStructMap_Main_Sub {
+	if x != nil {
+		return x.SubMap
+	}
+	return nil
+}
+
+type VerticalUniqueFieldStructMap_Main_Sub struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SubId   uint32 `protobuf:"varint,1,opt,name=sub_id,json=subId,proto3" json:"sub_id,omitempty"`
+	SubName string `protobuf:"bytes,2,opt,name=sub_name,json=subName,proto3" json:"sub_name,omitempty"`
 }
 
-func (x *Transpose_Hero) Reset() {
-	*x = Transpose_Hero{}
+func (x *VerticalUniqueFieldStructMap_Main_Sub) Reset() {
+	*x = VerticalUniqueFieldStructMap_Main_Sub{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[99]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Transpose_Hero) String() string {
+func (x *VerticalUniqueFieldStructMap_Main_Sub) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transpose_Hero) ProtoMessage() {}
+func (*VerticalUniqueFieldStructMap_Main_Sub) ProtoMessage() {}
 
-func (x *Transpose_Hero) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[99]
+func (x *VerticalUniqueFieldStructMap_Main_Sub) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3705,51 +3605,52 @@ func (x *Transpose_Hero) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transpose_Hero.ProtoReflect.Descriptor instead.
-func (*Transpose_Hero) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{21, 1}
+// Deprecated: Use VerticalUniqueFieldStructMap_Main_Sub.ProtoReflect.Descriptor instead.
+func (*VerticalUniqueFieldStructMap_Main_Sub) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{14, 1, 2}
 }
 
-func (x *Transpose_Hero) GetId() int32 {
+func (x *VerticalUniqueFieldStructMap_Main_Sub) GetSubId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.SubId
 	}
 	return 0
 }
 
-func (x *Transpose_Hero) GetName() string {
+func (x *VerticalUniqueFieldStructMap_Main_Sub) GetSubName() string {
 	if x != nil {
-		return x.Name
+		return x.SubName
 	}
 	return ""
 }
 
-type TaskConf_Task struct {
+type DocumentUniqueFieldStructList_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Target *Target `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Num  int32  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (x *TaskConf_Task) Reset() {
-	*x = TaskConf_Task{}
+func (x *DocumentUniqueFieldStructList_Item) Reset() {
+	*x = DocumentUniqueFieldStructList_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[102]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskConf_Task) String() string {
+func (x *DocumentUniqueFieldStructList_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskConf_Task) ProtoMessage() {}
+func (*DocumentUniqueFieldStructList_Item) ProtoMessage() {}
 
-func (x *TaskConf_Task) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[102]
+func (x *DocumentUniqueFieldStructList_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3760,55 +3661,59 @@ func (x *TaskConf_Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskConf_Task.ProtoReflect.Descriptor instead.
-func (*TaskConf_Task) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{23, 1}
+// Deprecated: Use DocumentUniqueFieldStructList_Item.ProtoReflect.Descriptor instead.
+func (*DocumentUniqueFieldStructList_Item) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *TaskConf_Task) GetId() int32 {
+func (x *DocumentUniqueFieldStructList_Item) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *TaskConf_Task) GetTarget() *Target {
+func (x *DocumentUniqueFieldStructList_Item) GetName() string {
 	if x != nil {
-		return x.Target
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type FieldPresentMap_Player struct {
+func (x *DocumentUniqueFieldStructList_Item) GetNum() int32 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type DocumentUniqueFieldStructMap_Chapter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Weapon   *FieldPresentMap_Player_Weapon `protobuf:"bytes,2,opt,name=weapon,proto3" json:"weapon,omitempty"`
-	Info     *FieldPresentMap_Player_Info   `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	HeroList []int32                        `protobuf:"varint,4,rep,packed,name=hero_list,json=heroList,proto3" json:"hero_list,omitempty"`
-	AttrMap  map[string]int32               `protobuf:"bytes,5,rep,name=attr_map,json=attrMap,proto3" json:"attr_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Target   *Target                        `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	Key     string                                                   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name    string                                                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Section map[string]*DocumentUniqueFieldStructMap_Chapter_Section `protobuf:"bytes,3,rep,name=section,proto3" json:"section,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *FieldPresentMap_Player) Reset() {
-	*x = FieldPresentMap_Player{}
+func (x *DocumentUniqueFieldStructMap_Chapter) Reset() {
+	*x = DocumentUniqueFieldStructMap_Chapter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[104]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FieldPresentMap_Player) String() string {
+func (x *DocumentUniqueFieldStructMap_Chapter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldPresentMap_Player) ProtoMessage() {}
+func (*DocumentUniqueFieldStructMap_Chapter) ProtoMessage() {}
 
-func (x *FieldPresentMap_Player) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[104]
+func (x *DocumentUniqueFieldStructMap_Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3819,79 +3724,59 @@ func (x *FieldPresentMap_Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldPresentMap_Player.ProtoReflect.Descriptor instead.
-func (*FieldPresentMap_Player) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{24, 1}
-}
-
-func (x *FieldPresentMap_Player) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *FieldPresentMap_Player) GetWeapon() *FieldPresentMap_Player_Weapon {
-	if x != nil {
-		return x.Weapon
-	}
-	return nil
-}
-
-func (x *FieldPresentMap_Player) GetInfo() *FieldPresentMap_Player_Info {
-	if x != nil {
-		return x.Info
-	}
-	return nil
+// Deprecated: Use DocumentUniqueFieldStructMap_Chapter.ProtoReflect.Descriptor instead.
+func (*DocumentUniqueFieldStructMap_Chapter) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 1}
 }
 
-func (x *FieldPresentMap_Player) GetHeroList() []int32 {
+func (x *DocumentUniqueFieldStructMap_Chapter) GetKey() string {
 	if x != nil {
-		return x.HeroList
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *FieldPresentMap_Player) GetAttrMap() map[string]int32 {
+func (x *DocumentUniqueFieldStructMap_Chapter) GetName() string {
 	if x != nil {
-		return x.AttrMap
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *FieldPresentMap_Player) GetTarget() *Target {
+func (x *DocumentUniqueFieldStructMap_Chapter) GetSection() map[string]*DocumentUniqueFieldStructMap_Chapter_Section {
 	if x != nil {
-		return x.Target
+		return x.Section
 	}
 	return nil
 }
 
-type FieldPresentMap_Player_Weapon struct {
+type DocumentUniqueFieldStructMap_ChapterInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Rarity int32  `protobuf:"varint,2,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Key     string                                                       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name    string                                                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Section map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section `protobuf:"bytes,3,rep,name=section,proto3" json:"section,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *FieldPresentMap_Player_Weapon) Reset() {
-	*x = FieldPresentMap_Player_Weapon{}
+func (x *DocumentUniqueFieldStructMap_ChapterInfo) Reset() {
+	*x = DocumentUniqueFieldStructMap_ChapterInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[105]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FieldPresentMap_Player_Weapon) String() string {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldPresentMap_Player_Weapon) ProtoMessage() {}
+func (*DocumentUniqueFieldStructMap_ChapterInfo) ProtoMessage() {}
 
-func (x *FieldPresentMap_Player_Weapon) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[105]
+func (x *DocumentUniqueFieldStructMap_ChapterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3902,51 +3787,58 @@ func (x *FieldPresentMap_Player_Weapon) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldPresentMap_Player_Weapon.ProtoReflect.Descriptor instead.
-func (*FieldPresentMap_Player_Weapon) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{24, 1, 0}
+// Deprecated: Use DocumentUniqueFieldStructMap_ChapterInfo.ProtoReflect.Descriptor instead.
+func (*DocumentUniqueFieldStructMap_ChapterInfo) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 5}
 }
 
-func (x *FieldPresentMap_Player_Weapon) GetName() string {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DocumentUniqueFieldStructMap_ChapterInfo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *FieldPresentMap_Player_Weapon) GetRarity() int32 {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo) GetSection() map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section {
 	if x != nil {
-		return x.Rarity
+		return x.Section
 	}
-	return 0
+	return nil
 }
 
-type FieldPresentMap_Player_Info struct {
+type DocumentUniqueFieldStructMap_Chapter_Section struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
-	Exp   int32 `protobuf:"varint,2,opt,name=exp,proto3" json:"exp,omitempty"`
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *FieldPresentMap_Player_Info) Reset() {
-	*x = FieldPresentMap_Player_Info{}
+func (x *DocumentUniqueFieldStructMap_Chapter_Section) Reset() {
+	*x = DocumentUniqueFieldStructMap_Chapter_Section{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[106]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FieldPresentMap_Player_Info) String() string {
+func (x *DocumentUniqueFieldStructMap_Chapter_Section) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldPresentMap_Player_Info) ProtoMessage() {}
+func (*DocumentUniqueFieldStructMap_Chapter_Section) ProtoMessage() {}
 
-func (x *FieldPresentMap_Player_Info) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[106]
+func (x *DocumentUniqueFieldStructMap_Chapter_Section) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3957,51 +3849,52 @@ func (x *FieldPresentMap_Player_Info) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldPresentMap_Player_Info.ProtoReflect.Descriptor instead.
-func (*FieldPresentMap_Player_Info) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{24, 1, 1}
+// Deprecated: Use DocumentUniqueFieldStructMap_Chapter_Section.ProtoReflect.Descriptor instead.
+func (*DocumentUniqueFieldStructMap_Chapter_Section) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 1, 1}
 }
 
-func (x *FieldPresentMap_Player_Info) GetLevel() int32 {
+func (x *DocumentUniqueFieldStructMap_Chapter_Section) GetKey() string {
 	if x != nil {
-		return x.Level
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *FieldPresentMap_Player_Info) GetExp() int32 {
+func (x *DocumentUniqueFieldStructMap_Chapter_Section) GetName() string {
 	if x != nil {
-		return x.Exp
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type ScatterNoneConf_Zone struct {
+type DocumentUniqueFieldStructMap_ChapterInfo_Section struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key     string                                                               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name    string                                                               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Section map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section `protobuf:"bytes,3,rep,name=section,proto3" json:"section,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ScatterNoneConf_Zone) Reset() {
-	*x = ScatterNoneConf_Zone{}
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) Reset() {
+	*x = DocumentUniqueFieldStructMap_ChapterInfo_Section{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[109]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ScatterNoneConf_Zone) String() string {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScatterNoneConf_Zone) ProtoMessage() {}
+func (*DocumentUniqueFieldStructMap_ChapterInfo_Section) ProtoMessage() {}
 
-func (x *ScatterNoneConf_Zone) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[109]
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4012,51 +3905,59 @@ func (x *ScatterNoneConf_Zone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScatterNoneConf_Zone.ProtoReflect.Descriptor instead.
-func (*ScatterNoneConf_Zone) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{25, 1}
+// Deprecated: Use DocumentUniqueFieldStructMap_ChapterInfo_Section.ProtoReflect.Descriptor instead.
+func (*DocumentUniqueFieldStructMap_ChapterInfo_Section) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 5, 1}
 }
 
-func (x *ScatterNoneConf_Zone) GetId() uint32 {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) GetKey() string {
 	if x != nil {
-		return x.Id
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *ScatterNoneConf_Zone) GetName() string {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ScatterReplaceConf_Zone struct {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section) GetSection() map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+type DocumentUniqueFieldStructMap_ChapterInfo_Section_Section struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Key     string                                                                       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name    string                                                                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Section map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section `protobuf:"bytes,3,rep,name=section,proto3" json:"section,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ScatterReplaceConf_Zone) Reset() {
-	*x = ScatterReplaceConf_Zone{}
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) Reset() {
+	*x = DocumentUniqueFieldStructMap_ChapterInfo_Section_Section{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[111]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ScatterReplaceConf_Zone) String() string {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScatterReplaceConf_Zone) ProtoMessage() {}
+func (*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) ProtoMessage() {}
 
-func (x *ScatterReplaceConf_Zone) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[111]
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4067,51 +3968,58 @@ func (x *ScatterReplaceConf_Zone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScatterReplaceConf_Zone.ProtoReflect.Descriptor instead.
-func (*ScatterReplaceConf_Zone) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{26, 1}
+// Deprecated: Use DocumentUniqueFieldStructMap_ChapterInfo_Section_Section.ProtoReflect.Descriptor instead.
+func (*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 5, 1, 1}
 }
 
-func (x *ScatterReplaceConf_Zone) GetId() uint32 {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) GetKey() string {
 	if x != nil {
-		return x.Id
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *ScatterReplaceConf_Zone) GetName() string {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ScatterMergeConf_Zone struct {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section) GetSection() map[string]*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+type DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ScatterMergeConf_Zone) Reset() {
-	*x = ScatterMergeConf_Zone{}
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) Reset() {
+	*x = DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[113]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ScatterMergeConf_Zone) String() string {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScatterMergeConf_Zone) ProtoMessage() {}
+func (*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) ProtoMessage() {}
 
-func (x *ScatterMergeConf_Zone) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[113]
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4122,51 +4030,52 @@ func (x *ScatterMergeConf_Zone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScatterMergeConf_Zone.ProtoReflect.Descriptor instead.
-func (*ScatterMergeConf_Zone) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{27, 1}
+// Deprecated: Use DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section.ProtoReflect.Descriptor instead.
+func (*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{16, 5, 1, 1, 1}
 }
 
-func (x *ScatterMergeConf_Zone) GetId() uint32 {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) GetKey() string {
 	if x != nil {
-		return x.Id
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *ScatterMergeConf_Zone) GetName() string {
+func (x *DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type MergerSingleConf_Zone struct {
+type SequenceFieldInVerticalStructList_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Num  int64  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (x *MergerSingleConf_Zone) Reset() {
-	*x = MergerSingleConf_Zone{}
+func (x *SequenceFieldInVerticalStructList_Item) Reset() {
+	*x = SequenceFieldInVerticalStructList_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[115]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MergerSingleConf_Zone) String() string {
+func (x *SequenceFieldInVerticalStructList_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergerSingleConf_Zone) ProtoMessage() {}
+func (*SequenceFieldInVerticalStructList_Item) ProtoMessage() {}
 
-func (x *MergerSingleConf_Zone) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[115]
+func (x *SequenceFieldInVerticalStructList_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4177,51 +4086,58 @@ func (x *MergerSingleConf_Zone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergerSingleConf_Zone.ProtoReflect.Descriptor instead.
-func (*MergerSingleConf_Zone) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{28, 1}
+// Deprecated: Use SequenceFieldInVerticalStructList_Item.ProtoReflect.Descriptor instead.
+func (*SequenceFieldInVerticalStructList_Item) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{17, 0}
 }
 
-func (x *MergerSingleConf_Zone) GetId() uint32 {
+func (x *SequenceFieldInVerticalStructList_Item) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MergerSingleConf_Zone) GetName() string {
+func (x *SequenceFieldInVerticalStructList_Item) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type MergerMultiConf_Zone struct {
+func (x *SequenceFieldInVerticalStructList_Item) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type SequenceKeyInVerticalKeyedList_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id      uint32                                              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropMap map[int32]*SequenceKeyInVerticalKeyedList_Item_Prop `protobuf:"bytes,2,rep,name=prop_map,json=propMap,proto3" json:"prop_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *MergerMultiConf_Zone) Reset() {
-	*x = MergerMultiConf_Zone{}
+func (x *SequenceKeyInVerticalKeyedList_Item) Reset() {
+	*x = SequenceKeyInVerticalKeyedList_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[117]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MergerMultiConf_Zone) String() string {
+func (x *SequenceKeyInVerticalKeyedList_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergerMultiConf_Zone) ProtoMessage() {}
+func (*SequenceKeyInVerticalKeyedList_Item) ProtoMessage() {}
 
-func (x *MergerMultiConf_Zone) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[117]
+func (x *SequenceKeyInVerticalKeyedList_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4232,52 +4148,51 @@ func (x *MergerMultiConf_Zone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergerMultiConf_Zone.ProtoReflect.Descriptor instead.
-func (*MergerMultiConf_Zone) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{29, 1}
+// Deprecated: Use SequenceKeyInVerticalKeyedList_Item.ProtoReflect.Descriptor instead.
+func (*SequenceKeyInVerticalKeyedList_Item) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *MergerMultiConf_Zone) GetId() uint32 {
+func (x *SequenceKeyInVerticalKeyedList_Item) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MergerMultiConf_Zone) GetName() string {
+func (x *SequenceKeyInVerticalKeyedList_Item) GetPropMap() map[int32]*SequenceKeyInVerticalKeyedList_Item_Prop {
 	if x != nil {
-		return x.Name
+		return x.PropMap
 	}
-	return ""
+	return nil
 }
 
-type VerticalAggregationMap_Hero struct {
+type SequenceKeyInVerticalKeyedList_Item_Prop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HeroId   uint32                                       `protobuf:"varint,1,opt,name=hero_id,json=heroId,proto3" json:"hero_id,omitempty"`      // Hero ID
-	HeroName string                                       `protobuf:"bytes,2,opt,name=hero_name,json=heroName,proto3" json:"hero_name,omitempty"` // Hero name
-	LevelMap map[int32]*VerticalAggregationMap_Hero_Level `protobuf:"bytes,3,rep,name=level_map,json=levelMap,proto3" json:"level_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PropId   int32  `protobuf:"varint,1,opt,name=prop_id,json=propId,proto3" json:"prop_id,omitempty"`
+	PropName string `protobuf:"bytes,2,opt,name=prop_name,json=propName,proto3" json:"prop_name,omitempty"`
 }
 
-func (x *VerticalAggregationMap_Hero) Reset() {
-	*x = VerticalAggregationMap_Hero{}
+func (x *SequenceKeyInVerticalKeyedList_Item_Prop) Reset() {
+	*x = SequenceKeyInVerticalKeyedList_Item_Prop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[119]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerticalAggregationMap_Hero) String() string {
+func (x *SequenceKeyInVerticalKeyedList_Item_Prop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerticalAggregationMap_Hero) ProtoMessage() {}
+func (*SequenceKeyInVerticalKeyedList_Item_Prop) ProtoMessage() {}
 
-func (x *VerticalAggregationMap_Hero) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[119]
+func (x *SequenceKeyInVerticalKeyedList_Item_Prop) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4288,58 +4203,51 @@ func (x *VerticalAggregationMap_Hero) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerticalAggregationMap_Hero.ProtoReflect.Descriptor instead.
-func (*VerticalAggregationMap_Hero) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{30, 1}
+// Deprecated: Use SequenceKeyInVerticalKeyedList_Item_Prop.ProtoReflect.Descriptor instead.
+func (*SequenceKeyInVerticalKeyedList_Item_Prop) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{18, 0, 1}
 }
 
-func (x *VerticalAggregationMap_Hero) GetHeroId() uint32 {
+func (x *SequenceKeyInVerticalKeyedList_Item_Prop) GetPropId() int32 {
 	if x != nil {
-		return x.HeroId
+		return x.PropId
 	}
 	return 0
 }
 
-func (x *VerticalAggregationMap_Hero) GetHeroName() string {
+func (x *SequenceKeyInVerticalKeyedList_Item_Prop) GetPropName() string {
 	if x != nil {
-		return x.HeroName
+		return x.PropName
 	}
 	return ""
 }
 
-func (x *VerticalAggregationMap_Hero) GetLevelMap() map[int32]*VerticalAggregationMap_Hero_Level {
-	if x != nil {
-		return x.LevelMap
-	}
-	return nil
-}
-
-type VerticalAggregationMap_Hero_Level struct {
+type VerticalSequenceFieldStructMap_Main struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"` // Level
-	Exp   int32 `protobuf:"varint,2,opt,name=exp,proto3" json:"exp,omitempty"`     // Experience points
+	MainId uint32                                              `protobuf:"varint,1,opt,name=main_id,json=mainId,proto3" json:"main_id,omitempty"`
+	SubMap map[uint32]*VerticalSequenceFieldStructMap_Main_Sub `protobuf:"bytes,2,rep,name=sub_map,json=subMap,proto3" json:"sub_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *VerticalAggregationMap_Hero_Level) Reset() {
-	*x = VerticalAggregationMap_Hero_Level{}
+func (x *VerticalSequenceFieldStructMap_Main) Reset() {
+	*x = VerticalSequenceFieldStructMap_Main{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[121]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerticalAggregationMap_Hero_Level) String() string {
+func (x *VerticalSequenceFieldStructMap_Main) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerticalAggregationMap_Hero_Level) ProtoMessage() {}
+func (*VerticalSequenceFieldStructMap_Main) ProtoMessage() {}
 
-func (x *VerticalAggregationMap_Hero_Level) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[121]
+func (x *VerticalSequenceFieldStructMap_Main) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4350,51 +4258,107 @@ func (x *VerticalAggregationMap_Hero_Level) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerticalAggregationMap_Hero_Level.ProtoReflect.Descriptor instead.
-func (*VerticalAggregationMap_Hero_Level) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{30, 1, 1}
+// Deprecated: Use VerticalSequenceFieldStructMap_Main.ProtoReflect.Descriptor instead.
+func (*VerticalSequenceFieldStructMap_Main) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{19, 1}
 }
 
-func (x *VerticalAggregationMap_Hero_Level) GetLevel() int32 {
+func (x *VerticalSequenceFieldStructMap_Main) GetMainId() uint32 {
 	if x != nil {
-		return x.Level
+		return x.MainId
 	}
 	return 0
 }
 
-func (x *VerticalAggregationMap_Hero_Level) GetExp() int32 {
+func (x *VerticalSequenceFieldStructMap_Main) GetSubMap() map[uint32]*VerticalSequenceFieldStructMap_Main_Sub {
 	if x != nil {
-		return x.Exp
+		return x.SubMap
+	}
+	return nil
+}
+
+type VerticalSequenceFieldStructMap_Main_Sub struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubId   uint32 `protobuf:"varint,1,opt,name=sub_id,json=subId,proto3" json:"sub_id,omitempty"`
+	SubName string `protobuf:"bytes,2,opt,name=sub_name,json=subName,proto3" json:"sub_name,omitempty"`
+}
+
+func (x *VerticalSequenceFieldStructMap_Main_Sub) Reset() {
+	*x = VerticalSequenceFieldStructMap_Main_Sub{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerticalSequenceFieldStructMap_Main_Sub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerticalSequenceFieldStructMap_Main_Sub) ProtoMessage() {}
+
+func (x *VerticalSequenceFieldStructMap_Main_Sub) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerticalSequenceFieldStructMap_Main_Sub.ProtoReflect.Descriptor instead.
+func (*VerticalSequenceFieldStructMap_Main_Sub) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{19, 1, 1}
+}
+
+func (x *VerticalSequenceFieldStructMap_Main_Sub) GetSubId() uint32 {
+	if x != nil {
+		return x.SubId
 	}
 	return 0
 }
 
-type HorizontalAggregateMap_Hero struct {
+func (x *VerticalSequenceFieldStructMap_Main_Sub) GetSubName() string {
+	if x != nil {
+		return x.SubName
+	}
+	return ""
+}
+
+type DocumentSequenceFieldStructList_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HeroId  uint32           `protobuf:"varint,1,opt,name=hero_id,json=heroId,proto3" json:"hero_id,omitempty"`
-	ItemMap map[uint32]*Item `protobuf:"bytes,2,rep,name=item_map,json=itemMap,proto3" json:"item_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Num  int32  `protobuf:"varint,3,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (x *HorizontalAggregateMap_Hero) Reset() {
-	*x = HorizontalAggregateMap_Hero{}
+func (x *DocumentSequenceFieldStructList_Item) Reset() {
+	*x = DocumentSequenceFieldStructList_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[123]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HorizontalAggregateMap_Hero) String() string {
+func (x *DocumentSequenceFieldStructList_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HorizontalAggregateMap_Hero) ProtoMessage() {}
+func (*DocumentSequenceFieldStructList_Item) ProtoMessage() {}
 
-func (x *HorizontalAggregateMap_Hero) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[123]
+func (x *DocumentSequenceFieldStructList_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4405,51 +4369,172 @@ func (x *HorizontalAggregateMap_Hero) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HorizontalAggregateMap_Hero.ProtoReflect.Descriptor instead.
-func (*HorizontalAggregateMap_Hero) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{32, 1}
+// Deprecated: Use DocumentSequenceFieldStructList_Item.ProtoReflect.Descriptor instead.
+func (*DocumentSequenceFieldStructList_Item) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{20, 0}
 }
 
-func (x *HorizontalAggregateMap_Hero) GetHeroId() uint32 {
+func (x *DocumentSequenceFieldStructList_Item) GetId() uint32 {
 	if x != nil {
-		return x.HeroId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *HorizontalAggregateMap_Hero) GetItemMap() map[uint32]*Item {
+func (x *DocumentSequenceFieldStructList_Item) GetName() string {
 	if x != nil {
-		return x.ItemMap
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DocumentSequenceFieldStructList_Item) GetNum() int32 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type Transpose_Hero struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Transpose_Hero) Reset() {
+	*x = Transpose_Hero{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transpose_Hero) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transpose_Hero) ProtoMessage() {}
+
+func (x *Transpose_Hero) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transpose_Hero.ProtoReflect.Descriptor instead.
+func (*Transpose_Hero) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *Transpose_Hero) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transpose_Hero) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TaskConf_Task struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target *Target `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *TaskConf_Task) Reset() {
+	*x = TaskConf_Task{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskConf_Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskConf_Task) ProtoMessage() {}
+
+func (x *TaskConf_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskConf_Task.ProtoReflect.Descriptor instead.
+func (*TaskConf_Task) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *TaskConf_Task) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaskConf_Task) GetTarget() *Target {
+	if x != nil {
+		return x.Target
 	}
 	return nil
 }
 
-type HorizontalAggregateList_Hero struct {
+type FieldPresentMap_Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HeroId    uint32  `protobuf:"varint,1,opt,name=hero_id,json=heroId,proto3" json:"hero_id,omitempty"`
-	ParamList []*Item `protobuf:"bytes,2,rep,name=param_list,json=paramList,proto3" json:"param_list,omitempty"`
+	Id       int32                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Weapon   *FieldPresentMap_Player_Weapon `protobuf:"bytes,2,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Info     *FieldPresentMap_Player_Info   `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	HeroList []int32                        `protobuf:"varint,4,rep,packed,name=hero_list,json=heroList,proto3" json:"hero_list,omitempty"`
+	AttrMap  map[string]int32               `protobuf:"bytes,5,rep,name=attr_map,json=attrMap,proto3" json:"attr_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Target   *Target                        `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *HorizontalAggregateList_Hero) Reset() {
-	*x = HorizontalAggregateList_Hero{}
+func (x *FieldPresentMap_Player) Reset() {
+	*x = FieldPresentMap_Player{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[126]
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HorizontalAggregateList_Hero) String() string {
+func (x *FieldPresentMap_Player) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HorizontalAggregateList_Hero) ProtoMessage() {}
+func (*FieldPresentMap_Player) ProtoMessage() {}
 
-func (x *HorizontalAggregateList_Hero) ProtoReflect() protoreflect.Message {
-	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[126]
+func (x *FieldPresentMap_Player) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4460,1406 +4545,3642 @@ func (x *HorizontalAggregateList_Hero) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HorizontalAggregateList_Hero.ProtoReflect.Descriptor instead.
-func (*HorizontalAggregateList_Hero) Descriptor() ([]byte, []int) {
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{33, 1}
+// Deprecated: Use FieldPresentMap_Player.ProtoReflect.Descriptor instead.
+func (*FieldPresentMap_Player) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{24, 1}
 }
 
-func (x *HorizontalAggregateList_Hero) GetHeroId() uint32 {
+func (x *FieldPresentMap_Player) GetId() int32 {
 	if x != nil {
-		return x.HeroId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *HorizontalAggregateList_Hero) GetParamList() []*Item {
+func (x *FieldPresentMap_Player) GetWeapon() *FieldPresentMap_Player_Weapon {
 	if x != nil {
-		return x.ParamList
+		return x.Weapon
 	}
 	return nil
 }
 
-var File_tableau_protobuf_unittest_unittest_proto protoreflect.FileDescriptor
+func (x *FieldPresentMap_Player) GetInfo() *FieldPresentMap_Player_Info {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
 
-var file_tableau_protobuf_unittest_unittest_proto_rawDesc = []byte{
-	0x0a, 0x28, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x26, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x53,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x12, 0x54,
-	0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x04,
-	0x49, 0x74, 0x65, 0x6d, 0x20, 0x03, 0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52, 0x07, 0x69, 0x74, 0x65,
-	0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x3a, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x3a, 0x1d, 0x82, 0xb5, 0x18, 0x19, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e,
-	0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22,
-	0xf0, 0x05, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x12, 0x52, 0x0a,
-	0x09, 0x66, 0x72, 0x75, 0x69, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65,
-	0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x05, 0x46, 0x72, 0x75, 0x69, 0x74,
-	0x1a, 0x03, 0x4b, 0x65, 0x79, 0x20, 0x03, 0x52, 0x08, 0x66, 0x72, 0x75, 0x69, 0x74, 0x4d, 0x61,
-	0x70, 0x12, 0x51, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x6c, 0x61, 0x76, 0x6f,
-	0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a,
-	0x06, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x20, 0x03, 0x52, 0x09, 0x66, 0x6c, 0x61, 0x76, 0x6f,
-	0x72, 0x4d, 0x61, 0x70, 0x12, 0x4e, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x04,
-	0x49, 0x74, 0x65, 0x6d, 0x1a, 0x03, 0x4b, 0x65, 0x79, 0x20, 0x03, 0x52, 0x07, 0x69, 0x74, 0x65,
-	0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x56, 0x0a, 0x0d, 0x46, 0x72, 0x75, 0x69, 0x74, 0x4d, 0x61, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x72, 0x75, 0x69,
-	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x05,
-	0x46, 0x72, 0x75, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72,
-	0x75, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4b,
-	0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x53, 0x0a, 0x0e, 0x46, 0x6c,
-	0x61, 0x76, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74, 0x46, 0x6c,
-	0x61, 0x76, 0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x54, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65,
-	0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x72, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x75, 0x6e, 0x69,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42,
-	0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74, 0x46,
-	0x6c, 0x61, 0x76, 0x6f, 0x72, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x17, 0x82, 0xb5, 0x18, 0x13, 0x0a,
-	0x09, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x04, 0x22, 0x72, 0x0a, 0x10, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a,
-	0x04, 0x49, 0x74, 0x65, 0x6d, 0x20, 0x03, 0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52, 0x08, 0x69, 0x74,
-	0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x1e, 0x82, 0xb5, 0x18, 0x1a, 0x0a, 0x10, 0x49, 0x6e,
-	0x63, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x01,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x63, 0x65, 0x6c,
-	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a,
-	0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0b, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x42,
-	0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x06, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x20, 0x03, 0x52,
-	0x0a, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0c,
-	0x82, 0xb5, 0x18, 0x08, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x20, 0x03, 0x52, 0x08, 0x69, 0x74,
-	0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x18, 0x82, 0xb5, 0x18, 0x14, 0x0a, 0x0a, 0x49, 0x6e,
-	0x63, 0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04,
-	0x22, 0xb6, 0x01, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x46, 0x0a,
-	0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x43,
-	0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x69, 0x74,
-	0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x4a, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x3a, 0x16, 0x82, 0xb5, 0x18, 0x12, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x6e,
-	0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xfa, 0x03, 0x0a, 0x08, 0x4d, 0x61,
-	0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x6d,
-	0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f,
-	0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a,
-	0x06, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x73, 0x68, 0x6f, 0x70, 0x4d,
-	0x61, 0x70, 0x1a, 0x53, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
-	0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb4, 0x02, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x70,
-	0x12, 0x25, 0x0a, 0x07, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x44, 0x52,
-	0x06, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x09, 0x67, 0x6f, 0x6f, 0x64, 0x73,
-	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53,
-	0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x1a, 0x07, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x44,
-	0x20, 0x01, 0x52, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x1a, 0x5a, 0x0a, 0x0d,
-	0x47, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a, 0x05, 0x47, 0x6f, 0x6f, 0x64,
-	0x73, 0x12, 0x28, 0x0a, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x07, 0x47, 0x6f, 0x6f, 0x64, 0x73,
-	0x49, 0x44, 0x52, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07,
-	0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x16,
-	0x82, 0xb5, 0x18, 0x12, 0x0a, 0x08, 0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0x9b, 0x09, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x5e, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x61,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x1a, 0x0a, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x44, 0x20, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x70, 0x1a, 0x5f, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xad, 0x07, 0x0a, 0x08, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c,
-	0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x0a, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x63, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x70,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x1a, 0x09, 0x43,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x44, 0x20, 0x01, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x66, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f,
-	0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe7,
-	0x04, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0a, 0x63, 0x68,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f,
-	0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x44, 0x52,
-	0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x63, 0x68,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x5a, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x20, 0x01, 0x52,
-	0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x99, 0x03, 0x0a,
-	0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0x82, 0xb5,
-	0x18, 0x0b, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52, 0x09, 0x73,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
-	0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x71,
-	0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x1a, 0x02, 0x49, 0x44, 0x20, 0x02, 0x52, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61,
-	0x70, 0x1a, 0x74, 0x0a, 0x0e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82,
-	0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x6e,
-	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03,
-	0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x3a, 0x1a, 0x82, 0xb5, 0x18, 0x16, 0x0a, 0x0c,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x04, 0x22, 0xb2, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43,
-	0x6f, 0x6e, 0x66, 0x12, 0x54, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x61,
-	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10, 0x82, 0xb5,
-	0x18, 0x0c, 0x1a, 0x08, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x20, 0x01, 0x52, 0x09,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x1a, 0x59, 0x0a, 0x0e, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f,
-	0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0xd8, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x2b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x49, 0x44, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x08,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x04,
-	0x49, 0x74, 0x65, 0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x02, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d,
-	0x4d, 0x61, 0x70, 0x1a, 0x4a, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a,
-	0x18, 0x82, 0xb5, 0x18, 0x14, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e,
-	0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xd5, 0x02, 0x0a, 0x0e, 0x59, 0x61,
-	0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03,
-	0x6e, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x57, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x0a,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21,
-	0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0b, 0x82,
-	0xb5, 0x18, 0x07, 0x0a, 0x05, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1e, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x04, 0x62, 0x6c, 0x6f,
-	0x62, 0x12, 0x18, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x08, 0x82,
-	0xb5, 0x18, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x52, 0x02, 0x6f, 0x6b, 0x3a, 0x14, 0x82, 0xb5, 0x18,
-	0x10, 0x0a, 0x0e, 0x59, 0x61, 0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09,
-	0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x20, 0x03, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x3a, 0x21, 0x82, 0xb5, 0x18, 0x1d, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x04, 0xa8, 0x01, 0x01, 0x22, 0xcc, 0x06, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63,
-	0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x6e, 0x61,
-	0x6d, 0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a,
-	0x05, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x12, 0x26, 0x0a,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x82, 0xb5,
-	0x18, 0x07, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x33, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x61, 0x6d,
-	0x65, 0x33, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50,
-	0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x04,
-	0x74, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x05,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x20, 0x03, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x46, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x42, 0x18,
-	0x82, 0xb5, 0x18, 0x14, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x20, 0x03, 0x7a, 0x02, 0x60, 0x01, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x08, 0x69, 0x74,
-	0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72,
-	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x1a,
-	0x02, 0x49, 0x44, 0x20, 0x02, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x12, 0x73,
-	0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d,
-	0x61, 0x70, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1b, 0x82, 0xb5, 0x18, 0x17, 0x0a, 0x0b, 0x52, 0x65,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x02, 0x7a,
-	0x02, 0x60, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x4d, 0x61, 0x70, 0x1a, 0x86, 0x01, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x79, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x1a, 0x4a, 0x0a, 0x0c,
-	0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x51, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x1f, 0x82, 0xb5, 0x18,
-	0x1b, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0xa8, 0x01, 0x02, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x33, 0x22, 0xaa, 0x0b, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x75, 0x72,
-	0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x54, 0x0a,
-	0x08, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72,
-	0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68,
-	0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a,
-	0x1a, 0x06, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x73, 0x68, 0x6f, 0x70,
-	0x4d, 0x61, 0x70, 0x1a, 0x5d, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f,
-	0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0xb9, 0x09, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x70, 0x12, 0x25, 0x0a, 0x07, 0x73,
-	0x68, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5,
-	0x18, 0x08, 0x0a, 0x06, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x44, 0x52, 0x06, 0x73, 0x68, 0x6f, 0x70,
-	0x49, 0x64, 0x12, 0x5d, 0x0a, 0x09, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43,
-	0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x1a, 0x07, 0x47, 0x6f,
-	0x6f, 0x64, 0x73, 0x49, 0x44, 0x20, 0x01, 0x52, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61,
-	0x70, 0x1a, 0x64, 0x0a, 0x0d, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52,
-	0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e,
-	0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xc4, 0x07, 0x0a, 0x05, 0x47, 0x6f, 0x6f, 0x64,
-	0x73, 0x12, 0x28, 0x0a, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x07, 0x47, 0x6f, 0x6f, 0x64, 0x73,
-	0x49, 0x44, 0x52, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x64,
-	0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a,
-	0x04, 0x44, 0x65, 0x73, 0x63, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x73, 0x0a, 0x0c, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x38, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63,
-	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
-	0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x16, 0x82, 0xb5, 0x18,
-	0x12, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x20, 0x02, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x70,
-	0x12, 0x2a, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0c, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x20, 0x02, 0x7a,
-	0x02, 0x60, 0x01, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x0a,
-	0x61, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x75,
-	0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53,
-	0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x41, 0x77, 0x61, 0x72, 0x64, 0x42,
-	0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x20, 0x02, 0x52, 0x09,
-	0x61, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x70, 0x0a, 0x10, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x46, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f,
-	0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xc0, 0x03, 0x0a, 0x08,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x42, 0x11, 0x82, 0xb5,
-	0x18, 0x0d, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x20, 0x03, 0x7a, 0x02, 0x60, 0x01, 0x52,
-	0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6d, 0x0a, 0x0a, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73,
-	0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f,
-	0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x03, 0x52, 0x09,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x75, 0x0a, 0x0c, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x41, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72,
-	0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68,
-	0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x20, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
-	0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
-	0x0a, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x64, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09,
-	0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x3a, 0x23,
-	0x82, 0xb5, 0x18, 0x1f, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04,
-	0xa8, 0x01, 0x02, 0x22, 0xa6, 0x02, 0x0a, 0x10, 0x4a, 0x73, 0x6f, 0x6e, 0x55, 0x74, 0x69, 0x6c,
-	0x54, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0c, 0x6e, 0x6f, 0x72, 0x6d,
-	0x61, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x0b, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x45,
-	0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x73, 0x6f,
-	0x6e, 0x55, 0x74, 0x69, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x61,
-	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x70,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x55, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x87, 0x02, 0x0a,
-	0x1f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x56, 0x65,
-	0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x53, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74,
-	0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x20, 0x01, 0x52, 0x08, 0x69, 0x74, 0x65,
-	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x68, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a,
-	0x02, 0x49, 0x44, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82,
-	0xb5, 0x18, 0x06, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x3a,
-	0x25, 0x82, 0xb5, 0x18, 0x21, 0x0a, 0x1f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc0, 0x06, 0x0a, 0x1c, 0x56, 0x65, 0x72, 0x74, 0x69,
-	0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x5e, 0x0a, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
-	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61,
-	0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e,
-	0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07,
-	0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x1a, 0x67, 0x0a, 0x0c, 0x4d, 0x61, 0x69, 0x6e, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70,
-	0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0xb2, 0x04, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08,
-	0x0a, 0x06, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x06, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64,
-	0x12, 0x2f, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x08, 0x4d, 0x61, 0x69, 0x6e, 0x4e,
-	0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x6e, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6b, 0x76, 0x5f, 0x6d, 0x61, 0x70,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d,
-	0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x4b, 0x76, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x06, 0x4d, 0x61, 0x69, 0x6e, 0x4b, 0x56,
-	0x20, 0x03, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x69, 0x6e, 0x4b, 0x76, 0x4d, 0x61,
-	0x70, 0x12, 0x5f, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65,
-	0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x2e,
-	0x53, 0x75, 0x62, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0d, 0x82, 0xb5, 0x18,
-	0x09, 0x1a, 0x05, 0x53, 0x75, 0x62, 0x49, 0x44, 0x20, 0x01, 0x52, 0x06, 0x73, 0x75, 0x62, 0x4d,
-	0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6e, 0x4b, 0x76, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x6a, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x45, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74,
-	0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x75,
-	0x62, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x03,
-	0x53, 0x75, 0x62, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x53, 0x75, 0x62, 0x49, 0x44,
-	0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a,
-	0x07, 0x53, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x07, 0x73, 0x75,
-	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x0a, 0x1c, 0x56, 0x65, 0x72,
-	0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x22, 0x83, 0x02, 0x0a, 0x1d, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x09, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0b, 0x82, 0xb5,
-	0x18, 0x07, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4c,
-	0x69, 0x73, 0x74, 0x1a, 0x65, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x02, 0x49,
-	0x44, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
-	0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05,
-	0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x3a, 0x23, 0x82, 0xb5, 0x18, 0x1f,
-	0x0a, 0x1d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0x9b, 0x12, 0x0a, 0x1c, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70,
-	0x12, 0x66, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0x82, 0xb5, 0x18, 0x13, 0x0a, 0x07, 0x43, 0x68,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58, 0x01, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x0a, 0x73, 0x63, 0x61, 0x6c,
-	0x61, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x13, 0x82, 0xb5, 0x18, 0x0f, 0x0a, 0x09, 0x53, 0x63, 0x61, 0x6c, 0x61,
-	0x72, 0x4d, 0x61, 0x70, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72,
-	0x4d, 0x61, 0x70, 0x12, 0x6b, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x6d, 0x61,
-	0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e,
-	0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x15,
-	0x82, 0xb5, 0x18, 0x11, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x20,
-	0x03, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70,
-	0x12, 0x77, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x1b, 0x82, 0xb5, 0x18, 0x17, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x0b, 0x63, 0x68,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x6a, 0x0a, 0x0c, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x44, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6e, 0x69,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d,
-	0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x80, 0x03, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10,
-	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70,
-	0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x13, 0x82, 0xb5, 0x18, 0x0f, 0x0a, 0x07, 0x53, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x72, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x10, 0x01, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02,
-	0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6c,
-	0x61, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x72, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61,
-	0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xbe, 0x08, 0x0a, 0x0b, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65,
-	0x79, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x72,
-	0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x17, 0x82, 0xb5, 0x18, 0x13, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
-	0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x76, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x50, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xfc, 0x05, 0x0a, 0x07, 0x53,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a,
-	0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x7a, 0x0a, 0x07,
-	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e,
-	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0x82, 0xb5, 0x18, 0x13, 0x0a, 0x07, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58, 0x01, 0x52,
-	0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x7e, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x58, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61,
-	0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xae, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x10,
-	0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x07, 0x73,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0x82,
-	0xb5, 0x18, 0x13, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x04, 0x40, 0x6b,
-	0x65, 0x79, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
-	0x86, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x60, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x4a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x10, 0x01,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x0a,
-	0x1c, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x22, 0x8b, 0x02,
-	0x0a, 0x21, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49,
-	0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49,
-	0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x20, 0x01,
-	0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x66, 0x0a, 0x04, 0x49, 0x74,
-	0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c,
-	0x82, 0xb5, 0x18, 0x08, 0x0a, 0x02, 0x49, 0x44, 0x7a, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
-	0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0x82,
-	0xb5, 0x18, 0x0a, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x7a, 0x03, 0x20, 0xb9, 0x60, 0x52, 0x03, 0x6e,
-	0x75, 0x6d, 0x3a, 0x27, 0x82, 0xb5, 0x18, 0x23, 0x0a, 0x21, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61,
-	0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xf8, 0x03, 0x0a, 0x1e,
-	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56, 0x65, 0x72,
-	0x74, 0x69, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x56,
-	0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63,
-	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x08, 0x69, 0x74,
-	0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0xd7, 0x02, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x1c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18,
-	0x08, 0x0a, 0x02, 0x49, 0x44, 0x7a, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x65, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c,
-	0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x50,
-	0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18,
-	0x0a, 0x1a, 0x06, 0x50, 0x72, 0x6f, 0x70, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f,
-	0x70, 0x4d, 0x61, 0x70, 0x1a, 0x6e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56, 0x65,
-	0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x2e,
-	0x49, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x04, 0x50, 0x72, 0x6f, 0x70, 0x12, 0x25, 0x0a, 0x07,
-	0x70, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0c, 0x82,
-	0xb5, 0x18, 0x08, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x70, 0x49, 0x44, 0x52, 0x06, 0x70, 0x72, 0x6f,
-	0x70, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x08, 0x50, 0x72,
-	0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65,
-	0x3a, 0x24, 0x82, 0xb5, 0x18, 0x20, 0x0a, 0x1e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79,
-	0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xf2, 0x04, 0x0a, 0x1e, 0x56, 0x65, 0x72, 0x74, 0x69,
-	0x63, 0x61, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x60, 0x0a, 0x08, 0x6d, 0x61, 0x69,
-	0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x75, 0x6e,
-	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x44,
-	0x20, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x1a, 0x69, 0x0a, 0x0c, 0x4d,
-	0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x43, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c,
-	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xdc, 0x02, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6e, 0x12,
-	0x2a, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x06, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x7a, 0x03,
-	0x20, 0xe9, 0x07, 0x52, 0x06, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x61, 0x0a, 0x07, 0x73,
-	0x75, 0x62, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c,
-	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x1a, 0x05, 0x53,
-	0x75, 0x62, 0x49, 0x44, 0x20, 0x01, 0x52, 0x06, 0x73, 0x75, 0x62, 0x4d, 0x61, 0x70, 0x1a, 0x6c,
-	0x0a, 0x0b, 0x53, 0x75, 0x62, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
-	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63,
-	0x61, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x75,
-	0x62, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x03,
-	0x53, 0x75, 0x62, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x05, 0x53, 0x75, 0x62, 0x49, 0x44,
-	0x7a, 0x02, 0x20, 0x01, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x73,
-	0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x82,
-	0xb5, 0x18, 0x09, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x07, 0x73, 0x75,
-	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x24, 0x82, 0xb5, 0x18, 0x20, 0x0a, 0x1e, 0x56, 0x65, 0x72,
-	0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x22, 0x86, 0x02, 0x0a, 0x1f,
-	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x58, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x74,
-	0x65, 0x6d, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
-	0x08, 0x69, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x62, 0x0a, 0x04, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x82,
-	0xb5, 0x18, 0x09, 0x0a, 0x02, 0x49, 0x44, 0x7a, 0x03, 0x20, 0xe9, 0x07, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
-	0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82,
-	0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x3a, 0x25, 0x82,
-	0xb5, 0x18, 0x21, 0x0a, 0x1f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
-	0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44,
-	0x20, 0x01, 0x52, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x1a, 0x54, 0x0a, 0x0c, 0x48,
-	0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73,
-	0x65, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x40, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x3a, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x70, 0x6f, 0x73, 0x65, 0x60, 0x01, 0x22, 0x98, 0x03, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x05, 0x92,
-	0x01, 0x02, 0x10, 0x03, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x20, 0x03, 0x52,
-	0x07, 0x74, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
-	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x14, 0xba, 0x48, 0x05, 0x9a, 0x01, 0x02, 0x10, 0x02, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x04, 0x50,
-	0x72, 0x6f, 0x70, 0x20, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x1a, 0x3a,
-	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x84, 0x01, 0xba, 0x48, 0x67,
-	0x1a, 0x65, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x2e, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x6e, 0x61, 0x6d, 0x65, 0x20,
-	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6e, 0x6f, 0x6e, 0x2d, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x20, 0x69, 0x73, 0x20, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x64, 0x20, 0x3d,
-	0x3d, 0x20, 0x30, 0x75, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6e, 0x61, 0x6d,
-	0x65, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0x82, 0xb5, 0x18, 0x16, 0x0a, 0x0c, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x04, 0x22, 0x99, 0x02, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x46,
-	0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x74,
-	0x61, 0x73, 0x6b, 0x4d, 0x61, 0x70, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x61,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x04, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a,
-	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42,
-	0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x3a, 0x16, 0x82, 0xb5, 0x18, 0x12, 0x0a, 0x08, 0x54, 0x61, 0x73,
-	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xc5, 0x06,
-	0x0a, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x61,
-	0x70, 0x12, 0x53, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x09, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x5e, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
-	0x74, 0x4d, 0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe5, 0x04, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0x82,
-	0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x06, 0x77,
-	0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e,
-	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73,
-	0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x57, 0x65,
-	0x61, 0x70, 0x6f, 0x6e, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x06, 0x57, 0x65, 0x61, 0x70,
-	0x6f, 0x6e, 0x7a, 0x02, 0x50, 0x01, 0x52, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x4b,
-	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65,
-	0x73, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x49,
-	0x6e, 0x66, 0x6f, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x28,
-	0x02, 0x7a, 0x02, 0x50, 0x01, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x09, 0x68,
-	0x65, 0x72, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x42, 0x10,
-	0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x20, 0x03, 0x7a, 0x02, 0x50, 0x01,
-	0x52, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x08, 0x61, 0x74,
-	0x74, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65,
-	0x73, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x41,
-	0x74, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10, 0x82, 0xb5, 0x18,
-	0x0c, 0x0a, 0x04, 0x41, 0x74, 0x74, 0x72, 0x20, 0x03, 0x7a, 0x02, 0x50, 0x01, 0x52, 0x07, 0x61,
-	0x74, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x3e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x0a, 0x06,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x28, 0x02, 0x7a, 0x04, 0x40, 0x01, 0x50, 0x01, 0x52, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x52, 0x0a, 0x06, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e,
-	0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x50, 0x01, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x52, 0x61, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x46, 0x0a, 0x04, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x21, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x70, 0x52, 0x03, 0x65,
-	0x78, 0x70, 0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x15,
-	0x82, 0xb5, 0x18, 0x11, 0x0a, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x74, 0x4d, 0x61, 0x70, 0x22, 0xc4, 0x02, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x4e, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4d, 0x0a, 0x08, 0x7a, 0x6f, 0x6e,
-	0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e,
-	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f,
-	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52,
-	0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5a, 0x0a, 0x0c, 0x5a, 0x6f, 0x6e, 0x65,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x44, 0x82, 0xb5, 0x18, 0x40, 0x0a, 0x0f, 0x53, 0x63,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x04, 0x9a, 0x01, 0x24, 0x55, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x2a, 0x2e, 0x63, 0x73, 0x76, 0x23, 0x53, 0x63, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xd6, 0x02, 0x0a,
-	0x12, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x12, 0x50, 0x0a, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x7a, 0x6f,
-	0x6e, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5d, 0x0a, 0x0c, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02,
-	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x4d, 0x82, 0xb5, 0x18, 0x49, 0x0a, 0x12, 0x53, 0x63,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x9a, 0x01, 0x27, 0x55, 0x6e, 0x69, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x2a, 0x2e, 0x63, 0x73, 0x76, 0x23,
-	0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0xa8, 0x01, 0x01, 0x22, 0xcc, 0x02, 0x0a, 0x10, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4e, 0x0a, 0x08, 0x7a, 0x6f,
-	0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20,
-	0x01, 0x52, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5b, 0x0a, 0x0c, 0x5a, 0x6f,
-	0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e,
-	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65,
-	0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
-	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18,
-	0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x49, 0x82, 0xb5, 0x18, 0x45, 0x0a,
-	0x10, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x9a, 0x01, 0x25, 0x55, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x2a, 0x2e, 0x63, 0x73, 0x76,
-	0x23, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0xa8, 0x01, 0x02, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4e, 0x0a, 0x08, 0x7a, 0x6f, 0x6e,
-	0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e,
-	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01,
-	0x52, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5b, 0x0a, 0x0c, 0x5a, 0x6f, 0x6e,
-	0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04,
-	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x45, 0x82, 0xb5, 0x18, 0x41, 0x0a, 0x10,
-	0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x72, 0x25, 0x55, 0x6e, 0x69, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x31, 0x2a, 0x2e, 0x63, 0x73, 0x76, 0x23, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x22,
-	0xc2, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43,
-	0x6f, 0x6e, 0x66, 0x12, 0x4d, 0x0a, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x66,
-	0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82,
-	0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x4d,
-	0x61, 0x70, 0x1a, 0x5a, 0x0a, 0x0c, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a,
-	0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40,
-	0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
-	0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x3a, 0x42, 0x82, 0xb5, 0x18, 0x3e, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x72,
-	0x23, 0x55, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x2a,
-	0x2e, 0x63, 0x73, 0x76, 0x23, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x43, 0x6f, 0x6e, 0x66, 0x22, 0xe4, 0x04, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61,
-	0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x12,
-	0x58, 0x0a, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72,
-	0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x61, 0x70, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x48, 0x65, 0x72, 0x6f, 0x49, 0x44, 0x20, 0x01,
-	0x52, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x1a, 0x61, 0x0a, 0x0c, 0x48, 0x65, 0x72,
-	0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x2e, 0x48, 0x65, 0x72,
-	0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xee, 0x02, 0x0a,
-	0x04, 0x48, 0x65, 0x72, 0x6f, 0x12, 0x25, 0x0a, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x48, 0x65,
-	0x72, 0x6f, 0x49, 0x44, 0x52, 0x06, 0x68, 0x65, 0x72, 0x6f, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x09,
-	0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x08, 0x48, 0x65, 0x72, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x08, 0x68, 0x65, 0x72, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5f, 0x0a, 0x09, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c,
-	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x2e, 0x48,
-	0x65, 0x72, 0x6f, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x1a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x20, 0x01,
-	0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x1a, 0x68, 0x0a, 0x0d, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c,
-	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x2e, 0x48,
-	0x65, 0x72, 0x6f, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x21, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x82, 0xb5,
-	0x18, 0x07, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x1b, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82,
-	0xb5, 0x18, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x70, 0x52, 0x03, 0x65, 0x78, 0x70, 0x3a, 0x1c, 0x82,
-	0xb5, 0x18, 0x18, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x22, 0xe8, 0x01, 0x0a, 0x0f,
-	0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x2c, 0x0a, 0x07, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d,
-	0x42, 0x13, 0x82, 0xb5, 0x18, 0x0f, 0x0a, 0x02, 0x49, 0x44, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x03,
-	0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52, 0x06, 0x69, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x44, 0x0a,
-	0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x1a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x20, 0x03, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x15, 0x82, 0xb5, 0x18, 0x11, 0x0a, 0x04, 0x49, 0x74,
-	0x65, 0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x03, 0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52, 0x08, 0x69,
-	0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x1d, 0x82, 0xb5, 0x18, 0x19, 0x0a, 0x0f, 0x49,
-	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x01,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xe1, 0x03, 0x0a, 0x16, 0x48, 0x6f, 0x72, 0x69, 0x7a,
-	0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x70, 0x12, 0x58, 0x0a, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x48,
-	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x70, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x48, 0x65, 0x72, 0x6f, 0x49, 0x44,
-	0x20, 0x01, 0x52, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x1a, 0x61, 0x0a, 0x0c, 0x48,
-	0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74,
-	0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x2e, 0x48,
-	0x65, 0x72, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe3,
-	0x01, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x12, 0x29, 0x0a, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x06,
-	0x48, 0x65, 0x72, 0x6f, 0x49, 0x44, 0x7a, 0x02, 0x10, 0x00, 0x52, 0x06, 0x68, 0x65, 0x72, 0x6f,
-	0x49, 0x64, 0x12, 0x64, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x15, 0x82, 0xb5, 0x18, 0x11, 0x0a, 0x04,
-	0x49, 0x74, 0x65, 0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x02, 0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52,
-	0x07, 0x69, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x4a, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x3a, 0x24, 0x82, 0xb5, 0x18, 0x20, 0x0a, 0x16, 0x48, 0x6f, 0x72, 0x69,
-	0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x70, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xf5, 0x02, 0x0a, 0x17, 0x48,
-	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6d,
-	0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x72, 0x6f,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06,
-	0x48, 0x65, 0x72, 0x6f, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x4d, 0x61,
-	0x70, 0x1a, 0x62, 0x0a, 0x0c, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x6f,
-	0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x74, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x12, 0x29, 0x0a,
-	0x07, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10,
-	0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x06, 0x48, 0x65, 0x72, 0x6f, 0x49, 0x44, 0x7a, 0x02, 0x10, 0x00,
-	0x52, 0x06, 0x68, 0x65, 0x72, 0x6f, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75,
-	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x12, 0x82, 0xb5,
-	0x18, 0x0e, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x02, 0x7a, 0x03, 0xa8, 0x01, 0x01,
-	0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x25, 0x82, 0xb5, 0x18,
-	0x21, 0x0a, 0x17, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x04, 0x42, 0x56, 0x82, 0xb5, 0x18, 0x19, 0x0a, 0x17, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x2f, 0x55, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x23, 0x2a, 0x2e, 0x63, 0x73,
-	0x76, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x61, 0x75, 0x69, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x70, 0x62, 0x2f,
-	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (x *FieldPresentMap_Player) GetHeroList() []int32 {
+	if x != nil {
+		return x.HeroList
+	}
+	return nil
 }
 
-var (
-	file_tableau_protobuf_unittest_unittest_proto_rawDescOnce sync.Once
-	file_tableau_protobuf_unittest_unittest_proto_rawDescData = file_tableau_protobuf_unittest_unittest_proto_rawDesc
-)
+func (x *FieldPresentMap_Player) GetAttrMap() map[string]int32 {
+	if x != nil {
+		return x.AttrMap
+	}
+	return nil
+}
 
-func file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP() []byte {
-	file_tableau_protobuf_unittest_unittest_proto_rawDescOnce.Do(func() {
-		file_tableau_protobuf_unittest_unittest_proto_rawDescData = protoimpl.X.CompressGZIP(file_tableau_protobuf_unittest_unittest_proto_rawDescData)
-	})
-	return file_tableau_protobuf_unittest_unittest_proto_rawDescData
+func (x *FieldPresentMap_Player) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
 }
 
-var file_tableau_protobuf_unittest_unittest_proto_msgTypes = make([]protoimpl.MessageInfo, 127)
-var file_tableau_protobuf_unittest_unittest_proto_goTypes = []interface{}{
-	(*SimpleIncellMap)(nil),                   // 0: unittest.SimpleIncellMap
-	(*IncellMap)(nil),                         // 1: unittest.IncellMap
-	(*IncellStructList)(nil),                  // 2: unittest.IncellStructList
-	(*IncellList)(nil),                        // 3: unittest.IncellList
-	(*ItemConf)(nil),                          // 4: unittest.ItemConf
-	(*MallConf)(nil),                          // 5: unittest.MallConf
-	(*ActivityConf)(nil),                      // 6: unittest.ActivityConf
-	(*RewardConf)(nil),                        // 7: unittest.RewardConf
-	(*YamlScalarConf)(nil),                    // 8: unittest.YamlScalarConf
-	(*PatchReplaceConf)(nil),                  // 9: unittest.PatchReplaceConf
-	(*PatchMergeConf)(nil),                    // 10: unittest.PatchMergeConf
-	(*RecursivePatchConf)(nil),                // 11: unittest.RecursivePatchConf
-	(*JsonUtilTestData)(nil),                  // 12: unittest.JsonUtilTestData
-	(*UniqueFieldInVerticalStructList)(nil),   // 13: unittest.UniqueFieldInVerticalStructList
-	(*VerticalUniqueFieldStructMap)(nil),      // 14: unittest.VerticalUniqueFieldStructMap
-	(*DocumentUniqueFieldStructList)(nil),     // 15: unittest.DocumentUniqueFieldStructList
-	(*DocumentUniqueFieldStructMap)(nil),      // 16: unittest.DocumentUniqueFieldStructMap
-	(*SequenceFieldInVerticalStructList)(nil), // 17: unittest.SequenceFieldInVerticalStructList
-	(*SequenceKeyInVerticalKeyedList)(nil),    // 18: unittest.SequenceKeyInVerticalKeyedList
-	(*VerticalSequenceFieldStructMap)(nil),    // 19: unittest.VerticalSequenceFieldStructMap
-	(*DocumentSequenceFieldStructList)(nil),   // 20: unittest.DocumentSequenceFieldStructList
-	(*Transpose)(nil),                         // 21: unittest.Transpose
-	(*ValidateConf)(nil),                      // 22: unittest.ValidateConf
-	(*TaskConf)(nil),                          // 23: unittest.TaskConf
-	(*FieldPresentMap)(nil),                   // 24: unittest.FieldPresentMap
-	(*ScatterNoneConf)(nil),                   // 25: unittest.ScatterNoneConf
-	(*ScatterReplaceConf)(nil),                // 26: unittest.ScatterReplaceConf
-	(*ScatterMergeConf)(nil),                  // 27: unittest.ScatterMergeConf
-	(*MergerSingleConf)(nil),                  // 28: unittest.MergerSingleConf
-	(*MergerMultiConf)(nil),                   // 29: unittest.MergerMultiConf
-	(*VerticalAggregationMap)(nil),            // 30: unittest.VerticalAggregationMap
-	(*IncellKeyedList)(nil),                   // 31: unittest.IncellKeyedList
-	(*HorizontalAggregateMap)(nil),            // 32: unittest.HorizontalAggregateMap
-	(*HorizontalAggregateList)(nil),           // 33: unittest.HorizontalAggregateList
-	nil,                                       // 34: unittest.SimpleIncellMap.ItemMapEntry
-	nil,                                       // 35: unittest.IncellMap.FruitMapEntry
-	(*IncellMap_Fruit)(nil),                   // 36: unittest.IncellMap.Fruit
-	nil,                                       // 37: unittest.IncellMap.FlavorMapEntry
-	nil,                                       // 38: unittest.IncellMap.ItemMapEntry
-	(*IncellMap_Item)(nil),                    // 39: unittest.IncellMap.Item
-	nil,                                       // 40: unittest.ItemConf.ItemMapEntry
-	nil,                                       // 41: unittest.MallConf.ShopMapEntry
-	(*MallConf_Shop)(nil),                     // 42: unittest.MallConf.Shop
-	nil,                                       // 43: unittest.MallConf.Shop.GoodsMapEntry
-	(*MallConf_Shop_Goods)(nil),               // 44: unittest.MallConf.Shop.Goods
-	nil,                                       // 45: unittest.ActivityConf.ActivityMapEntry
-	(*ActivityConf_Activity)(nil),             // 46: unittest.ActivityConf.Activity
-	nil,                                       // 47: unittest.ActivityConf.Activity.ChapterMapEntry
-	(*ActivityConf_Activity_Chapter)(nil),     // 48: unittest.ActivityConf.Activity.Chapter
-	(*ActivityConf_Activity_Chapter_Section)(nil), // 49: unittest.ActivityConf.Activity.Chapter.Section
-	nil, // 50: unittest.ActivityConf.Activity.Chapter.Section.RewardMapEntry
-	(*ActivityConf_Activity_Chapter_Section_Reward)(nil), // 51: unittest.ActivityConf.Activity.Chapter.Section.Reward
-	nil,                                   // 52: unittest.RewardConf.RewardMapEntry
-	(*RewardConf_Reward)(nil),             // 53: unittest.RewardConf.Reward
-	nil,                                   // 54: unittest.RewardConf.Reward.ItemMapEntry
-	(*PatchMergeConf_Time)(nil),           // 55: unittest.PatchMergeConf.Time
-	nil,                                   // 56: unittest.PatchMergeConf.ItemMapEntry
-	nil,                                   // 57: unittest.PatchMergeConf.ReplaceItemMapEntry
-	nil,                                   // 58: unittest.RecursivePatchConf.ShopMapEntry
-	(*RecursivePatchConf_Shop)(nil),       // 59: unittest.RecursivePatchConf.Shop
-	nil,                                   // 60: unittest.RecursivePatchConf.Shop.GoodsMapEntry
-	(*RecursivePatchConf_Shop_Goods)(nil), // 61: unittest.RecursivePatchConf.Shop.Goods
-	nil,                                   // 62: unittest.RecursivePatchConf.Shop.Goods.CurrencyMapEntry
-	(*RecursivePatchConf_Shop_Goods_Currency)(nil), // 63: unittest.RecursivePatchConf.Shop.Goods.Currency
-	(*RecursivePatchConf_Shop_Goods_Award)(nil),    // 64: unittest.RecursivePatchConf.Shop.Goods.Award
-	nil, // 65: unittest.RecursivePatchConf.Shop.Goods.Currency.ValueListEntry
-	nil, // 66: unittest.RecursivePatchConf.Shop.Goods.Currency.MessageListEntry
-	nil, // 67: unittest.JsonUtilTestData.MapFieldEntry
-	(*UniqueFieldInVerticalStructList_Item)(nil), // 68: unittest.UniqueFieldInVerticalStructList.Item
-	nil, // 69: unittest.VerticalUniqueFieldStructMap.MainMapEntry
-	(*VerticalUniqueFieldStructMap_Main)(nil), // 70: unittest.VerticalUniqueFieldStructMap.Main
-	nil, // 71: unittest.VerticalUniqueFieldStructMap.Main.MainKvMapEntry
-	nil, // 72: unittest.VerticalUniqueFieldStructMap.Main.SubMapEntry
-	(*VerticalUniqueFieldStructMap_Main_Sub)(nil), // 73: unittest.VerticalUniqueFieldStructMap.Main.Sub
-	(*DocumentUniqueFieldStructList_Item)(nil),    // 74: unittest.DocumentUniqueFieldStructList.Item
-	nil, // 75: unittest.DocumentUniqueFieldStructMap.ChapterEntry
-	(*DocumentUniqueFieldStructMap_Chapter)(nil), // 76: unittest.DocumentUniqueFieldStructMap.Chapter
-	nil, // 77: unittest.DocumentUniqueFieldStructMap.ScalarMapEntry
-	nil, // 78: unittest.DocumentUniqueFieldStructMap.IncellMapEntry
-	nil, // 79: unittest.DocumentUniqueFieldStructMap.ChapterInfoEntry
-	(*DocumentUniqueFieldStructMap_ChapterInfo)(nil), // 80: unittest.DocumentUniqueFieldStructMap.ChapterInfo
-	nil, // 81: unittest.DocumentUniqueFieldStructMap.Chapter.SectionEntry
-	(*DocumentUniqueFieldStructMap_Chapter_Section)(nil), // 82: unittest.DocumentUniqueFieldStructMap.Chapter.Section
-	nil, // 83: unittest.DocumentUniqueFieldStructMap.ChapterInfo.SectionEntry
-	(*DocumentUniqueFieldStructMap_ChapterInfo_Section)(nil), // 84: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section
-	nil, // 85: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.SectionEntry
-	(*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section)(nil), // 86: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section
-	nil, // 87: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.SectionEntry
-	(*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section)(nil), // 88: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.Section
-	(*SequenceFieldInVerticalStructList_Item)(nil),                           // 89: unittest.SequenceFieldInVerticalStructList.Item
-	(*SequenceKeyInVerticalKeyedList_Item)(nil),                              // 90: unittest.SequenceKeyInVerticalKeyedList.Item
-	nil, // 91: unittest.SequenceKeyInVerticalKeyedList.Item.PropMapEntry
-	(*SequenceKeyInVerticalKeyedList_Item_Prop)(nil), // 92: unittest.SequenceKeyInVerticalKeyedList.Item.Prop
-	nil, // 93: unittest.VerticalSequenceFieldStructMap.MainMapEntry
-	(*VerticalSequenceFieldStructMap_Main)(nil), // 94: unittest.VerticalSequenceFieldStructMap.Main
-	nil, // 95: unittest.VerticalSequenceFieldStructMap.Main.SubMapEntry
-	(*VerticalSequenceFieldStructMap_Main_Sub)(nil), // 96: unittest.VerticalSequenceFieldStructMap.Main.Sub
-	(*DocumentSequenceFieldStructList_Item)(nil),    // 97: unittest.DocumentSequenceFieldStructList.Item
-	nil,                                   // 98: unittest.Transpose.HeroMapEntry
-	(*Transpose_Hero)(nil),                // 99: unittest.Transpose.Hero
-	nil,                                   // 100: unittest.ValidateConf.PropMapEntry
-	nil,                                   // 101: unittest.TaskConf.TaskMapEntry
-	(*TaskConf_Task)(nil),                 // 102: unittest.TaskConf.Task
-	nil,                                   // 103: unittest.FieldPresentMap.PlayerMapEntry
-	(*FieldPresentMap_Player)(nil),        // 104: unittest.FieldPresentMap.Player
-	(*FieldPresentMap_Player_Weapon)(nil), // 105: unittest.FieldPresentMap.Player.Weapon
-	(*FieldPresentMap_Player_Info)(nil),   // 106: unittest.FieldPresentMap.Player.Info
-	nil,                                   // 107: unittest.FieldPresentMap.Player.AttrMapEntry
-	nil,                                   // 108: unittest.ScatterNoneConf.ZoneMapEntry
-	(*ScatterNoneConf_Zone)(nil),          // 109: unittest.ScatterNoneConf.Zone
-	nil,                                   // 110: unittest.ScatterReplaceConf.ZoneMapEntry
-	(*ScatterReplaceConf_Zone)(nil),       // 111: unittest.ScatterReplaceConf.Zone
-	nil,                                   // 112: unittest.ScatterMergeConf.ZoneMapEntry
-	(*ScatterMergeConf_Zone)(nil),         // 113: unittest.ScatterMergeConf.Zone
-	nil,                                   // 114: unittest.MergerSingleConf.ZoneMapEntry
-	(*MergerSingleConf_Zone)(nil),         // 115: unittest.MergerSingleConf.Zone
-	nil,                                   // 116: unittest.MergerMultiConf.ZoneMapEntry
-	(*MergerMultiConf_Zone)(nil),          // 117: unittest.MergerMultiConf.Zone
-	nil,                                   // 118: unittest.VerticalAggregationMap.HeroMapEntry
-	(*VerticalAggregationMap_Hero)(nil),   // 119: unittest.VerticalAggregationMap.Hero
-	nil,                                   // 120: unittest.VerticalAggregationMap.Hero.LevelMapEntry
-	(*VerticalAggregationMap_Hero_Level)(nil), // 121: unittest.VerticalAggregationMap.Hero.Level
-	nil,                                  // 122: unittest.HorizontalAggregateMap.HeroMapEntry
-	(*HorizontalAggregateMap_Hero)(nil),  // 123: unittest.HorizontalAggregateMap.Hero
-	nil,                                  // 124: unittest.HorizontalAggregateMap.Hero.ItemMapEntry
-	nil,                                  // 125: unittest.HorizontalAggregateList.HeroMapEntry
-	(*HorizontalAggregateList_Hero)(nil), // 126: unittest.HorizontalAggregateList.Hero
-	(*Item)(nil),                         // 127: unittest.Item
-	(FruitFlavor)(0),                     // 128: unittest.FruitFlavor
-	(FruitType)(0),                       // 129: unittest.FruitType
-	(*timestamppb.Timestamp)(nil),        // 130: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),          // 131: google.protobuf.Duration
-	(*Target)(nil),                       // 132: unittest.Target
+type FieldPresentMap_Player_Weapon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rarity int32  `protobuf:"varint,2,opt,name=rarity,proto3" json:"rarity,omitempty"`
 }
-var file_tableau_protobuf_unittest_unittest_proto_depIdxs = []int32{
-	34,  // 0: unittest.SimpleIncellMap.item_map:type_name -> unittest.SimpleIncellMap.ItemMapEntry
-	35,  // 1: unittest.IncellMap.fruit_map:type_name -> unittest.IncellMap.FruitMapEntry
-	37,  // 2: unittest.IncellMap.flavor_map:type_name -> unittest.IncellMap.FlavorMapEntry
-	38,  // 3: unittest.IncellMap.item_map:type_name -> unittest.IncellMap.ItemMapEntry
-	127, // 4: unittest.IncellStructList.item_list:type_name -> unittest.Item
-	128, // 5: unittest.IncellList.flavor_list:type_name -> unittest.FruitFlavor
-	127, // 6: unittest.IncellList.item_list:type_name -> unittest.Item
-	40,  // 7: unittest.ItemConf.item_map:type_name -> unittest.ItemConf.ItemMapEntry
-	41,  // 8: unittest.MallConf.shop_map:type_name -> unittest.MallConf.ShopMapEntry
-	45,  // 9: unittest.ActivityConf.activity_map:type_name -> unittest.ActivityConf.ActivityMapEntry
-	52,  // 10: unittest.RewardConf.reward_map:type_name -> unittest.RewardConf.RewardMapEntry
-	55,  // 11: unittest.PatchMergeConf.time:type_name -> unittest.PatchMergeConf.Time
-	56,  // 12: unittest.PatchMergeConf.item_map:type_name -> unittest.PatchMergeConf.ItemMapEntry
-	57,  // 13: unittest.PatchMergeConf.replace_item_map:type_name -> unittest.PatchMergeConf.ReplaceItemMapEntry
-	58,  // 14: unittest.RecursivePatchConf.shop_map:type_name -> unittest.RecursivePatchConf.ShopMapEntry
-	10,  // 15: unittest.JsonUtilTestData.normal_field:type_name -> unittest.PatchMergeConf
-	10,  // 16: unittest.JsonUtilTestData.list_field:type_name -> unittest.PatchMergeConf
-	67,  // 17: unittest.JsonUtilTestData.map_field:type_name -> unittest.JsonUtilTestData.MapFieldEntry
-	68,  // 18: unittest.UniqueFieldInVerticalStructList.item_list:type_name -> unittest.UniqueFieldInVerticalStructList.Item
-	69,  // 19: unittest.VerticalUniqueFieldStructMap.main_map:type_name -> unittest.VerticalUniqueFieldStructMap.MainMapEntry
-	74,  // 20: unittest.DocumentUniqueFieldStructList.item_list:type_name -> unittest.DocumentUniqueFieldStructList.Item
-	75,  // 21: unittest.DocumentUniqueFieldStructMap.chapter:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterEntry
-	77,  // 22: unittest.DocumentUniqueFieldStructMap.scalar_map:type_name -> unittest.DocumentUniqueFieldStructMap.ScalarMapEntry
-	78,  // 23: unittest.DocumentUniqueFieldStructMap.incell_map:type_name -> unittest.DocumentUniqueFieldStructMap.IncellMapEntry
-	79,  // 24: unittest.DocumentUniqueFieldStructMap.chapter_info:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfoEntry
-	89,  // 25: unittest.SequenceFieldInVerticalStructList.item_list:type_name -> unittest.SequenceFieldInVerticalStructList.Item
-	90,  // 26: unittest.SequenceKeyInVerticalKeyedList.item_list:type_name -> unittest.SequenceKeyInVerticalKeyedList.Item
-	93,  // 27: unittest.VerticalSequenceFieldStructMap.main_map:type_name -> unittest.VerticalSequenceFieldStructMap.MainMapEntry
-	97,  // 28: unittest.DocumentSequenceFieldStructList.item_list:type_name -> unittest.DocumentSequenceFieldStructList.Item
-	98,  // 29: unittest.Transpose.hero_map:type_name -> unittest.Transpose.HeroMapEntry
-	100, // 30: unittest.ValidateConf.prop_map:type_name -> unittest.ValidateConf.PropMapEntry
-	101, // 31: unittest.TaskConf.task_map:type_name -> unittest.TaskConf.TaskMapEntry
-	103, // 32: unittest.FieldPresentMap.player_map:type_name -> unittest.FieldPresentMap.PlayerMapEntry
-	108, // 33: unittest.ScatterNoneConf.zone_map:type_name -> unittest.ScatterNoneConf.ZoneMapEntry
-	110, // 34: unittest.ScatterReplaceConf.zone_map:type_name -> unittest.ScatterReplaceConf.ZoneMapEntry
-	112, // 35: unittest.ScatterMergeConf.zone_map:type_name -> unittest.ScatterMergeConf.ZoneMapEntry
-	114, // 36: unittest.MergerSingleConf.zone_map:type_name -> unittest.MergerSingleConf.ZoneMapEntry
-	116, // 37: unittest.MergerMultiConf.zone_map:type_name -> unittest.MergerMultiConf.ZoneMapEntry
-	118, // 38: unittest.VerticalAggregationMap.hero_map:type_name -> unittest.VerticalAggregationMap.HeroMapEntry
-	129, // 39: unittest.IncellKeyedList.type_list:type_name -> unittest.FruitType
-	127, // 40: unittest.IncellKeyedList.item_list:type_name -> unittest.Item
-	122, // 41: unittest.HorizontalAggregateMap.hero_map:type_name -> unittest.HorizontalAggregateMap.HeroMapEntry
-	125, // 42: unittest.HorizontalAggregateList.hero_map:type_name -> unittest.HorizontalAggregateList.HeroMapEntry
-	36,  // 43: unittest.IncellMap.FruitMapEntry.value:type_name -> unittest.IncellMap.Fruit
-	129, // 44: unittest.IncellMap.Fruit.key:type_name -> unittest.FruitType
-	128, // 45: unittest.IncellMap.FlavorMapEntry.value:type_name -> unittest.FruitFlavor
-	39,  // 46: unittest.IncellMap.ItemMapEntry.value:type_name -> unittest.IncellMap.Item
-	129, // 47: unittest.IncellMap.Item.key:type_name -> unittest.FruitType
-	128, // 48: unittest.IncellMap.Item.value:type_name -> unittest.FruitFlavor
-	127, // 49: unittest.ItemConf.ItemMapEntry.value:type_name -> unittest.Item
-	42,  // 50: unittest.MallConf.ShopMapEntry.value:type_name -> unittest.MallConf.Shop
-	43,  // 51: unittest.MallConf.Shop.goods_map:type_name -> unittest.MallConf.Shop.GoodsMapEntry
-	44,  // 52: unittest.MallConf.Shop.GoodsMapEntry.value:type_name -> unittest.MallConf.Shop.Goods
-	46,  // 53: unittest.ActivityConf.ActivityMapEntry.value:type_name -> unittest.ActivityConf.Activity
-	47,  // 54: unittest.ActivityConf.Activity.chapter_map:type_name -> unittest.ActivityConf.Activity.ChapterMapEntry
-	48,  // 55: unittest.ActivityConf.Activity.ChapterMapEntry.value:type_name -> unittest.ActivityConf.Activity.Chapter
-	49,  // 56: unittest.ActivityConf.Activity.Chapter.section_list:type_name -> unittest.ActivityConf.Activity.Chapter.Section
-	50,  // 57: unittest.ActivityConf.Activity.Chapter.Section.reward_map:type_name -> unittest.ActivityConf.Activity.Chapter.Section.RewardMapEntry
-	51,  // 58: unittest.ActivityConf.Activity.Chapter.Section.RewardMapEntry.value:type_name -> unittest.ActivityConf.Activity.Chapter.Section.Reward
-	53,  // 59: unittest.RewardConf.RewardMapEntry.value:type_name -> unittest.RewardConf.Reward
-	54,  // 60: unittest.RewardConf.Reward.item_map:type_name -> unittest.RewardConf.Reward.ItemMapEntry
-	127, // 61: unittest.RewardConf.Reward.ItemMapEntry.value:type_name -> unittest.Item
-	130, // 62: unittest.PatchMergeConf.Time.start:type_name -> google.protobuf.Timestamp
-	131, // 63: unittest.PatchMergeConf.Time.expiry:type_name -> google.protobuf.Duration
-	127, // 64: unittest.PatchMergeConf.ItemMapEntry.value:type_name -> unittest.Item
-	127, // 65: unittest.PatchMergeConf.ReplaceItemMapEntry.value:type_name -> unittest.Item
-	59,  // 66: unittest.RecursivePatchConf.ShopMapEntry.value:type_name -> unittest.RecursivePatchConf.Shop
-	60,  // 67: unittest.RecursivePatchConf.Shop.goods_map:type_name -> unittest.RecursivePatchConf.Shop.GoodsMapEntry
-	61,  // 68: unittest.RecursivePatchConf.Shop.GoodsMapEntry.value:type_name -> unittest.RecursivePatchConf.Shop.Goods
-	62,  // 69: unittest.RecursivePatchConf.Shop.Goods.currency_map:type_name -> unittest.RecursivePatchConf.Shop.Goods.CurrencyMapEntry
-	64,  // 70: unittest.RecursivePatchConf.Shop.Goods.award_list:type_name -> unittest.RecursivePatchConf.Shop.Goods.Award
-	63,  // 71: unittest.RecursivePatchConf.Shop.Goods.CurrencyMapEntry.value:type_name -> unittest.RecursivePatchConf.Shop.Goods.Currency
-	65,  // 72: unittest.RecursivePatchConf.Shop.Goods.Currency.value_list:type_name -> unittest.RecursivePatchConf.Shop.Goods.Currency.ValueListEntry
-	66,  // 73: unittest.RecursivePatchConf.Shop.Goods.Currency.message_list:type_name -> unittest.RecursivePatchConf.Shop.Goods.Currency.MessageListEntry
-	10,  // 74: unittest.JsonUtilTestData.MapFieldEntry.value:type_name -> unittest.PatchMergeConf
-	70,  // 75: unittest.VerticalUniqueFieldStructMap.MainMapEntry.value:type_name -> unittest.VerticalUniqueFieldStructMap.Main
-	71,  // 76: unittest.VerticalUniqueFieldStructMap.Main.main_kv_map:type_name -> unittest.VerticalUniqueFieldStructMap.Main.MainKvMapEntry
-	72,  // 77: unittest.VerticalUniqueFieldStructMap.Main.sub_map:type_name -> unittest.VerticalUniqueFieldStructMap.Main.SubMapEntry
-	73,  // 78: unittest.VerticalUniqueFieldStructMap.Main.SubMapEntry.value:type_name -> unittest.VerticalUniqueFieldStructMap.Main.Sub
-	76,  // 79: unittest.DocumentUniqueFieldStructMap.ChapterEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.Chapter
-	81,  // 80: unittest.DocumentUniqueFieldStructMap.Chapter.section:type_name -> unittest.DocumentUniqueFieldStructMap.Chapter.SectionEntry
-	80,  // 81: unittest.DocumentUniqueFieldStructMap.ChapterInfoEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo
-	83,  // 82: unittest.DocumentUniqueFieldStructMap.ChapterInfo.section:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.SectionEntry
-	82,  // 83: unittest.DocumentUniqueFieldStructMap.Chapter.SectionEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.Chapter.Section
-	84,  // 84: unittest.DocumentUniqueFieldStructMap.ChapterInfo.SectionEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section
-	85,  // 85: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.section:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.SectionEntry
-	86,  // 86: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.SectionEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section
-	87,  // 87: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.section:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.SectionEntry
-	88,  // 88: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.SectionEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.Section
-	91,  // 89: unittest.SequenceKeyInVerticalKeyedList.Item.prop_map:type_name -> unittest.SequenceKeyInVerticalKeyedList.Item.PropMapEntry
-	92,  // 90: unittest.SequenceKeyInVerticalKeyedList.Item.PropMapEntry.value:type_name -> unittest.SequenceKeyInVerticalKeyedList.Item.Prop
-	94,  // 91: unittest.VerticalSequenceFieldStructMap.MainMapEntry.value:type_name -> unittest.VerticalSequenceFieldStructMap.Main
-	95,  // 92: unittest.VerticalSequenceFieldStructMap.Main.sub_map:type_name -> unittest.VerticalSequenceFieldStructMap.Main.SubMapEntry
-	96,  // 93: unittest.VerticalSequenceFieldStructMap.Main.SubMapEntry.value:type_name -> unittest.VerticalSequenceFieldStructMap.Main.Sub
-	99,  // 94: unittest.Transpose.HeroMapEntry.value:type_name -> unittest.Transpose.Hero
-	102, // 95: unittest.TaskConf.TaskMapEntry.value:type_name -> unittest.TaskConf.Task
-	132, // 96: unittest.TaskConf.Task.target:type_name -> unittest.Target
-	104, // 97: unittest.FieldPresentMap.PlayerMapEntry.value:type_name -> unittest.FieldPresentMap.Player
-	105, // 98: unittest.FieldPresentMap.Player.weapon:type_name -> unittest.FieldPresentMap.Player.Weapon
-	106, // 99: unittest.FieldPresentMap.Player.info:type_name -> unittest.FieldPresentMap.Player.Info
-	107, // 100: unittest.FieldPresentMap.Player.attr_map:type_name -> unittest.FieldPresentMap.Player.AttrMapEntry
-	132, // 101: unittest.FieldPresentMap.Player.target:type_name -> unittest.Target
-	109, // 102: unittest.ScatterNoneConf.ZoneMapEntry.value:type_name -> unittest.ScatterNoneConf.Zone
-	111, // 103: unittest.ScatterReplaceConf.ZoneMapEntry.value:type_name -> unittest.ScatterReplaceConf.Zone
-	113, // 104: unittest.ScatterMergeConf.ZoneMapEntry.value:type_name -> unittest.ScatterMergeConf.Zone
-	115, // 105: unittest.MergerSingleConf.ZoneMapEntry.value:type_name -> unittest.MergerSingleConf.Zone
-	117, // 106: unittest.MergerMultiConf.ZoneMapEntry.value:type_name -> unittest.MergerMultiConf.Zone
-	119, // 107: unittest.VerticalAggregationMap.HeroMapEntry.value:type_name -> unittest.VerticalAggregationMap.Hero
-	120, // 108: unittest.VerticalAggregationMap.Hero.level_map:type_name -> unittest.VerticalAggregationMap.Hero.LevelMapEntry
-	121, // 109: unittest.VerticalAggregationMap.Hero.LevelMapEntry.value:type_name -> unittest.VerticalAggregationMap.Hero.Level
-	123, // 110: unittest.HorizontalAggregateMap.HeroMapEntry.value:type_name -> unittest.HorizontalAggregateMap.Hero
-	124, // 111: unittest.HorizontalAggregateMap.Hero.item_map:type_name -> unittest.HorizontalAggregateMap.Hero.ItemMapEntry
-	127, // 112: unittest.HorizontalAggregateMap.Hero.ItemMapEntry.value:type_name -> unittest.Item
-	126, // 113: unittest.HorizontalAggregateList.HeroMapEntry.value:type_name -> unittest.HorizontalAggregateList.Hero
-	127, // 114: unittest.HorizontalAggregateList.Hero.param_list:type_name -> unittest.Item
-	115, // [115:115] is the sub-list for method output_type
-	115, // [115:115] is the sub-list for method input_type
-	115, // [115:115] is the sub-list for extension type_name
-	115, // [115:115] is the sub-list for extension extendee
-	0,   // [0:115] is the sub-list for field type_name
+
+func (x *FieldPresentMap_Player_Weapon) Reset() {
+	*x = FieldPresentMap_Player_Weapon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func init() { file_tableau_protobuf_unittest_unittest_proto_init() }
-func file_tableau_protobuf_unittest_unittest_proto_init() {
-	if File_tableau_protobuf_unittest_unittest_proto != nil {
-		return
+func (x *FieldPresentMap_Player_Weapon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldPresentMap_Player_Weapon) ProtoMessage() {}
+
+func (x *FieldPresentMap_Player_Weapon) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	file_tableau_protobuf_unittest_common_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimpleIncellMap); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldPresentMap_Player_Weapon.ProtoReflect.Descriptor instead.
+func (*FieldPresentMap_Player_Weapon) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{24, 1, 0}
+}
+
+func (x *FieldPresentMap_Player_Weapon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldPresentMap_Player_Weapon) GetRarity() int32 {
+	if x != nil {
+		return x.Rarity
+	}
+	return 0
+}
+
+type FieldPresentMap_Player_Info struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Exp   int32 `protobuf:"varint,2,opt,name=exp,proto3" json:"exp,omitempty"`
+}
+
+func (x *FieldPresentMap_Player_Info) Reset() {
+	*x = FieldPresentMap_Player_Info{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldPresentMap_Player_Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldPresentMap_Player_Info) ProtoMessage() {}
+
+func (x *FieldPresentMap_Player_Info) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldPresentMap_Player_Info.ProtoReflect.Descriptor instead.
+func (*FieldPresentMap_Player_Info) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{24, 1, 1}
+}
+
+func (x *FieldPresentMap_Player_Info) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *FieldPresentMap_Player_Info) GetExp() int32 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+type ScatterNoneConf_Zone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ScatterNoneConf_Zone) Reset() {
+	*x = ScatterNoneConf_Zone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScatterNoneConf_Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScatterNoneConf_Zone) ProtoMessage() {}
+
+func (x *ScatterNoneConf_Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScatterNoneConf_Zone.ProtoReflect.Descriptor instead.
+func (*ScatterNoneConf_Zone) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{25, 1}
+}
+
+func (x *ScatterNoneConf_Zone) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScatterNoneConf_Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ScatterReplaceConf_Zone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ScatterReplaceConf_Zone) Reset() {
+	*x = ScatterReplaceConf_Zone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScatterReplaceConf_Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScatterReplaceConf_Zone) ProtoMessage() {}
+
+func (x *ScatterReplaceConf_Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScatterReplaceConf_Zone.ProtoReflect.Descriptor instead.
+func (*ScatterReplaceConf_Zone) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{26, 1}
+}
+
+func (x *ScatterReplaceConf_Zone) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScatterReplaceConf_Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ScatterMergeConf_Zone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ScatterMergeConf_Zone) Reset() {
+	*x = ScatterMergeConf_Zone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScatterMergeConf_Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScatterMergeConf_Zone) ProtoMessage() {}
+
+func (x *ScatterMergeConf_Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScatterMergeConf_Zone.ProtoReflect.Descriptor instead.
+func (*ScatterMergeConf_Zone) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *ScatterMergeConf_Zone) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScatterMergeConf_Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MergerSingleConf_Zone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MergerSingleConf_Zone) Reset() {
+	*x = MergerSingleConf_Zone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergerSingleConf_Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergerSingleConf_Zone) ProtoMessage() {}
+
+func (x *MergerSingleConf_Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergerSingleConf_Zone.ProtoReflect.Descriptor instead.
+func (*MergerSingleConf_Zone) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *MergerSingleConf_Zone) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MergerSingleConf_Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MergerMultiConf_Zone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MergerMultiConf_Zone) Reset() {
+	*x = MergerMultiConf_Zone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergerMultiConf_Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergerMultiConf_Zone) ProtoMessage() {}
+
+func (x *MergerMultiConf_Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergerMultiConf_Zone.ProtoReflect.Descriptor instead.
+func (*MergerMultiConf_Zone) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *MergerMultiConf_Zone) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MergerMultiConf_Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type VerticalAggregationMap_Hero struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeroId   uint32                                       `protobuf:"varint,1,opt,name=hero_id,json=heroId,proto3" json:"hero_id,omitempty"`      // Hero ID
+	HeroName string                                       `protobuf:"bytes,2,opt,name=hero_name,json=heroName,proto3" json:"hero_name,omitempty"` // Hero name
+	LevelMap map[int32]*VerticalAggregationMap_Hero_Level `protobuf:"bytes,3,rep,name=level_map,json=levelMap,proto3" json:"level_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *VerticalAggregationMap_Hero) Reset() {
+	*x = VerticalAggregationMap_Hero{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerticalAggregationMap_Hero) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerticalAggregationMap_Hero) ProtoMessage() {}
+
+func (x *VerticalAggregationMap_Hero) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerticalAggregationMap_Hero.ProtoReflect.Descriptor instead.
+func (*VerticalAggregationMap_Hero) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{30, 1}
+}
+
+func (x *VerticalAggregationMap_Hero) GetHeroId() uint32 {
+	if x != nil {
+		return x.HeroId
+	}
+	return 0
+}
+
+func (x *VerticalAggregationMap_Hero) GetHeroName() string {
+	if x != nil {
+		return x.HeroName
+	}
+	return ""
+}
+
+func (x *VerticalAggregationMap_Hero) GetLevelMap() map[int32]*VerticalAggregationMap_Hero_Level {
+	if x != nil {
+		return x.LevelMap
+	}
+	return nil
+}
+
+type VerticalAggregationMap_Hero_Level struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"` // Level
+	Exp   int32 `protobuf:"varint,2,opt,name=exp,proto3" json:"exp,omitempty"`     // Experience points
+}
+
+func (x *VerticalAggregationMap_Hero_Level) Reset() {
+	*x = VerticalAggregationMap_Hero_Level{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerticalAggregationMap_Hero_Level) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerticalAggregationMap_Hero_Level) ProtoMessage() {}
+
+func (x *VerticalAggregationMap_Hero_Level) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerticalAggregationMap_Hero_Level.ProtoReflect.Descriptor instead.
+func (*VerticalAggregationMap_Hero_Level) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{30, 1, 1}
+}
+
+func (x *VerticalAggregationMap_Hero_Level) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *VerticalAggregationMap_Hero_Level) GetExp() int32 {
+	if x != nil {
+		return x.Exp
+	}
+	return 0
+}
+
+type HorizontalAggregateMap_Hero struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeroId  uint32           `protobuf:"varint,1,opt,name=hero_id,json=heroId,proto3" json:"hero_id,omitempty"`
+	ItemMap map[uint32]*Item `protobuf:"bytes,2,rep,name=item_map,json=itemMap,proto3" json:"item_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HorizontalAggregateMap_Hero) Reset() {
+	*x = HorizontalAggregateMap_Hero{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HorizontalAggregateMap_Hero) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HorizontalAggregateMap_Hero) ProtoMessage() {}
+
+func (x *HorizontalAggregateMap_Hero) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HorizontalAggregateMap_Hero.ProtoReflect.Descriptor instead.
+func (*HorizontalAggregateMap_Hero) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (x *HorizontalAggregateMap_Hero) GetHeroId() uint32 {
+	if x != nil {
+		return x.HeroId
+	}
+	return 0
+}
+
+func (x *HorizontalAggregateMap_Hero) GetItemMap() map[uint32]*Item {
+	if x != nil {
+		return x.ItemMap
+	}
+	return nil
+}
+
+type HorizontalAggregateList_Hero struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeroId    uint32  `protobuf:"varint,1,opt,name=hero_id,json=heroId,proto3" json:"hero_id,omitempty"`
+	ParamList []*Item `protobuf:"bytes,2,rep,name=param_list,json=paramList,proto3" json:"param_list,omitempty"`
+}
+
+func (x *HorizontalAggregateList_Hero) Reset() {
+	*x = HorizontalAggregateList_Hero{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HorizontalAggregateList_Hero) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HorizontalAggregateList_Hero) ProtoMessage() {}
+
+func (x *HorizontalAggregateList_Hero) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HorizontalAggregateList_Hero.ProtoReflect.Descriptor instead.
+func (*HorizontalAggregateList_Hero) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{33, 1}
+}
+
+func (x *HorizontalAggregateList_Hero) GetHeroId() uint32 {
+	if x != nil {
+		return x.HeroId
+	}
+	return 0
+}
+
+func (x *HorizontalAggregateList_Hero) GetParamList() []*Item {
+	if x != nil {
+		return x.ParamList
+	}
+	return nil
+}
+
+type RuleConf_Reward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type    int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	ItemId  uint32 `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	EquipId uint32 `protobuf:"varint,4,opt,name=equip_id,json=equipId,proto3" json:"equip_id,omitempty"`
+}
+
+func (x *RuleConf_Reward) Reset() {
+	*x = RuleConf_Reward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleConf_Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleConf_Reward) ProtoMessage() {}
+
+func (x *RuleConf_Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleConf_Reward.ProtoReflect.Descriptor instead.
+func (*RuleConf_Reward) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{34, 1}
+}
+
+func (x *RuleConf_Reward) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RuleConf_Reward) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *RuleConf_Reward) GetItemId() uint32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *RuleConf_Reward) GetEquipId() uint32 {
+	if x != nil {
+		return x.EquipId
+	}
+	return 0
+}
+
+type RuleConf_Level struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *RuleConf_Level) Reset() {
+	*x = RuleConf_Level{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleConf_Level) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleConf_Level) ProtoMessage() {}
+
+func (x *RuleConf_Level) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleConf_Level.ProtoReflect.Descriptor instead.
+func (*RuleConf_Level) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{34, 2}
+}
+
+func (x *RuleConf_Level) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RuleConf_Level) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type VectorConf_Spawn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pos        *tableaupb.Vector3    `protobuf:"bytes,2,opt,name=pos,proto3" json:"pos,omitempty"`
+	PointList  []*tableaupb.Vector2I `protobuf:"bytes,3,rep,name=point_list,json=pointList,proto3" json:"point_list,omitempty"`
+	OffsetList []*tableaupb.Vector2I `protobuf:"bytes,4,rep,name=offset_list,json=offsetList,proto3" json:"offset_list,omitempty"`
+}
+
+func (x *VectorConf_Spawn) Reset() {
+	*x = VectorConf_Spawn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorConf_Spawn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorConf_Spawn) ProtoMessage() {}
+
+func (x *VectorConf_Spawn) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorConf_Spawn.ProtoReflect.Descriptor instead.
+func (*VectorConf_Spawn) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{36, 1}
+}
+
+func (x *VectorConf_Spawn) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VectorConf_Spawn) GetPos() *tableaupb.Vector3 {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+func (x *VectorConf_Spawn) GetPointList() []*tableaupb.Vector2I {
+	if x != nil {
+		return x.PointList
+	}
+	return nil
+}
+
+func (x *VectorConf_Spawn) GetOffsetList() []*tableaupb.Vector2I {
+	if x != nil {
+		return x.OffsetList
+	}
+	return nil
+}
+
+type DecimalConf_Goods struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint32             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price *tableaupb.Decimal `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *DecimalConf_Goods) Reset() {
+	*x = DecimalConf_Goods{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecimalConf_Goods) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecimalConf_Goods) ProtoMessage() {}
+
+func (x *DecimalConf_Goods) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecimalConf_Goods.ProtoReflect.Descriptor instead.
+func (*DecimalConf_Goods) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{37, 1}
+}
+
+func (x *DecimalConf_Goods) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DecimalConf_Goods) GetPrice() *tableaupb.Decimal {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ColorConf_Theme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint32             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Color   *tableaupb.Color   `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Palette []*tableaupb.Color `protobuf:"bytes,3,rep,name=palette,proto3" json:"palette,omitempty"`
+}
+
+func (x *ColorConf_Theme) Reset() {
+	*x = ColorConf_Theme{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorConf_Theme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorConf_Theme) ProtoMessage() {}
+
+func (x *ColorConf_Theme) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorConf_Theme.ProtoReflect.Descriptor instead.
+func (*ColorConf_Theme) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{38, 1}
+}
+
+func (x *ColorConf_Theme) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ColorConf_Theme) GetColor() *tableaupb.Color {
+	if x != nil {
+		return x.Color
+	}
+	return nil
+}
+
+func (x *ColorConf_Theme) GetPalette() []*tableaupb.Color {
+	if x != nil {
+		return x.Palette
+	}
+	return nil
+}
+
+type IntervalConf_Bracket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Level    *tableaupb.Interval          `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	RollList []*tableaupb.DoubleInterval  `protobuf:"bytes,3,rep,name=roll_list,json=rollList,proto3" json:"roll_list,omitempty"`
+	Window   *tableaupb.TimestampInterval `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *IntervalConf_Bracket) Reset() {
+	*x = IntervalConf_Bracket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntervalConf_Bracket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntervalConf_Bracket) ProtoMessage() {}
+
+func (x *IntervalConf_Bracket) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntervalConf_Bracket.ProtoReflect.Descriptor instead.
+func (*IntervalConf_Bracket) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{39, 1}
+}
+
+func (x *IntervalConf_Bracket) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IntervalConf_Bracket) GetLevel() *tableaupb.Interval {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+func (x *IntervalConf_Bracket) GetRollList() []*tableaupb.DoubleInterval {
+	if x != nil {
+		return x.RollList
+	}
+	return nil
+}
+
+func (x *IntervalConf_Bracket) GetWindow() *tableaupb.TimestampInterval {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type WeightConf_Drop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Weight    int32   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	CumWeight int32   `protobuf:"varint,3,opt,name=cum_weight,json=cumWeight,proto3" json:"cum_weight,omitempty"`
+	Prob      float64 `protobuf:"fixed64,4,opt,name=prob,proto3" json:"prob,omitempty"`
+}
+
+func (x *WeightConf_Drop) Reset() {
+	*x = WeightConf_Drop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeightConf_Drop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightConf_Drop) ProtoMessage() {}
+
+func (x *WeightConf_Drop) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightConf_Drop.ProtoReflect.Descriptor instead.
+func (*WeightConf_Drop) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{40, 1}
+}
+
+func (x *WeightConf_Drop) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WeightConf_Drop) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *WeightConf_Drop) GetCumWeight() int32 {
+	if x != nil {
+		return x.CumWeight
+	}
+	return 0
+}
+
+func (x *WeightConf_Drop) GetProb() float64 {
+	if x != nil {
+		return x.Prob
+	}
+	return 0
+}
+
+type FlagsConf_Activity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Platforms uint64 `protobuf:"varint,2,opt,name=platforms,proto3" json:"platforms,omitempty"`
+}
+
+func (x *FlagsConf_Activity) Reset() {
+	*x = FlagsConf_Activity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagsConf_Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagsConf_Activity) ProtoMessage() {}
+
+func (x *FlagsConf_Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagsConf_Activity.ProtoReflect.Descriptor instead.
+func (*FlagsConf_Activity) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{41, 1}
+}
+
+func (x *FlagsConf_Activity) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FlagsConf_Activity) GetPlatforms() uint64 {
+	if x != nil {
+		return x.Platforms
+	}
+	return 0
+}
+
+type ScheduleConf_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint32              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reset_ *tableaupb.Schedule `protobuf:"bytes,2,opt,name=reset,proto3" json:"reset,omitempty"`
+	Open   *tableaupb.Schedule `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
+}
+
+func (x *ScheduleConf_Event) Reset() {
+	*x = ScheduleConf_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleConf_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConf_Event) ProtoMessage() {}
+
+func (x *ScheduleConf_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConf_Event.ProtoReflect.Descriptor instead.
+func (*ScheduleConf_Event) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{42, 1}
+}
+
+func (x *ScheduleConf_Event) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduleConf_Event) GetReset_() *tableaupb.Schedule {
+	if x != nil {
+		return x.Reset_
+	}
+	return nil
+}
+
+func (x *ScheduleConf_Event) GetOpen() *tableaupb.Schedule {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+type TextConf_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Desc string `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+}
+
+func (x *TextConf_Item) Reset() {
+	*x = TextConf_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextConf_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextConf_Item) ProtoMessage() {}
+
+func (x *TextConf_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextConf_Item.ProtoReflect.Descriptor instead.
+func (*TextConf_Item) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{43, 1}
+}
+
+func (x *TextConf_Item) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TextConf_Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TextConf_Item) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type StringFormatConf_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Guid    string `protobuf:"bytes,2,opt,name=guid,proto3" json:"guid,omitempty"`
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Link    string `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *StringFormatConf_Filter) Reset() {
+	*x = StringFormatConf_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringFormatConf_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringFormatConf_Filter) ProtoMessage() {}
+
+func (x *StringFormatConf_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringFormatConf_Filter.ProtoReflect.Descriptor instead.
+func (*StringFormatConf_Filter) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{44, 1}
+}
+
+func (x *StringFormatConf_Filter) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StringFormatConf_Filter) GetGuid() string {
+	if x != nil {
+		return x.Guid
+	}
+	return ""
+}
+
+func (x *StringFormatConf_Filter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *StringFormatConf_Filter) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type ComputeConf_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Base     int32  `protobuf:"varint,2,opt,name=base,proto3" json:"base,omitempty"`
+	Bonus    int32  `protobuf:"varint,3,opt,name=bonus,proto3" json:"bonus,omitempty"`
+	Total    int32  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	MaxTotal int32  `protobuf:"varint,5,opt,name=max_total,json=maxTotal,proto3" json:"max_total,omitempty"`
+}
+
+func (x *ComputeConf_Item) Reset() {
+	*x = ComputeConf_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeConf_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeConf_Item) ProtoMessage() {}
+
+func (x *ComputeConf_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeConf_Item.ProtoReflect.Descriptor instead.
+func (*ComputeConf_Item) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{45, 1}
+}
+
+func (x *ComputeConf_Item) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ComputeConf_Item) GetBase() int32 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *ComputeConf_Item) GetBonus() int32 {
+	if x != nil {
+		return x.Bonus
+	}
+	return 0
+}
+
+func (x *ComputeConf_Item) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ComputeConf_Item) GetMaxTotal() int32 {
+	if x != nil {
+		return x.MaxTotal
+	}
+	return 0
+}
+
+type UniqueDomainMapConf_Reward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RewardId uint32 `protobuf:"varint,1,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	Num      int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+}
+
+func (x *UniqueDomainMapConf_Reward) Reset() {
+	*x = UniqueDomainMapConf_Reward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniqueDomainMapConf_Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniqueDomainMapConf_Reward) ProtoMessage() {}
+
+func (x *UniqueDomainMapConf_Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniqueDomainMapConf_Reward.ProtoReflect.Descriptor instead.
+func (*UniqueDomainMapConf_Reward) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{46, 1}
+}
+
+func (x *UniqueDomainMapConf_Reward) GetRewardId() uint32 {
+	if x != nil {
+		return x.RewardId
+	}
+	return 0
+}
+
+func (x *UniqueDomainMapConf_Reward) GetNum() int32 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type UniqueDomainListConf_Reward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RewardId uint32 `protobuf:"varint,1,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	Num      int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"`
+}
+
+func (x *UniqueDomainListConf_Reward) Reset() {
+	*x = UniqueDomainListConf_Reward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniqueDomainListConf_Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniqueDomainListConf_Reward) ProtoMessage() {}
+
+func (x *UniqueDomainListConf_Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_unittest_unittest_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniqueDomainListConf_Reward.ProtoReflect.Descriptor instead.
+func (*UniqueDomainListConf_Reward) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *UniqueDomainListConf_Reward) GetRewardId() uint32 {
+	if x != nil {
+		return x.RewardId
+	}
+	return 0
+}
+
+func (x *UniqueDomainListConf_Reward) GetNum() int32 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+var File_tableau_protobuf_unittest_unittest_proto protoreflect.FileDescriptor
+
+var file_tableau_protobuf_unittest_unittest_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x75, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x75, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x26, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x65, 0x6c,
+	0x6c, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a,
+	0x0f, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70,
+	0x12, 0x54, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x20, 0x03, 0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52, 0x07, 0x69,
+	0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x3a, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x3a, 0x1d, 0x82, 0xb5, 0x18, 0x19, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x22, 0xf0, 0x05, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x12,
+	0x52, 0x0a, 0x09, 0x66, 0x72, 0x75, 0x69, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x05, 0x46, 0x72, 0x75,
+	0x69, 0x74, 0x1a, 0x03, 0x4b, 0x65, 0x79, 0x20, 0x03, 0x52, 0x08, 0x66, 0x72, 0x75, 0x69, 0x74,
+	0x4d, 0x61, 0x70, 0x12, 0x51, 0x0a, 0x0a, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f, 0x6d, 0x61,
+	0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x6c, 0x61,
+	0x76, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18,
+	0x0a, 0x0a, 0x06, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x20, 0x03, 0x52, 0x09, 0x66, 0x6c, 0x61,
+	0x76, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x4e, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d,
+	0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x03, 0x4b, 0x65, 0x79, 0x20, 0x03, 0x52, 0x07, 0x69,
+	0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x56, 0x0a, 0x0d, 0x46, 0x72, 0x75, 0x69, 0x74, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x46, 0x72,
+	0x75, 0x69, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c,
+	0x0a, 0x05, 0x46, 0x72, 0x75, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x72, 0x75, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a,
+	0x03, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x53, 0x0a, 0x0e,
+	0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74,
+	0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x54, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x72, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x30, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x75,
+	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69,
+	0x74, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x17, 0x82, 0xb5, 0x18,
+	0x13, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x10, 0x01, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x04, 0x22, 0x72, 0x0a, 0x10, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x11, 0x82, 0xb5, 0x18,
+	0x0d, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x20, 0x03, 0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52, 0x08,
+	0x69, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x1e, 0x82, 0xb5, 0x18, 0x1a, 0x0a, 0x10,
+	0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0d, 0x82, 0xb5, 0x18,
+	0x09, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0b, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x75, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72, 0x75, 0x69, 0x74, 0x46, 0x6c, 0x61, 0x76, 0x6f,
+	0x72, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x06, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x20,
+	0x03, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x20, 0x03, 0x52, 0x08,
+	0x69, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x18, 0x82, 0xb5, 0x18, 0x14, 0x0a, 0x0a,
+	0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x22, 0xb6, 0x01, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x46, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07,
+	0x69, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x4a, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x3a, 0x16, 0x82, 0xb5, 0x18, 0x12, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x43,
+	0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xfa, 0x03, 0x0a, 0x08,
+	0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70,
+	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53,
+	0x68, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18,
+	0x0a, 0x1a, 0x06, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x73, 0x68, 0x6f,
+	0x70, 0x4d, 0x61, 0x70, 0x1a, 0x53, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb4, 0x02, 0x0a, 0x04, 0x53, 0x68,
+	0x6f, 0x70, 0x12, 0x25, 0x0a, 0x07, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x53, 0x68, 0x6f, 0x70, 0x49,
+	0x44, 0x52, 0x06, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x09, 0x67, 0x6f, 0x6f,
+	0x64, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75,
+	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x1a, 0x07, 0x47, 0x6f, 0x6f, 0x64, 0x73,
+	0x49, 0x44, 0x20, 0x01, 0x52, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x1a, 0x5a,
+	0x0a, 0x0d, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6c, 0x6c,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a, 0x05, 0x47, 0x6f,
+	0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x07, 0x47, 0x6f, 0x6f,
+	0x64, 0x73, 0x49, 0x44, 0x52, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x82, 0xb5,
+	0x18, 0x07, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x3a, 0x16, 0x82, 0xb5, 0x18, 0x12, 0x0a, 0x08, 0x4d, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0x9b, 0x09, 0x0a, 0x0c, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x5e, 0x0a, 0x0c, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x1a, 0x0a,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x44, 0x20, 0x01, 0x52, 0x0b, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x70, 0x1a, 0x5f, 0x0a, 0x10, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xad, 0x07, 0x0a, 0x08, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x82, 0xb5,
+	0x18, 0x0c, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0d, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x1a,
+	0x09, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x44, 0x20, 0x01, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x66, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75,
+	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0xe7, 0x04, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49,
+	0x44, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x5a, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x20,
+	0x01, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x99,
+	0x03, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f,
+	0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x52,
+	0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x71, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x02, 0x52, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4d, 0x61, 0x70, 0x1a, 0x74, 0x0a, 0x0e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x06, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05,
+	0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x3a, 0x1a, 0x82, 0xb5, 0x18, 0x16,
+	0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xb2, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x54, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10,
+	0x82, 0xb5, 0x18, 0x0c, 0x1a, 0x08, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x20, 0x01,
+	0x52, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x1a, 0x59, 0x0a, 0x0e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xd8, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x2b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x55,
+	0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x02, 0x52, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x4a, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x3a, 0x18, 0x82, 0xb5, 0x18, 0x14, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xd5, 0x02, 0x0a, 0x0e,
+	0x59, 0x61, 0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04,
+	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4e, 0x75, 0x6d,
+	0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x21, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x52, 0x05, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x04, 0x62,
+	0x6c, 0x6f, 0x62, 0x12, 0x18, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x52, 0x02, 0x6f, 0x6b, 0x3a, 0x14, 0x82,
+	0xb5, 0x18, 0x10, 0x0a, 0x0e, 0x59, 0x61, 0x6d, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0d, 0x82, 0xb5,
+	0x18, 0x09, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x20, 0x03, 0x52, 0x09, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x21, 0x82, 0xb5, 0x18, 0x1d, 0x0a, 0x10, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0xa8, 0x01, 0x01, 0x22, 0xcc, 0x06, 0x0a, 0x0e, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x1e, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x82, 0xb5, 0x18,
+	0x07, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x12,
+	0x26, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
+	0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x33, 0x48, 0x00, 0x52, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x33, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09,
+	0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x20, 0x03, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05,
+	0x42, 0x18, 0x82, 0xb5, 0x18, 0x14, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x20, 0x03, 0x7a, 0x02, 0x60, 0x01, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x08,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x04, 0x49, 0x74, 0x65,
+	0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x02, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70,
+	0x12, 0x73, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1b, 0x82, 0xb5, 0x18, 0x17, 0x0a, 0x0b,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20,
+	0x02, 0x7a, 0x02, 0x60, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x86, 0x01, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3f, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x1a, 0x4a,
+	0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x51, 0x0a, 0x13, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x1f, 0x82,
+	0xb5, 0x18, 0x1b, 0x0a, 0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0xa8, 0x01, 0x02, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x33, 0x22, 0xaa, 0x0b, 0x0a, 0x12, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x54, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x53, 0x68, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5,
+	0x18, 0x0a, 0x1a, 0x06, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x73, 0x68,
+	0x6f, 0x70, 0x4d, 0x61, 0x70, 0x1a, 0x5d, 0x0a, 0x0c, 0x53, 0x68, 0x6f, 0x70, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb9, 0x09, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x70, 0x12, 0x25, 0x0a,
+	0x07, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c,
+	0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x44, 0x52, 0x06, 0x73, 0x68,
+	0x6f, 0x70, 0x49, 0x64, 0x12, 0x5d, 0x0a, 0x09, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x5f, 0x6d, 0x61,
+	0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x1a, 0x07,
+	0x47, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x44, 0x20, 0x01, 0x52, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73,
+	0x4d, 0x61, 0x70, 0x1a, 0x64, 0x0a, 0x0d, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xc4, 0x07, 0x0a, 0x05, 0x47, 0x6f,
+	0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x07, 0x47, 0x6f, 0x6f,
+	0x64, 0x73, 0x49, 0x44, 0x52, 0x07, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0a, 0x82, 0xb5, 0x18,
+	0x06, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x73, 0x0a,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x16, 0x82,
+	0xb5, 0x18, 0x12, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x20, 0x02, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4d,
+	0x61, 0x70, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0c, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x20,
+	0x02, 0x7a, 0x02, 0x60, 0x01, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5b,
+	0x0a, 0x0a, 0x61, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x41, 0x77, 0x61, 0x72,
+	0x64, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x20, 0x02,
+	0x52, 0x09, 0x61, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x70, 0x0a, 0x10, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x46, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53,
+	0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xc0, 0x03,
+	0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x42, 0x11,
+	0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x20, 0x03, 0x7a, 0x02, 0x60,
+	0x01, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6d, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53,
+	0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x03,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x75, 0x0a, 0x0c, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x41, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x53, 0x68, 0x6f, 0x70, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x20, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3e, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3e, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x64, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d,
+	0x3a, 0x23, 0x82, 0xb5, 0x18, 0x1f, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76,
+	0x65, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0xa8, 0x01, 0x02, 0x22, 0xa6, 0x02, 0x0a, 0x10, 0x4a, 0x73, 0x6f, 0x6e, 0x55, 0x74,
+	0x69, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x0c, 0x6e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x0b, 0x6e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x45, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4a,
+	0x73, 0x6f, 0x6e, 0x55, 0x74, 0x69, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x2e,
+	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d,
+	0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x55, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x87,
+	0x02, 0x0a, 0x1f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e,
+	0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x53, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x56, 0x65,
+	0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02, 0x20, 0x01, 0x52, 0x08, 0x69,
+	0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x68, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x1c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18,
+	0x08, 0x0a, 0x02, 0x49, 0x44, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18,
+	0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x52, 0x04, 0x64, 0x65, 0x73,
+	0x63, 0x3a, 0x25, 0x82, 0xb5, 0x18, 0x21, 0x0a, 0x1f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc0, 0x06, 0x0a, 0x1c, 0x56, 0x65, 0x72,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x5e, 0x0a, 0x08, 0x6d, 0x61, 0x69,
+	0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x20, 0x01,
+	0x52, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x1a, 0x67, 0x0a, 0x0c, 0x4d, 0x61, 0x69,
+	0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d,
+	0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0xb2, 0x04, 0x0a, 0x04, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x6d,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5,
+	0x18, 0x08, 0x0a, 0x06, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x06, 0x6d, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x08, 0x4d, 0x61, 0x69,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x6e, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6b, 0x76, 0x5f, 0x6d,
+	0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70,
+	0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x4b, 0x76, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x06, 0x4d, 0x61, 0x69, 0x6e,
+	0x4b, 0x56, 0x20, 0x03, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x69, 0x6e, 0x4b, 0x76,
+	0x4d, 0x61, 0x70, 0x12, 0x5f, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69,
+	0x6e, 0x2e, 0x53, 0x75, 0x62, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0d, 0x82,
+	0xb5, 0x18, 0x09, 0x1a, 0x05, 0x53, 0x75, 0x62, 0x49, 0x44, 0x20, 0x01, 0x52, 0x06, 0x73, 0x75,
+	0x62, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6e, 0x4b, 0x76, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x6a, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65,
+	0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x2e,
+	0x53, 0x75, 0x62, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57,
+	0x0a, 0x03, 0x53, 0x75, 0x62, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x53, 0x75, 0x62,
+	0x49, 0x44, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x75, 0x62,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x82, 0xb5, 0x18,
+	0x0d, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x22, 0x82, 0xb5, 0x18, 0x1e, 0x0a, 0x1c, 0x56,
+	0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x22, 0x83, 0x02, 0x0a, 0x1d,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x56, 0x0a,
+	0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0b,
+	0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x08, 0x69, 0x74, 0x65,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x65, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a,
+	0x02, 0x49, 0x44, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82, 0xb5,
+	0x18, 0x05, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x3a, 0x23, 0x82, 0xb5,
+	0x18, 0x1f, 0x0a, 0x1d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x9b, 0x12, 0x0a, 0x1c, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d,
+	0x61, 0x70, 0x12, 0x66, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0x82, 0xb5, 0x18, 0x13, 0x0a, 0x07,
+	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58,
+	0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x0a, 0x73, 0x63,
+	0x61, 0x6c, 0x61, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x13, 0x82, 0xb5, 0x18, 0x0f, 0x0a, 0x09, 0x53, 0x63, 0x61,
+	0x6c, 0x61, 0x72, 0x4d, 0x61, 0x70, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x6b, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x75, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61,
+	0x70, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x15, 0x82, 0xb5, 0x18, 0x11, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d, 0x61,
+	0x70, 0x20, 0x03, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4d,
+	0x61, 0x70, 0x12, 0x77, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70,
+	0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x1b, 0x82, 0xb5, 0x18, 0x17, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x6a, 0x0a, 0x0c, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x44, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75,
+	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x80, 0x03, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x10, 0x01,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x75, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d,
+	0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x13, 0x82, 0xb5, 0x18, 0x0f, 0x0a, 0x07, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x52, 0x07, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x72, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x07, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x10,
+	0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x63,
+	0x61, 0x6c, 0x61, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x72, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xbe, 0x08, 0x0a, 0x0b, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40,
+	0x6b, 0x65, 0x79, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x72, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x17, 0x82, 0xb5, 0x18, 0x13, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x07, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x76, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x50, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xfc, 0x05, 0x0a,
+	0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65,
+	0x79, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x7a,
+	0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x47, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0x82, 0xb5, 0x18, 0x13, 0x0a, 0x07,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58,
+	0x01, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x7e, 0x0a, 0x0c, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x58, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xae, 0x03, 0x0a, 0x07, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a,
+	0x02, 0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x82, 0x01, 0x0a,
+	0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x17, 0x82, 0xb5, 0x18, 0x13, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x04,
+	0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02, 0x58, 0x01, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x86, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x60, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x07, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x40, 0x6b, 0x65, 0x79, 0x7a, 0x02,
+	0x10, 0x01, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x22, 0x82, 0xb5, 0x18,
+	0x1e, 0x0a, 0x1c, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x22,
+	0x8b, 0x02, 0x0a, 0x21, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0x82, 0xb5, 0x18, 0x02,
+	0x20, 0x01, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x66, 0x0a, 0x04,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x02, 0x49, 0x44, 0x7a, 0x02, 0x20, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x7a, 0x03, 0x20, 0xb9, 0x60, 0x52,
+	0x03, 0x6e, 0x75, 0x6d, 0x3a, 0x27, 0x82, 0xb5, 0x18, 0x23, 0x0a, 0x21, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xf8, 0x03,
+	0x0a, 0x1e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56,
+	0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x56, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x08,
+	0x69, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0xd7, 0x02, 0x0a, 0x04, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x1c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82,
+	0xb5, 0x18, 0x08, 0x0a, 0x02, 0x49, 0x44, 0x7a, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x65, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82,
+	0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x50, 0x72, 0x6f, 0x70, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x1a, 0x6e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x04, 0x50, 0x72, 0x6f, 0x70, 0x12, 0x25,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x70, 0x49, 0x44, 0x52, 0x06, 0x70,
+	0x72, 0x6f, 0x70, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x3a, 0x24, 0x82, 0xb5, 0x18, 0x20, 0x0a, 0x1e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x4b,
+	0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xf2, 0x04, 0x0a, 0x1e, 0x56, 0x65, 0x72,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x60, 0x0a, 0x08, 0x6d,
+	0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x4d, 0x61, 0x69, 0x6e,
+	0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x1a, 0x69, 0x0a,
+	0x0c, 0x4d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x43, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xdc, 0x02, 0x0a, 0x04, 0x4d, 0x61, 0x69,
+	0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x06, 0x4d, 0x61, 0x69, 0x6e, 0x49, 0x44,
+	0x7a, 0x03, 0x20, 0xe9, 0x07, 0x52, 0x06, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x61, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x75,
+	0x62, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x1a,
+	0x05, 0x53, 0x75, 0x62, 0x49, 0x44, 0x20, 0x01, 0x52, 0x06, 0x73, 0x75, 0x62, 0x4d, 0x61, 0x70,
+	0x1a, 0x6c, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x2e,
+	0x53, 0x75, 0x62, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57,
+	0x0a, 0x03, 0x53, 0x75, 0x62, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x05, 0x53, 0x75, 0x62,
+	0x49, 0x44, 0x7a, 0x02, 0x20, 0x01, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x28, 0x0a,
+	0x08, 0x73, 0x75, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x24, 0x82, 0xb5, 0x18, 0x20, 0x0a, 0x1e, 0x56,
+	0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x22, 0x86, 0x02,
+	0x0a, 0x1f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x58, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x62, 0x0a, 0x04, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x02, 0x49, 0x44, 0x7a, 0x03, 0x20, 0xe9, 0x07, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x3a,
+	0x25, 0x82, 0xb5, 0x18, 0x21, 0x0a, 0x1f, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6d, 0x61, 0x70,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x72, 0x6f,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02,
+	0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x1a, 0x54, 0x0a,
+	0x0c, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x12, 0x18, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x09, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x60, 0x01, 0x22, 0x98, 0x03, 0x0a, 0x0c, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x61,
+	0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48,
+	0x05, 0x92, 0x01, 0x02, 0x10, 0x03, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x20,
+	0x03, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75,
+	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x14, 0xba, 0x48, 0x05, 0x9a, 0x01, 0x02, 0x10, 0x02, 0x82, 0xb5, 0x18, 0x08, 0x0a,
+	0x04, 0x50, 0x72, 0x6f, 0x70, 0x20, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x84, 0x01, 0xba,
+	0x48, 0x67, 0x1a, 0x65, 0x0a, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x2e, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x6e, 0x61, 0x6d,
+	0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6e, 0x6f, 0x6e, 0x2d, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x64, 0x20, 0x69, 0x73, 0x20, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x64,
+	0x20, 0x3d, 0x3d, 0x20, 0x30, 0x75, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0x82, 0xb5, 0x18, 0x16, 0x0a, 0x0c, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x22, 0x99, 0x02, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66,
+	0x12, 0x46, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52,
+	0x07, 0x74, 0x61, 0x73, 0x6b, 0x4d, 0x61, 0x70, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a,
+	0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x36, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x3a, 0x16, 0x82, 0xb5, 0x18, 0x12, 0x0a, 0x08, 0x54,
+	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22,
+	0xc5, 0x06, 0x0a, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x4d, 0x61, 0x70, 0x12, 0x53, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x4d,
+	0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x5e, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe5, 0x04, 0x0a, 0x06, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x51, 0x0a,
+	0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
+	0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x06, 0x57, 0x65,
+	0x61, 0x70, 0x6f, 0x6e, 0x7a, 0x02, 0x50, 0x01, 0x52, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e,
+	0x12, 0x4b, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x04, 0x49, 0x6e, 0x66,
+	0x6f, 0x28, 0x02, 0x7a, 0x02, 0x50, 0x01, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a,
+	0x09, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05,
+	0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x20, 0x03, 0x7a, 0x02,
+	0x50, 0x01, 0x52, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x41, 0x74, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10, 0x82,
+	0xb5, 0x18, 0x0c, 0x0a, 0x04, 0x41, 0x74, 0x74, 0x72, 0x20, 0x03, 0x7a, 0x02, 0x50, 0x01, 0x52,
+	0x07, 0x61, 0x74, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x3e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x14, 0x82, 0xb5, 0x18, 0x10,
+	0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x28, 0x02, 0x7a, 0x04, 0x40, 0x01, 0x50, 0x01,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x1a, 0x52, 0x0a, 0x06, 0x57, 0x65, 0x61, 0x70,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a, 0x02, 0x50, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06, 0x52, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x46, 0x0a, 0x04,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x70, 0x52,
+	0x03, 0x65, 0x78, 0x70, 0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x72, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x3a, 0x15, 0x82, 0xb5, 0x18, 0x11, 0x0a, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x22, 0xc4, 0x02, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4d, 0x0a, 0x08, 0x7a,
+	0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20,
+	0x01, 0x52, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5a, 0x0a, 0x0c, 0x5a, 0x6f,
+	0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04,
+	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x44, 0x82, 0xb5, 0x18, 0x40, 0x0a, 0x0f,
+	0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x10,
+	0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x9a, 0x01, 0x24, 0x55, 0x6e, 0x69, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x2a, 0x2e, 0x63, 0x73, 0x76, 0x23, 0x53,
+	0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xd6,
+	0x02, 0x0a, 0x12, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x50, 0x0a, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07,
+	0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5d, 0x0a, 0x0c, 0x5a, 0x6f, 0x6e, 0x65, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04,
+	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x4d, 0x82, 0xb5, 0x18, 0x49, 0x0a, 0x12,
+	0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x9a, 0x01, 0x27, 0x55, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x2a, 0x2e, 0x63, 0x73,
+	0x76, 0x23, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0xa8, 0x01, 0x01, 0x22, 0xcc, 0x02, 0x0a, 0x10, 0x53, 0x63, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4e, 0x0a, 0x08,
+	0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49,
+	0x44, 0x20, 0x01, 0x52, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5b, 0x0a, 0x0c,
+	0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e,
+	0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82,
+	0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x49, 0x82, 0xb5, 0x18,
+	0x45, 0x0a, 0x10, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x9a, 0x01, 0x25, 0x55, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x2a, 0x2e, 0x63,
+	0x73, 0x76, 0x23, 0x53, 0x63, 0x61, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0xa8, 0x01, 0x02, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4e, 0x0a, 0x08, 0x7a,
+	0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44,
+	0x20, 0x01, 0x52, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5b, 0x0a, 0x0c, 0x5a,
+	0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75,
+	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5,
+	0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x45, 0x82, 0xb5, 0x18, 0x41,
+	0x0a, 0x10, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x72, 0x25, 0x55, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x31, 0x2a, 0x2e, 0x63, 0x73, 0x76,
+	0x23, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x22, 0xc2, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4d, 0x0a, 0x08, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x7a, 0x6f, 0x6e,
+	0x65, 0x4d, 0x61, 0x70, 0x1a, 0x5a, 0x0a, 0x0c, 0x5a, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x40, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x3a, 0x42, 0x82, 0xb5, 0x18, 0x3e, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x72, 0x23, 0x55, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x72, 0x2a, 0x2e, 0x63, 0x73, 0x76, 0x23, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x72, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xe4, 0x04, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
+	0x70, 0x12, 0x58, 0x0a, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x48, 0x65, 0x72, 0x6f, 0x49, 0x44,
+	0x20, 0x01, 0x52, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x1a, 0x61, 0x0a, 0x0c, 0x48,
+	0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75,
+	0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x2e, 0x48,
+	0x65, 0x72, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xee,
+	0x02, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x12, 0x25, 0x0a, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0c, 0x82, 0xb5, 0x18, 0x08, 0x0a, 0x06,
+	0x48, 0x65, 0x72, 0x6f, 0x49, 0x44, 0x52, 0x06, 0x68, 0x65, 0x72, 0x6f, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x09, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x08, 0x48, 0x65, 0x72, 0x6f, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5f, 0x0a, 0x09, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70,
+	0x2e, 0x48, 0x65, 0x72, 0x6f, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x1a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x20, 0x01, 0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x1a, 0x68, 0x0a, 0x0d,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70,
+	0x2e, 0x48, 0x65, 0x72, 0x6f, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x21, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b,
+	0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x70, 0x52, 0x03, 0x65, 0x78, 0x70, 0x3a,
+	0x1c, 0x82, 0xb5, 0x18, 0x18, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x70, 0x22, 0xe8, 0x01,
+	0x0a, 0x0f, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x07, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0d, 0x42, 0x13, 0x82, 0xb5, 0x18, 0x0f, 0x0a, 0x02, 0x49, 0x44, 0x1a, 0x02, 0x49, 0x44,
+	0x20, 0x03, 0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52, 0x06, 0x69, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x44, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x72,
+	0x75, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x1a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x20, 0x03, 0x52, 0x08, 0x74, 0x79, 0x70,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x15, 0x82, 0xb5, 0x18, 0x11, 0x0a, 0x04,
+	0x49, 0x74, 0x65, 0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x03, 0x7a, 0x03, 0xa8, 0x01, 0x01, 0x52,
+	0x08, 0x69, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x1d, 0x82, 0xb5, 0x18, 0x19, 0x0a,
+	0x0f, 0x49, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x4b, 0x65, 0x79, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xe1, 0x03, 0x0a, 0x16, 0x48, 0x6f, 0x72,
+	0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x70, 0x12, 0x58, 0x0a, 0x08, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x6d, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x1a, 0x06, 0x48, 0x65, 0x72, 0x6f,
+	0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x1a, 0x61, 0x0a,
+	0x0c, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f,
+	0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70,
+	0x2e, 0x48, 0x65, 0x72, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0xe3, 0x01, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x12, 0x29, 0x0a, 0x07, 0x68, 0x65, 0x72,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c,
+	0x0a, 0x06, 0x48, 0x65, 0x72, 0x6f, 0x49, 0x44, 0x7a, 0x02, 0x10, 0x00, 0x52, 0x06, 0x68, 0x65,
+	0x72, 0x6f, 0x49, 0x64, 0x12, 0x64, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x15, 0x82, 0xb5, 0x18, 0x11,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x02, 0x7a, 0x03, 0xa8, 0x01,
+	0x01, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x4a, 0x0a, 0x0c, 0x49, 0x74,
+	0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x6e,
+	0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x24, 0x82, 0xb5, 0x18, 0x20, 0x0a, 0x16, 0x48, 0x6f,
+	0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x70, 0x10, 0x01, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x22, 0xf5, 0x02, 0x0a,
+	0x17, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x08, 0x68, 0x65, 0x72, 0x6f,
+	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x48, 0x65,
+	0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a,
+	0x1a, 0x06, 0x48, 0x65, 0x72, 0x6f, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x68, 0x65, 0x72, 0x6f,
+	0x4d, 0x61, 0x70, 0x1a, 0x62, 0x0a, 0x0c, 0x48, 0x65, 0x72, 0x6f, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x72, 0x6f, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x74, 0x0a, 0x04, 0x48, 0x65, 0x72, 0x6f, 0x12,
+	0x29, 0x0a, 0x07, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x06, 0x48, 0x65, 0x72, 0x6f, 0x49, 0x44, 0x7a, 0x02,
+	0x10, 0x00, 0x52, 0x06, 0x68, 0x65, 0x72, 0x6f, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x12,
+	0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x02, 0x7a, 0x03, 0xa8,
+	0x01, 0x01, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x25, 0x82,
+	0xb5, 0x18, 0x21, 0x0a, 0x17, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x10, 0x01, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x04, 0x22, 0xf6, 0x03, 0x0a, 0x08, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x12, 0x4c, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02,
+	0x49, 0x44, 0x20, 0x01, 0x52, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x12,
+	0x3b, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x1a, 0x57, 0x0a, 0x0e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xa8, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5,
+	0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x21, 0x82, 0xb5, 0x18,
+	0x1d, 0x0a, 0x06, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x7a, 0x13, 0xd2, 0x01, 0x06, 0x54, 0x79,
+	0x70, 0x65, 0x3d, 0x31, 0xda, 0x01, 0x07, 0x45, 0x71, 0x75, 0x69, 0x70, 0x49, 0x44, 0x52, 0x06,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x65, 0x71, 0x75, 0x69, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x07,
+	0x45, 0x71, 0x75, 0x69, 0x70, 0x49, 0x44, 0x52, 0x07, 0x65, 0x71, 0x75, 0x69, 0x70, 0x49, 0x64,
+	0x1a, 0x4b, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0x82, 0xb5, 0x18, 0x0f, 0x0a, 0x03, 0x4d, 0x69,
+	0x6e, 0x7a, 0x08, 0xe2, 0x01, 0x05, 0x3c, 0x3d, 0x4d, 0x61, 0x78, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x12, 0x1b, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82,
+	0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x3a, 0x0e, 0x82,
+	0xb5, 0x18, 0x0a, 0x0a, 0x08, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0x87, 0x01,
+	0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x4b, 0x56, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x3a, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x1d, 0x82, 0xb5, 0x18, 0x19, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x7a,
+	0x0d, 0xe2, 0x01, 0x0a, 0x3c, 0x3d, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0x82, 0xb5, 0x18,
+	0x0a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x3a, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x0a, 0x52, 0x75, 0x6c,
+	0x65, 0x4b, 0x56, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xa8, 0x03, 0x0a, 0x0a, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4b, 0x0a, 0x09, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x53, 0x70, 0x61, 0x77, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82,
+	0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x08, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x4d, 0x61, 0x70, 0x1a, 0x57, 0x0a, 0x0d, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x70, 0x61, 0x77,
+	0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe1, 0x01, 0x0a,
+	0x05, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x39, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x42,
+	0x15, 0x82, 0xb5, 0x18, 0x11, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x7a, 0x0a, 0x0a, 0x08, 0x2d, 0x31,
+	0x30, 0x30, 0x2c, 0x31, 0x30, 0x30, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x32, 0x69, 0x42, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x20,
+	0x02, 0x52, 0x09, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0b,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x32, 0x69, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x20, 0x03, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x3a, 0x10, 0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x0a, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x22, 0xb4, 0x02, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x12, 0x4c, 0x0a, 0x09, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x6f, 0x6f,
+	0x64, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06,
+	0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x08, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70,
+	0x1a, 0x58, 0x0a, 0x0d, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6a, 0x0a, 0x05, 0x47, 0x6f,
+	0x6f, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x1f,
+	0x82, 0xb5, 0x18, 0x1b, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x7a, 0x12, 0x0a, 0x06, 0x30,
+	0x2e, 0x30, 0x31, 0x2c, 0x7e, 0x82, 0x01, 0x04, 0x31, 0x30, 0x2c, 0x32, 0x88, 0x01, 0x01, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x0b, 0x44, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xd2, 0x02, 0x0a, 0x09, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4a, 0x0a, 0x09, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e,
+	0x54, 0x68, 0x65, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82,
+	0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x08, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x4d, 0x61, 0x70, 0x1a, 0x56, 0x0a, 0x0d, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x54, 0x68, 0x65, 0x6d, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x8f, 0x01, 0x0a, 0x05,
+	0x54, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x31, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x42, 0x0b,
+	0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x07, 0x50, 0x61, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x20, 0x03, 0x52, 0x07, 0x70, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x3a, 0x0f, 0x82,
+	0xb5, 0x18, 0x0b, 0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xc5,
+	0x03, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x12,
+	0x53, 0x0a, 0x0b, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x42, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5,
+	0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x0a, 0x62, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x4d, 0x61, 0x70, 0x1a, 0x5d, 0x0a, 0x0f, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0xec, 0x01, 0x0a, 0x07, 0x42, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18,
+	0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x61, 0x75, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x10, 0x82, 0xb5, 0x18,
+	0x0c, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x7a, 0x03, 0xe8, 0x01, 0x01, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x4a, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61,
+	0x75, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x42, 0x14, 0x82, 0xb5, 0x18, 0x10, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x6c, 0x20, 0x03, 0x7a, 0x06,
+	0x6a, 0x01, 0x3b, 0xe8, 0x01, 0x01, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0c, 0x82, 0xb5,
+	0x18, 0x08, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x3a, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xf7, 0x02, 0x0a, 0x0a, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x48, 0x0a, 0x08, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x6d, 0x61,
+	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x72,
+	0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06,
+	0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x1a,
+	0x55, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb5, 0x01, 0x0a, 0x04, 0x44, 0x72, 0x6f, 0x70, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18,
+	0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2b, 0x82, 0xb5, 0x18, 0x27, 0x0a,
+	0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7a, 0x1d, 0xf2, 0x01, 0x1a, 0x09, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x59, 0x40, 0x12, 0x09, 0x43, 0x75, 0x6d, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x1a, 0x04, 0x50, 0x72, 0x6f, 0x62, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e,
+	0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x09, 0x43, 0x75, 0x6d, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x09, 0x63, 0x75, 0x6d, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e,
+	0x0a, 0x04, 0x70, 0x72, 0x6f, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0a, 0x82, 0xb5,
+	0x18, 0x06, 0x0a, 0x04, 0x50, 0x72, 0x6f, 0x62, 0x52, 0x04, 0x70, 0x72, 0x6f, 0x62, 0x3a, 0x10,
+	0x82, 0xb5, 0x18, 0x0c, 0x0a, 0x0a, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x22, 0xbe, 0x02, 0x0a, 0x09, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x53,
+	0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06,
+	0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x4d, 0x61, 0x70, 0x1a, 0x5c, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x6d, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a,
+	0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x29, 0x82, 0xb5, 0x18, 0x25,
+	0x0a, 0x09, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x7a, 0x18, 0xfa, 0x01, 0x15,
+	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x3a, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x09, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x22, 0xf5, 0x02, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x12, 0x4d, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18,
+	0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61,
+	0x70, 0x1a, 0x59, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xa6, 0x01, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x34, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x26, 0x82, 0xb5, 0x18, 0x22, 0x0a, 0x04, 0x4f,
+	0x70, 0x65, 0x6e, 0x7a, 0x1a, 0x82, 0x02, 0x17, 0x08, 0x02, 0x12, 0x13, 0x32, 0x30, 0x32, 0x34,
+	0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31, 0x20, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x52,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x3a, 0x12, 0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x0c, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xa3, 0x02, 0x0a, 0x08, 0x54, 0x65,
+	0x78, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x46, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d,
+	0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a,
+	0x02, 0x49, 0x44, 0x20, 0x01, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x53,
+	0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x6a, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x7a,
+	0x03, 0x88, 0x02, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x0a, 0x04,
+	0x44, 0x65, 0x73, 0x63, 0x7a, 0x03, 0x88, 0x02, 0x01, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x3a,
+	0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x08, 0x54, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x22,
+	0x8f, 0x03, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x12, 0x54, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49, 0x44, 0x20, 0x01, 0x52,
+	0x09, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x5f, 0x0a, 0x0e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xab, 0x01, 0x0a, 0x06,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x26, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12,
+	0x82, 0xb5, 0x18, 0x0e, 0x0a, 0x04, 0x47, 0x55, 0x49, 0x44, 0x7a, 0x06, 0x90, 0x02, 0x01, 0x98,
+	0x02, 0x01, 0x52, 0x04, 0x67, 0x75, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0x82, 0xb5, 0x18, 0x11, 0x0a,
+	0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x7a, 0x06, 0x90, 0x02, 0x02, 0x98, 0x02, 0x01,
+	0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x82, 0xb5, 0x18, 0x16, 0x0a, 0x04, 0x4c,
+	0x69, 0x6e, 0x6b, 0x7a, 0x0e, 0x90, 0x02, 0x03, 0x98, 0x02, 0x01, 0xa2, 0x02, 0x05, 0x68, 0x74,
+	0x74, 0x70, 0x73, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x3a, 0x16, 0x82, 0xb5, 0x18, 0x12, 0x0a,
+	0x10, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x22, 0xa9, 0x03, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x12, 0x49, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x1a, 0x02, 0x49,
+	0x44, 0x20, 0x01, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x1a, 0x56, 0x0a, 0x0c,
+	0x49, 0x74, 0x65, 0x6d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe3, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0xb5, 0x18, 0x04, 0x0a,
+	0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x0a, 0x04, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x82, 0xb5, 0x18, 0x07, 0x0a, 0x05, 0x42, 0x6f,
+	0x6e, 0x75, 0x73, 0x52, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x2f, 0x82, 0xb5, 0x18, 0x2b, 0x0a,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x7a, 0x22, 0x0a, 0x05, 0x31, 0x2c, 0x31, 0x30, 0x30, 0x10,
+	0x01, 0xc2, 0x01, 0x16, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x20, 0x2b, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x37, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0x82, 0xb5, 0x18, 0x16, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x7a, 0x0a, 0xe2, 0x01, 0x07, 0x3e, 0x3d, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x3a, 0x11, 0x82, 0xb5, 0x18, 0x0d,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x22, 0xd4, 0x02,
+	0x0a, 0x13, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x61,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x6a, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75, 0x6e, 0x69, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x4d, 0x61, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1d, 0x82, 0xb5, 0x18, 0x19, 0x1a, 0x08, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x20, 0x01, 0x7a, 0x0b, 0xca, 0x01, 0x08, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61,
+	0x70, 0x1a, 0x62, 0x0a, 0x0e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70, 0x43,
+	0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x2b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x03,
+	0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a,
+	0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x3a, 0x19, 0x82, 0xb5, 0x18, 0x15, 0x0a,
+	0x13, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4d, 0x61, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x22, 0xe5, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x56, 0x0a,
+	0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x0e, 0x82, 0xb5, 0x18, 0x0a, 0x0a,
+	0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x20, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x59, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x32, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x15, 0x82, 0xb5, 0x18, 0x11, 0x0a, 0x02, 0x49, 0x44, 0x7a, 0x0b, 0xca, 0x01,
+	0x08, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x52, 0x03, 0x6e, 0x75, 0x6d,
+	0x3a, 0x1a, 0x82, 0xb5, 0x18, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x2a, 0xa6, 0x01, 0x0a,
+	0x0c, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a,
+	0x15, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x07,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x20, 0x0a, 0x11, 0x50, 0x4c, 0x41, 0x54, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4f, 0x53, 0x10, 0x01, 0x1a, 0x09,
+	0x82, 0xb5, 0x18, 0x05, 0x0a, 0x03, 0x69, 0x4f, 0x53, 0x12, 0x28, 0x0a, 0x15, 0x50, 0x4c, 0x41,
+	0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x44, 0x52, 0x4f,
+	0x49, 0x44, 0x10, 0x02, 0x1a, 0x0d, 0x82, 0xb5, 0x18, 0x09, 0x0a, 0x07, 0x41, 0x6e, 0x64, 0x72,
+	0x6f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x11, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x10, 0x40, 0x1a, 0x09, 0x82, 0xb5, 0x18, 0x05,
+	0x0a, 0x03, 0x57, 0x65, 0x62, 0x42, 0x56, 0x82, 0xb5, 0x18, 0x19, 0x0a, 0x17, 0x75, 0x6e, 0x69,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x55, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x23, 0x2a,
+	0x2e, 0x63, 0x73, 0x76, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x69, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75,
+	0x70, 0x62, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tableau_protobuf_unittest_unittest_proto_rawDescOnce sync.Once
+	file_tableau_protobuf_unittest_unittest_proto_rawDescData = file_tableau_protobuf_unittest_unittest_proto_rawDesc
+)
+
+func file_tableau_protobuf_unittest_unittest_proto_rawDescGZIP() []byte {
+	file_tableau_protobuf_unittest_unittest_proto_rawDescOnce.Do(func() {
+		file_tableau_protobuf_unittest_unittest_proto_rawDescData = protoimpl.X.CompressGZIP(file_tableau_protobuf_unittest_unittest_proto_rawDescData)
+	})
+	return file_tableau_protobuf_unittest_unittest_proto_rawDescData
+}
+
+var file_tableau_protobuf_unittest_unittest_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tableau_protobuf_unittest_unittest_proto_msgTypes = make([]protoimpl.MessageInfo, 167)
+var file_tableau_protobuf_unittest_unittest_proto_goTypes = []interface{}{
+	(PlatformType)(0),                         // 0: unittest.PlatformType
+	(*SimpleIncellMap)(nil),                   // 1: unittest.SimpleIncellMap
+	(*IncellMap)(nil),                         // 2: unittest.IncellMap
+	(*IncellStructList)(nil),                  // 3: unittest.IncellStructList
+	(*IncellList)(nil),                        // 4: unittest.IncellList
+	(*ItemConf)(nil),                          // 5: unittest.ItemConf
+	(*MallConf)(nil),                          // 6: unittest.MallConf
+	(*ActivityConf)(nil),                      // 7: unittest.ActivityConf
+	(*RewardConf)(nil),                        // 8: unittest.RewardConf
+	(*YamlScalarConf)(nil),                    // 9: unittest.YamlScalarConf
+	(*PatchReplaceConf)(nil),                  // 10: unittest.PatchReplaceConf
+	(*PatchMergeConf)(nil),                    // 11: unittest.PatchMergeConf
+	(*RecursivePatchConf)(nil),                // 12: unittest.RecursivePatchConf
+	(*JsonUtilTestData)(nil),                  // 13: unittest.JsonUtilTestData
+	(*UniqueFieldInVerticalStructList)(nil),   // 14: unittest.UniqueFieldInVerticalStructList
+	(*VerticalUniqueFieldStructMap)(nil),      // 15: unittest.VerticalUniqueFieldStructMap
+	(*DocumentUniqueFieldStructList)(nil),     // 16: unittest.DocumentUniqueFieldStructList
+	(*DocumentUniqueFieldStructMap)(nil),      // 17: unittest.DocumentUniqueFieldStructMap
+	(*SequenceFieldInVerticalStructList)(nil), // 18: unittest.SequenceFieldInVerticalStructList
+	(*SequenceKeyInVerticalKeyedList)(nil),    // 19: unittest.SequenceKeyInVerticalKeyedList
+	(*VerticalSequenceFieldStructMap)(nil),    // 20: unittest.VerticalSequenceFieldStructMap
+	(*DocumentSequenceFieldStructList)(nil),   // 21: unittest.DocumentSequenceFieldStructList
+	(*Transpose)(nil),                         // 22: unittest.Transpose
+	(*ValidateConf)(nil),                      // 23: unittest.ValidateConf
+	(*TaskConf)(nil),                          // 24: unittest.TaskConf
+	(*FieldPresentMap)(nil),                   // 25: unittest.FieldPresentMap
+	(*ScatterNoneConf)(nil),                   // 26: unittest.ScatterNoneConf
+	(*ScatterReplaceConf)(nil),                // 27: unittest.ScatterReplaceConf
+	(*ScatterMergeConf)(nil),                  // 28: unittest.ScatterMergeConf
+	(*MergerSingleConf)(nil),                  // 29: unittest.MergerSingleConf
+	(*MergerMultiConf)(nil),                   // 30: unittest.MergerMultiConf
+	(*VerticalAggregationMap)(nil),            // 31: unittest.VerticalAggregationMap
+	(*IncellKeyedList)(nil),                   // 32: unittest.IncellKeyedList
+	(*HorizontalAggregateMap)(nil),            // 33: unittest.HorizontalAggregateMap
+	(*HorizontalAggregateList)(nil),           // 34: unittest.HorizontalAggregateList
+	(*RuleConf)(nil),                          // 35: unittest.RuleConf
+	(*RuleKVConf)(nil),                        // 36: unittest.RuleKVConf
+	(*VectorConf)(nil),                        // 37: unittest.VectorConf
+	(*DecimalConf)(nil),                       // 38: unittest.DecimalConf
+	(*ColorConf)(nil),                         // 39: unittest.ColorConf
+	(*IntervalConf)(nil),                      // 40: unittest.IntervalConf
+	(*WeightConf)(nil),                        // 41: unittest.WeightConf
+	(*FlagsConf)(nil),                         // 42: unittest.FlagsConf
+	(*ScheduleConf)(nil),                      // 43: unittest.ScheduleConf
+	(*TextConf)(nil),                          // 44: unittest.TextConf
+	(*StringFormatConf)(nil),                  // 45: unittest.StringFormatConf
+	(*ComputeConf)(nil),                       // 46: unittest.ComputeConf
+	(*UniqueDomainMapConf)(nil),               // 47: unittest.UniqueDomainMapConf
+	(*UniqueDomainListConf)(nil),              // 48: unittest.UniqueDomainListConf
+	nil,                                       // 49: unittest.SimpleIncellMap.ItemMapEntry
+	nil,                                       // 50: unittest.IncellMap.FruitMapEntry
+	(*IncellMap_Fruit)(nil),                   // 51: unittest.IncellMap.Fruit
+	nil,                                       // 52: unittest.IncellMap.FlavorMapEntry
+	nil,                                       // 53: unittest.IncellMap.ItemMapEntry
+	(*IncellMap_Item)(nil),                    // 54: unittest.IncellMap.Item
+	nil,                                       // 55: unittest.ItemConf.ItemMapEntry
+	nil,                                       // 56: unittest.MallConf.ShopMapEntry
+	(*MallConf_Shop)(nil),                     // 57: unittest.MallConf.Shop
+	nil,                                       // 58: unittest.MallConf.Shop.GoodsMapEntry
+	(*MallConf_Shop_Goods)(nil),               // 59: unittest.MallConf.Shop.Goods
+	nil,                                       // 60: unittest.ActivityConf.ActivityMapEntry
+	(*ActivityConf_Activity)(nil),             // 61: unittest.ActivityConf.Activity
+	nil,                                       // 62: unittest.ActivityConf.Activity.ChapterMapEntry
+	(*ActivityConf_Activity_Chapter)(nil),     // 63: unittest.ActivityConf.Activity.Chapter
+	(*ActivityConf_Activity_Chapter_Section)(nil), // 64: unittest.ActivityConf.Activity.Chapter.Section
+	nil, // 65: unittest.ActivityConf.Activity.Chapter.Section.RewardMapEntry
+	(*ActivityConf_Activity_Chapter_Section_Reward)(nil), // 66: unittest.ActivityConf.Activity.Chapter.Section.Reward
+	nil,                                   // 67: unittest.RewardConf.RewardMapEntry
+	(*RewardConf_Reward)(nil),             // 68: unittest.RewardConf.Reward
+	nil,                                   // 69: unittest.RewardConf.Reward.ItemMapEntry
+	(*PatchMergeConf_Time)(nil),           // 70: unittest.PatchMergeConf.Time
+	nil,                                   // 71: unittest.PatchMergeConf.ItemMapEntry
+	nil,                                   // 72: unittest.PatchMergeConf.ReplaceItemMapEntry
+	nil,                                   // 73: unittest.RecursivePatchConf.ShopMapEntry
+	(*RecursivePatchConf_Shop)(nil),       // 74: unittest.RecursivePatchConf.Shop
+	nil,                                   // 75: unittest.RecursivePatchConf.Shop.GoodsMapEntry
+	(*RecursivePatchConf_Shop_Goods)(nil), // 76: unittest.RecursivePatchConf.Shop.Goods
+	nil,                                   // 77: unittest.RecursivePatchConf.Shop.Goods.CurrencyMapEntry
+	(*RecursivePatchConf_Shop_Goods_Currency)(nil), // 78: unittest.RecursivePatchConf.Shop.Goods.Currency
+	(*RecursivePatchConf_Shop_Goods_Award)(nil),    // 79: unittest.RecursivePatchConf.Shop.Goods.Award
+	nil, // 80: unittest.RecursivePatchConf.Shop.Goods.Currency.ValueListEntry
+	nil, // 81: unittest.RecursivePatchConf.Shop.Goods.Currency.MessageListEntry
+	nil, // 82: unittest.JsonUtilTestData.MapFieldEntry
+	(*UniqueFieldInVerticalStructList_Item)(nil), // 83: unittest.UniqueFieldInVerticalStructList.Item
+	nil, // 84: unittest.VerticalUniqueFieldStructMap.MainMapEntry
+	(*VerticalUniqueFieldStructMap_Main)(nil), // 85: unittest.VerticalUniqueFieldStructMap.Main
+	nil, // 86: unittest.VerticalUniqueFieldStructMap.Main.MainKvMapEntry
+	nil, // 87: unittest.VerticalUniqueFieldStructMap.Main.SubMapEntry
+	(*VerticalUniqueFieldStructMap_Main_Sub)(nil), // 88: unittest.VerticalUniqueFieldStructMap.Main.Sub
+	(*DocumentUniqueFieldStructList_Item)(nil),    // 89: unittest.DocumentUniqueFieldStructList.Item
+	nil, // 90: unittest.DocumentUniqueFieldStructMap.ChapterEntry
+	(*DocumentUniqueFieldStructMap_Chapter)(nil), // 91: unittest.DocumentUniqueFieldStructMap.Chapter
+	nil, // 92: unittest.DocumentUniqueFieldStructMap.ScalarMapEntry
+	nil, // 93: unittest.DocumentUniqueFieldStructMap.IncellMapEntry
+	nil, // 94: unittest.DocumentUniqueFieldStructMap.ChapterInfoEntry
+	(*DocumentUniqueFieldStructMap_ChapterInfo)(nil), // 95: unittest.DocumentUniqueFieldStructMap.ChapterInfo
+	nil, // 96: unittest.DocumentUniqueFieldStructMap.Chapter.SectionEntry
+	(*DocumentUniqueFieldStructMap_Chapter_Section)(nil), // 97: unittest.DocumentUniqueFieldStructMap.Chapter.Section
+	nil, // 98: unittest.DocumentUniqueFieldStructMap.ChapterInfo.SectionEntry
+	(*DocumentUniqueFieldStructMap_ChapterInfo_Section)(nil), // 99: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section
+	nil, // 100: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.SectionEntry
+	(*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section)(nil), // 101: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section
+	nil, // 102: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.SectionEntry
+	(*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section)(nil), // 103: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.Section
+	(*SequenceFieldInVerticalStructList_Item)(nil),                           // 104: unittest.SequenceFieldInVerticalStructList.Item
+	(*SequenceKeyInVerticalKeyedList_Item)(nil),                              // 105: unittest.SequenceKeyInVerticalKeyedList.Item
+	nil, // 106: unittest.SequenceKeyInVerticalKeyedList.Item.PropMapEntry
+	(*SequenceKeyInVerticalKeyedList_Item_Prop)(nil), // 107: unittest.SequenceKeyInVerticalKeyedList.Item.Prop
+	nil, // 108: unittest.VerticalSequenceFieldStructMap.MainMapEntry
+	(*VerticalSequenceFieldStructMap_Main)(nil), // 109: unittest.VerticalSequenceFieldStructMap.Main
+	nil, // 110: unittest.VerticalSequenceFieldStructMap.Main.SubMapEntry
+	(*VerticalSequenceFieldStructMap_Main_Sub)(nil), // 111: unittest.VerticalSequenceFieldStructMap.Main.Sub
+	(*DocumentSequenceFieldStructList_Item)(nil),    // 112: unittest.DocumentSequenceFieldStructList.Item
+	nil,                                   // 113: unittest.Transpose.HeroMapEntry
+	(*Transpose_Hero)(nil),                // 114: unittest.Transpose.Hero
+	nil,                                   // 115: unittest.ValidateConf.PropMapEntry
+	nil,                                   // 116: unittest.TaskConf.TaskMapEntry
+	(*TaskConf_Task)(nil),                 // 117: unittest.TaskConf.Task
+	nil,                                   // 118: unittest.FieldPresentMap.PlayerMapEntry
+	(*FieldPresentMap_Player)(nil),        // 119: unittest.FieldPresentMap.Player
+	(*FieldPresentMap_Player_Weapon)(nil), // 120: unittest.FieldPresentMap.Player.Weapon
+	(*FieldPresentMap_Player_Info)(nil),   // 121: unittest.FieldPresentMap.Player.Info
+	nil,                                   // 122: unittest.FieldPresentMap.Player.AttrMapEntry
+	nil,                                   // 123: unittest.ScatterNoneConf.ZoneMapEntry
+	(*ScatterNoneConf_Zone)(nil),          // 124: unittest.ScatterNoneConf.Zone
+	nil,                                   // 125: unittest.ScatterReplaceConf.ZoneMapEntry
+	(*ScatterReplaceConf_Zone)(nil),       // 126: unittest.ScatterReplaceConf.Zone
+	nil,                                   // 127: unittest.ScatterMergeConf.ZoneMapEntry
+	(*ScatterMergeConf_Zone)(nil),         // 128: unittest.ScatterMergeConf.Zone
+	nil,                                   // 129: unittest.MergerSingleConf.ZoneMapEntry
+	(*MergerSingleConf_Zone)(nil),         // 130: unittest.MergerSingleConf.Zone
+	nil,                                   // 131: unittest.MergerMultiConf.ZoneMapEntry
+	(*MergerMultiConf_Zone)(nil),          // 132: unittest.MergerMultiConf.Zone
+	nil,                                   // 133: unittest.VerticalAggregationMap.HeroMapEntry
+	(*VerticalAggregationMap_Hero)(nil),   // 134: unittest.VerticalAggregationMap.Hero
+	nil,                                   // 135: unittest.VerticalAggregationMap.Hero.LevelMapEntry
+	(*VerticalAggregationMap_Hero_Level)(nil), // 136: unittest.VerticalAggregationMap.Hero.Level
+	nil,                                  // 137: unittest.HorizontalAggregateMap.HeroMapEntry
+	(*HorizontalAggregateMap_Hero)(nil),  // 138: unittest.HorizontalAggregateMap.Hero
+	nil,                                  // 139: unittest.HorizontalAggregateMap.Hero.ItemMapEntry
+	nil,                                  // 140: unittest.HorizontalAggregateList.HeroMapEntry
+	(*HorizontalAggregateList_Hero)(nil), // 141: unittest.HorizontalAggregateList.Hero
+	nil,                                  // 142: unittest.RuleConf.RewardMapEntry
+	(*RuleConf_Reward)(nil),              // 143: unittest.RuleConf.Reward
+	(*RuleConf_Level)(nil),               // 144: unittest.RuleConf.Level
+	nil,                                  // 145: unittest.VectorConf.SpawnMapEntry
+	(*VectorConf_Spawn)(nil),             // 146: unittest.VectorConf.Spawn
+	nil,                                  // 147: unittest.DecimalConf.GoodsMapEntry
+	(*DecimalConf_Goods)(nil),            // 148: unittest.DecimalConf.Goods
+	nil,                                  // 149: unittest.ColorConf.ThemeMapEntry
+	(*ColorConf_Theme)(nil),              // 150: unittest.ColorConf.Theme
+	nil,                                  // 151: unittest.IntervalConf.BracketMapEntry
+	(*IntervalConf_Bracket)(nil),         // 152: unittest.IntervalConf.Bracket
+	nil,                                  // 153: unittest.WeightConf.DropMapEntry
+	(*WeightConf_Drop)(nil),              // 154: unittest.WeightConf.Drop
+	nil,                                  // 155: unittest.FlagsConf.ActivityMapEntry
+	(*FlagsConf_Activity)(nil),           // 156: unittest.FlagsConf.Activity
+	nil,                                  // 157: unittest.ScheduleConf.EventMapEntry
+	(*ScheduleConf_Event)(nil),           // 158: unittest.ScheduleConf.Event
+	nil,                                  // 159: unittest.TextConf.ItemMapEntry
+	(*TextConf_Item)(nil),                // 160: unittest.TextConf.Item
+	nil,                                  // 161: unittest.StringFormatConf.FilterMapEntry
+	(*StringFormatConf_Filter)(nil),      // 162: unittest.StringFormatConf.Filter
+	nil,                                  // 163: unittest.ComputeConf.ItemMapEntry
+	(*ComputeConf_Item)(nil),             // 164: unittest.ComputeConf.Item
+	nil,                                  // 165: unittest.UniqueDomainMapConf.RewardMapEntry
+	(*UniqueDomainMapConf_Reward)(nil),   // 166: unittest.UniqueDomainMapConf.Reward
+	(*UniqueDomainListConf_Reward)(nil),  // 167: unittest.UniqueDomainListConf.Reward
+	(*Item)(nil),                         // 168: unittest.Item
+	(FruitFlavor)(0),                     // 169: unittest.FruitFlavor
+	(FruitType)(0),                       // 170: unittest.FruitType
+	(*timestamppb.Timestamp)(nil),        // 171: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 172: google.protobuf.Duration
+	(*Target)(nil),                       // 173: unittest.Target
+	(*tableaupb.Vector3)(nil),            // 174: tableau.Vector3
+	(*tableaupb.Vector2I)(nil),           // 175: tableau.Vector2i
+	(*tableaupb.Decimal)(nil),            // 176: tableau.Decimal
+	(*tableaupb.Color)(nil),              // 177: tableau.Color
+	(*tableaupb.Interval)(nil),           // 178: tableau.Interval
+	(*tableaupb.DoubleInterval)(nil),     // 179: tableau.DoubleInterval
+	(*tableaupb.TimestampInterval)(nil),  // 180: tableau.TimestampInterval
+	(*tableaupb.Schedule)(nil),           // 181: tableau.Schedule
+}
+var file_tableau_protobuf_unittest_unittest_proto_depIdxs = []int32{
+	49,  // 0: unittest.SimpleIncellMap.item_map:type_name -> unittest.SimpleIncellMap.ItemMapEntry
+	50,  // 1: unittest.IncellMap.fruit_map:type_name -> unittest.IncellMap.FruitMapEntry
+	52,  // 2: unittest.IncellMap.flavor_map:type_name -> unittest.IncellMap.FlavorMapEntry
+	53,  // 3: unittest.IncellMap.item_map:type_name -> unittest.IncellMap.ItemMapEntry
+	168, // 4: unittest.IncellStructList.item_list:type_name -> unittest.Item
+	169, // 5: unittest.IncellList.flavor_list:type_name -> unittest.FruitFlavor
+	168, // 6: unittest.IncellList.item_list:type_name -> unittest.Item
+	55,  // 7: unittest.ItemConf.item_map:type_name -> unittest.ItemConf.ItemMapEntry
+	56,  // 8: unittest.MallConf.shop_map:type_name -> unittest.MallConf.ShopMapEntry
+	60,  // 9: unittest.ActivityConf.activity_map:type_name -> unittest.ActivityConf.ActivityMapEntry
+	67,  // 10: unittest.RewardConf.reward_map:type_name -> unittest.RewardConf.RewardMapEntry
+	70,  // 11: unittest.PatchMergeConf.time:type_name -> unittest.PatchMergeConf.Time
+	71,  // 12: unittest.PatchMergeConf.item_map:type_name -> unittest.PatchMergeConf.ItemMapEntry
+	72,  // 13: unittest.PatchMergeConf.replace_item_map:type_name -> unittest.PatchMergeConf.ReplaceItemMapEntry
+	73,  // 14: unittest.RecursivePatchConf.shop_map:type_name -> unittest.RecursivePatchConf.ShopMapEntry
+	11,  // 15: unittest.JsonUtilTestData.normal_field:type_name -> unittest.PatchMergeConf
+	11,  // 16: unittest.JsonUtilTestData.list_field:type_name -> unittest.PatchMergeConf
+	82,  // 17: unittest.JsonUtilTestData.map_field:type_name -> unittest.JsonUtilTestData.MapFieldEntry
+	83,  // 18: unittest.UniqueFieldInVerticalStructList.item_list:type_name -> unittest.UniqueFieldInVerticalStructList.Item
+	84,  // 19: unittest.VerticalUniqueFieldStructMap.main_map:type_name -> unittest.VerticalUniqueFieldStructMap.MainMapEntry
+	89,  // 20: unittest.DocumentUniqueFieldStructList.item_list:type_name -> unittest.DocumentUniqueFieldStructList.Item
+	90,  // 21: unittest.DocumentUniqueFieldStructMap.chapter:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterEntry
+	92,  // 22: unittest.DocumentUniqueFieldStructMap.scalar_map:type_name -> unittest.DocumentUniqueFieldStructMap.ScalarMapEntry
+	93,  // 23: unittest.DocumentUniqueFieldStructMap.incell_map:type_name -> unittest.DocumentUniqueFieldStructMap.IncellMapEntry
+	94,  // 24: unittest.DocumentUniqueFieldStructMap.chapter_info:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfoEntry
+	104, // 25: unittest.SequenceFieldInVerticalStructList.item_list:type_name -> unittest.SequenceFieldInVerticalStructList.Item
+	105, // 26: unittest.SequenceKeyInVerticalKeyedList.item_list:type_name -> unittest.SequenceKeyInVerticalKeyedList.Item
+	108, // 27: unittest.VerticalSequenceFieldStructMap.main_map:type_name -> unittest.VerticalSequenceFieldStructMap.MainMapEntry
+	112, // 28: unittest.DocumentSequenceFieldStructList.item_list:type_name -> unittest.DocumentSequenceFieldStructList.Item
+	113, // 29: unittest.Transpose.hero_map:type_name -> unittest.Transpose.HeroMapEntry
+	115, // 30: unittest.ValidateConf.prop_map:type_name -> unittest.ValidateConf.PropMapEntry
+	116, // 31: unittest.TaskConf.task_map:type_name -> unittest.TaskConf.TaskMapEntry
+	118, // 32: unittest.FieldPresentMap.player_map:type_name -> unittest.FieldPresentMap.PlayerMapEntry
+	123, // 33: unittest.ScatterNoneConf.zone_map:type_name -> unittest.ScatterNoneConf.ZoneMapEntry
+	125, // 34: unittest.ScatterReplaceConf.zone_map:type_name -> unittest.ScatterReplaceConf.ZoneMapEntry
+	127, // 35: unittest.ScatterMergeConf.zone_map:type_name -> unittest.ScatterMergeConf.ZoneMapEntry
+	129, // 36: unittest.MergerSingleConf.zone_map:type_name -> unittest.MergerSingleConf.ZoneMapEntry
+	131, // 37: unittest.MergerMultiConf.zone_map:type_name -> unittest.MergerMultiConf.ZoneMapEntry
+	133, // 38: unittest.VerticalAggregationMap.hero_map:type_name -> unittest.VerticalAggregationMap.HeroMapEntry
+	170, // 39: unittest.IncellKeyedList.type_list:type_name -> unittest.FruitType
+	168, // 40: unittest.IncellKeyedList.item_list:type_name -> unittest.Item
+	137, // 41: unittest.HorizontalAggregateMap.hero_map:type_name -> unittest.HorizontalAggregateMap.HeroMapEntry
+	140, // 42: unittest.HorizontalAggregateList.hero_map:type_name -> unittest.HorizontalAggregateList.HeroMapEntry
+	142, // 43: unittest.RuleConf.reward_map:type_name -> unittest.RuleConf.RewardMapEntry
+	144, // 44: unittest.RuleConf.level:type_name -> unittest.RuleConf.Level
+	145, // 45: unittest.VectorConf.spawn_map:type_name -> unittest.VectorConf.SpawnMapEntry
+	147, // 46: unittest.DecimalConf.goods_map:type_name -> unittest.DecimalConf.GoodsMapEntry
+	149, // 47: unittest.ColorConf.theme_map:type_name -> unittest.ColorConf.ThemeMapEntry
+	151, // 48: unittest.IntervalConf.bracket_map:type_name -> unittest.IntervalConf.BracketMapEntry
+	153, // 49: unittest.WeightConf.drop_map:type_name -> unittest.WeightConf.DropMapEntry
+	155, // 50: unittest.FlagsConf.activity_map:type_name -> unittest.FlagsConf.ActivityMapEntry
+	157, // 51: unittest.ScheduleConf.event_map:type_name -> unittest.ScheduleConf.EventMapEntry
+	159, // 52: unittest.TextConf.item_map:type_name -> unittest.TextConf.ItemMapEntry
+	161, // 53: unittest.StringFormatConf.filter_map:type_name -> unittest.StringFormatConf.FilterMapEntry
+	163, // 54: unittest.ComputeConf.item_map:type_name -> unittest.ComputeConf.ItemMapEntry
+	165, // 55: unittest.UniqueDomainMapConf.reward_map:type_name -> unittest.UniqueDomainMapConf.RewardMapEntry
+	167, // 56: unittest.UniqueDomainListConf.reward_list:type_name -> unittest.UniqueDomainListConf.Reward
+	51,  // 57: unittest.IncellMap.FruitMapEntry.value:type_name -> unittest.IncellMap.Fruit
+	170, // 58: unittest.IncellMap.Fruit.key:type_name -> unittest.FruitType
+	169, // 59: unittest.IncellMap.FlavorMapEntry.value:type_name -> unittest.FruitFlavor
+	54,  // 60: unittest.IncellMap.ItemMapEntry.value:type_name -> unittest.IncellMap.Item
+	170, // 61: unittest.IncellMap.Item.key:type_name -> unittest.FruitType
+	169, // 62: unittest.IncellMap.Item.value:type_name -> unittest.FruitFlavor
+	168, // 63: unittest.ItemConf.ItemMapEntry.value:type_name -> unittest.Item
+	57,  // 64: unittest.MallConf.ShopMapEntry.value:type_name -> unittest.MallConf.Shop
+	58,  // 65: unittest.MallConf.Shop.goods_map:type_name -> unittest.MallConf.Shop.GoodsMapEntry
+	59,  // 66: unittest.MallConf.Shop.GoodsMapEntry.value:type_name -> unittest.MallConf.Shop.Goods
+	61,  // 67: unittest.ActivityConf.ActivityMapEntry.value:type_name -> unittest.ActivityConf.Activity
+	62,  // 68: unittest.ActivityConf.Activity.chapter_map:type_name -> unittest.ActivityConf.Activity.ChapterMapEntry
+	63,  // 69: unittest.ActivityConf.Activity.ChapterMapEntry.value:type_name -> unittest.ActivityConf.Activity.Chapter
+	64,  // 70: unittest.ActivityConf.Activity.Chapter.section_list:type_name -> unittest.ActivityConf.Activity.Chapter.Section
+	65,  // 71: unittest.ActivityConf.Activity.Chapter.Section.reward_map:type_name -> unittest.ActivityConf.Activity.Chapter.Section.RewardMapEntry
+	66,  // 72: unittest.ActivityConf.Activity.Chapter.Section.RewardMapEntry.value:type_name -> unittest.ActivityConf.Activity.Chapter.Section.Reward
+	68,  // 73: unittest.RewardConf.RewardMapEntry.value:type_name -> unittest.RewardConf.Reward
+	69,  // 74: unittest.RewardConf.Reward.item_map:type_name -> unittest.RewardConf.Reward.ItemMapEntry
+	168, // 75: unittest.RewardConf.Reward.ItemMapEntry.value:type_name -> unittest.Item
+	171, // 76: unittest.PatchMergeConf.Time.start:type_name -> google.protobuf.Timestamp
+	172, // 77: unittest.PatchMergeConf.Time.expiry:type_name -> google.protobuf.Duration
+	168, // 78: unittest.PatchMergeConf.ItemMapEntry.value:type_name -> unittest.Item
+	168, // 79: unittest.PatchMergeConf.ReplaceItemMapEntry.value:type_name -> unittest.Item
+	74,  // 80: unittest.RecursivePatchConf.ShopMapEntry.value:type_name -> unittest.RecursivePatchConf.Shop
+	75,  // 81: unittest.RecursivePatchConf.Shop.goods_map:type_name -> unittest.RecursivePatchConf.Shop.GoodsMapEntry
+	76,  // 82: unittest.RecursivePatchConf.Shop.GoodsMapEntry.value:type_name -> unittest.RecursivePatchConf.Shop.Goods
+	77,  // 83: unittest.RecursivePatchConf.Shop.Goods.currency_map:type_name -> unittest.RecursivePatchConf.Shop.Goods.CurrencyMapEntry
+	79,  // 84: unittest.RecursivePatchConf.Shop.Goods.award_list:type_name -> unittest.RecursivePatchConf.Shop.Goods.Award
+	78,  // 85: unittest.RecursivePatchConf.Shop.Goods.CurrencyMapEntry.value:type_name -> unittest.RecursivePatchConf.Shop.Goods.Currency
+	80,  // 86: unittest.RecursivePatchConf.Shop.Goods.Currency.value_list:type_name -> unittest.RecursivePatchConf.Shop.Goods.Currency.ValueListEntry
+	81,  // 87: unittest.RecursivePatchConf.Shop.Goods.Currency.message_list:type_name -> unittest.RecursivePatchConf.Shop.Goods.Currency.MessageListEntry
+	11,  // 88: unittest.JsonUtilTestData.MapFieldEntry.value:type_name -> unittest.PatchMergeConf
+	85,  // 89: unittest.VerticalUniqueFieldStructMap.MainMapEntry.value:type_name -> unittest.VerticalUniqueFieldStructMap.Main
+	86,  // 90: unittest.VerticalUniqueFieldStructMap.Main.main_kv_map:type_name -> unittest.VerticalUniqueFieldStructMap.Main.MainKvMapEntry
+	87,  // 91: unittest.VerticalUniqueFieldStructMap.Main.sub_map:type_name -> unittest.VerticalUniqueFieldStructMap.Main.SubMapEntry
+	88,  // 92: unittest.VerticalUniqueFieldStructMap.Main.SubMapEntry.value:type_name -> unittest.VerticalUniqueFieldStructMap.Main.Sub
+	91,  // 93: unittest.DocumentUniqueFieldStructMap.ChapterEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.Chapter
+	96,  // 94: unittest.DocumentUniqueFieldStructMap.Chapter.section:type_name -> unittest.DocumentUniqueFieldStructMap.Chapter.SectionEntry
+	95,  // 95: unittest.DocumentUniqueFieldStructMap.ChapterInfoEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo
+	98,  // 96: unittest.DocumentUniqueFieldStructMap.ChapterInfo.section:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.SectionEntry
+	97,  // 97: unittest.DocumentUniqueFieldStructMap.Chapter.SectionEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.Chapter.Section
+	99,  // 98: unittest.DocumentUniqueFieldStructMap.ChapterInfo.SectionEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section
+	100, // 99: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.section:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.SectionEntry
+	101, // 100: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.SectionEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section
+	102, // 101: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.section:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.SectionEntry
+	103, // 102: unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.SectionEntry.value:type_name -> unittest.DocumentUniqueFieldStructMap.ChapterInfo.Section.Section.Section
+	106, // 103: unittest.SequenceKeyInVerticalKeyedList.Item.prop_map:type_name -> unittest.SequenceKeyInVerticalKeyedList.Item.PropMapEntry
+	107, // 104: unittest.SequenceKeyInVerticalKeyedList.Item.PropMapEntry.value:type_name -> unittest.SequenceKeyInVerticalKeyedList.Item.Prop
+	109, // 105: unittest.VerticalSequenceFieldStructMap.MainMapEntry.value:type_name -> unittest.VerticalSequenceFieldStructMap.Main
+	110, // 106: unittest.VerticalSequenceFieldStructMap.Main.sub_map:type_name -> unittest.VerticalSequenceFieldStructMap.Main.SubMapEntry
+	111, // 107: unittest.VerticalSequenceFieldStructMap.Main.SubMapEntry.value:type_name -> unittest.VerticalSequenceFieldStructMap.Main.Sub
+	114, // 108: unittest.Transpose.HeroMapEntry.value:type_name -> unittest.Transpose.Hero
+	117, // 109: unittest.TaskConf.TaskMapEntry.value:type_name -> unittest.TaskConf.Task
+	173, // 110: unittest.TaskConf.Task.target:type_name -> unittest.Target
+	119, // 111: unittest.FieldPresentMap.PlayerMapEntry.value:type_name -> unittest.FieldPresentMap.Player
+	120, // 112: unittest.FieldPresentMap.Player.weapon:type_name -> unittest.FieldPresentMap.Player.Weapon
+	121, // 113: unittest.FieldPresentMap.Player.info:type_name -> unittest.FieldPresentMap.Player.Info
+	122, // 114: unittest.FieldPresentMap.Player.attr_map:type_name -> unittest.FieldPresentMap.Player.AttrMapEntry
+	173, // 115: unittest.FieldPresentMap.Player.target:type_name -> unittest.Target
+	124, // 116: unittest.ScatterNoneConf.ZoneMapEntry.value:type_name -> unittest.ScatterNoneConf.Zone
+	126, // 117: unittest.ScatterReplaceConf.ZoneMapEntry.value:type_name -> unittest.ScatterReplaceConf.Zone
+	128, // 118: unittest.ScatterMergeConf.ZoneMapEntry.value:type_name -> unittest.ScatterMergeConf.Zone
+	130, // 119: unittest.MergerSingleConf.ZoneMapEntry.value:type_name -> unittest.MergerSingleConf.Zone
+	132, // 120: unittest.MergerMultiConf.ZoneMapEntry.value:type_name -> unittest.MergerMultiConf.Zone
+	134, // 121: unittest.VerticalAggregationMap.HeroMapEntry.value:type_name -> unittest.VerticalAggregationMap.Hero
+	135, // 122: unittest.VerticalAggregationMap.Hero.level_map:type_name -> unittest.VerticalAggregationMap.Hero.LevelMapEntry
+	136, // 123: unittest.VerticalAggregationMap.Hero.LevelMapEntry.value:type_name -> unittest.VerticalAggregationMap.Hero.Level
+	138, // 124: unittest.HorizontalAggregateMap.HeroMapEntry.value:type_name -> unittest.HorizontalAggregateMap.Hero
+	139, // 125: unittest.HorizontalAggregateMap.Hero.item_map:type_name -> unittest.HorizontalAggregateMap.Hero.ItemMapEntry
+	168, // 126: unittest.HorizontalAggregateMap.Hero.ItemMapEntry.value:type_name -> unittest.Item
+	141, // 127: unittest.HorizontalAggregateList.HeroMapEntry.value:type_name -> unittest.HorizontalAggregateList.Hero
+	168, // 128: unittest.HorizontalAggregateList.Hero.param_list:type_name -> unittest.Item
+	143, // 129: unittest.RuleConf.RewardMapEntry.value:type_name -> unittest.RuleConf.Reward
+	146, // 130: unittest.VectorConf.SpawnMapEntry.value:type_name -> unittest.VectorConf.Spawn
+	174, // 131: unittest.VectorConf.Spawn.pos:type_name -> tableau.Vector3
+	175, // 132: unittest.VectorConf.Spawn.point_list:type_name -> tableau.Vector2i
+	175, // 133: unittest.VectorConf.Spawn.offset_list:type_name -> tableau.Vector2i
+	148, // 134: unittest.DecimalConf.GoodsMapEntry.value:type_name -> unittest.DecimalConf.Goods
+	176, // 135: unittest.DecimalConf.Goods.price:type_name -> tableau.Decimal
+	150, // 136: unittest.ColorConf.ThemeMapEntry.value:type_name -> unittest.ColorConf.Theme
+	177, // 137: unittest.ColorConf.Theme.color:type_name -> tableau.Color
+	177, // 138: unittest.ColorConf.Theme.palette:type_name -> tableau.Color
+	152, // 139: unittest.IntervalConf.BracketMapEntry.value:type_name -> unittest.IntervalConf.Bracket
+	178, // 140: unittest.IntervalConf.Bracket.level:type_name -> tableau.Interval
+	179, // 141: unittest.IntervalConf.Bracket.roll_list:type_name -> tableau.DoubleInterval
+	180, // 142: unittest.IntervalConf.Bracket.window:type_name -> tableau.TimestampInterval
+	154, // 143: unittest.WeightConf.DropMapEntry.value:type_name -> unittest.WeightConf.Drop
+	156, // 144: unittest.FlagsConf.ActivityMapEntry.value:type_name -> unittest.FlagsConf.Activity
+	158, // 145: unittest.ScheduleConf.EventMapEntry.value:type_name -> unittest.ScheduleConf.Event
+	181, // 146: unittest.ScheduleConf.Event.reset:type_name -> tableau.Schedule
+	181, // 147: unittest.ScheduleConf.Event.open:type_name -> tableau.Schedule
+	160, // 148: unittest.TextConf.ItemMapEntry.value:type_name -> unittest.TextConf.Item
+	162, // 149: unittest.StringFormatConf.FilterMapEntry.value:type_name -> unittest.StringFormatConf.Filter
+	164, // 150: unittest.ComputeConf.ItemMapEntry.value:type_name -> unittest.ComputeConf.Item
+	166, // 151: unittest.UniqueDomainMapConf.RewardMapEntry.value:type_name -> unittest.UniqueDomainMapConf.Reward
+	152, // [152:152] is the sub-list for method output_type
+	152, // [152:152] is the sub-list for method input_type
+	152, // [152:152] is the sub-list for extension type_name
+	152, // [152:152] is the sub-list for extension extendee
+	0,   // [0:152] is the sub-list for field type_name
+}
+
+func init() { file_tableau_protobuf_unittest_unittest_proto_init() }
+func file_tableau_protobuf_unittest_unittest_proto_init() {
+	if File_tableau_protobuf_unittest_unittest_proto != nil {
+		return
+	}
+	file_tableau_protobuf_unittest_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimpleIncellMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncellMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncellStructList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncellList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MallConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*YamlScalarConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchReplaceConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchMergeConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecursivePatchConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JsonUtilTestData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueFieldInVerticalStructList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalUniqueFieldStructMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceFieldInVerticalStructList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceKeyInVerticalKeyedList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalSequenceFieldStructMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentSequenceFieldStructList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transpose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldPresentMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5870,8 +8191,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncellMap); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScatterNoneConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5882,8 +8203,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncellStructList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScatterReplaceConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5894,8 +8215,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncellList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScatterMergeConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5906,8 +8227,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ItemConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergerSingleConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5918,8 +8239,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MallConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergerMultiConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5930,8 +8251,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalAggregationMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5942,8 +8263,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncellKeyedList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5954,8 +8275,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*YamlScalarConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HorizontalAggregateMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5966,8 +8287,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PatchReplaceConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HorizontalAggregateList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5978,8 +8299,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PatchMergeConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5990,8 +8311,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecursivePatchConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleKVConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6002,8 +8323,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JsonUtilTestData); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6014,8 +8335,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniqueFieldInVerticalStructList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecimalConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6026,8 +8347,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalUniqueFieldStructMap); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6038,8 +8359,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntervalConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6050,8 +8371,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructMap); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeightConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6062,8 +8383,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SequenceFieldInVerticalStructList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6074,8 +8395,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SequenceKeyInVerticalKeyedList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6086,8 +8407,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalSequenceFieldStructMap); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6098,8 +8419,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentSequenceFieldStructList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringFormatConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6110,8 +8431,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Transpose); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComputeConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6122,8 +8443,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueDomainMapConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6134,8 +8455,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueDomainListConf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6146,8 +8467,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldPresentMap); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncellMap_Fruit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6158,8 +8479,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScatterNoneConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncellMap_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6170,8 +8491,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScatterReplaceConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MallConf_Shop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6182,8 +8503,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScatterMergeConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MallConf_Shop_Goods); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6194,8 +8515,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MergerSingleConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityConf_Activity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6206,8 +8527,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MergerMultiConf); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityConf_Activity_Chapter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6218,8 +8539,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalAggregationMap); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityConf_Activity_Chapter_Section); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6230,8 +8551,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncellKeyedList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityConf_Activity_Chapter_Section_Reward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6242,8 +8563,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HorizontalAggregateMap); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardConf_Reward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6254,8 +8575,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HorizontalAggregateList); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchMergeConf_Time); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6266,8 +8587,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncellMap_Fruit); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecursivePatchConf_Shop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6278,8 +8599,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncellMap_Item); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecursivePatchConf_Shop_Goods); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6290,8 +8611,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MallConf_Shop); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecursivePatchConf_Shop_Goods_Currency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6302,8 +8623,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MallConf_Shop_Goods); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecursivePatchConf_Shop_Goods_Award); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6314,8 +8635,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityConf_Activity); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueFieldInVerticalStructList_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6326,8 +8647,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityConf_Activity_Chapter); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalUniqueFieldStructMap_Main); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6338,8 +8659,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityConf_Activity_Chapter_Section); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalUniqueFieldStructMap_Main_Sub); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6350,8 +8671,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivityConf_Activity_Chapter_Section_Reward); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructList_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6362,8 +8683,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardConf_Reward); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructMap_Chapter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6374,8 +8695,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PatchMergeConf_Time); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructMap_ChapterInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6386,8 +8707,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecursivePatchConf_Shop); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructMap_Chapter_Section); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6398,8 +8719,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecursivePatchConf_Shop_Goods); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructMap_ChapterInfo_Section); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6410,8 +8731,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecursivePatchConf_Shop_Goods_Currency); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6422,8 +8743,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecursivePatchConf_Shop_Goods_Award); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6434,8 +8755,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniqueFieldInVerticalStructList_Item); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceFieldInVerticalStructList_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6446,8 +8767,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalUniqueFieldStructMap_Main); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceKeyInVerticalKeyedList_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6458,8 +8779,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalUniqueFieldStructMap_Main_Sub); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceKeyInVerticalKeyedList_Item_Prop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6470,8 +8791,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructList_Item); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalSequenceFieldStructMap_Main); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6482,8 +8803,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructMap_Chapter); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalSequenceFieldStructMap_Main_Sub); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6494,8 +8815,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructMap_ChapterInfo); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentSequenceFieldStructList_Item); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6506,8 +8827,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructMap_Chapter_Section); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transpose_Hero); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6518,8 +8839,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructMap_ChapterInfo_Section); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskConf_Task); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6530,8 +8851,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldPresentMap_Player); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6542,8 +8863,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentUniqueFieldStructMap_ChapterInfo_Section_Section_Section); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldPresentMap_Player_Weapon); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6554,8 +8875,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SequenceFieldInVerticalStructList_Item); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldPresentMap_Player_Info); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6566,8 +8887,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SequenceKeyInVerticalKeyedList_Item); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScatterNoneConf_Zone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6578,8 +8899,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SequenceKeyInVerticalKeyedList_Item_Prop); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScatterReplaceConf_Zone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6590,8 +8911,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalSequenceFieldStructMap_Main); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScatterMergeConf_Zone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6602,8 +8923,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalSequenceFieldStructMap_Main_Sub); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergerSingleConf_Zone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6614,8 +8935,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentSequenceFieldStructList_Item); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergerMultiConf_Zone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6626,8 +8947,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Transpose_Hero); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalAggregationMap_Hero); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6638,8 +8959,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskConf_Task); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerticalAggregationMap_Hero_Level); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6650,8 +8971,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldPresentMap_Player); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HorizontalAggregateMap_Hero); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6662,8 +8983,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldPresentMap_Player_Weapon); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HorizontalAggregateList_Hero); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6674,8 +8995,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldPresentMap_Player_Info); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleConf_Reward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6686,8 +9007,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScatterNoneConf_Zone); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleConf_Level); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6698,8 +9019,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScatterReplaceConf_Zone); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorConf_Spawn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6710,8 +9031,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScatterMergeConf_Zone); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecimalConf_Goods); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6722,8 +9043,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MergerSingleConf_Zone); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorConf_Theme); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6734,8 +9055,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MergerMultiConf_Zone); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntervalConf_Bracket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6746,8 +9067,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalAggregationMap_Hero); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeightConf_Drop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6758,8 +9079,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerticalAggregationMap_Hero_Level); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagsConf_Activity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6770,8 +9091,8 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HorizontalAggregateMap_Hero); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleConf_Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6782,8 +9103,56 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 				return nil
 			}
 		}
-		file_tableau_protobuf_unittest_unittest_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HorizontalAggregateList_Hero); i {
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextConf_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringFormatConf_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComputeConf_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueDomainMapConf_Reward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_unittest_unittest_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueDomainListConf_Reward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6801,13 +9170,14 @@ func file_tableau_protobuf_unittest_unittest_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tableau_protobuf_unittest_unittest_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   127,
+			NumEnums:      1,
+			NumMessages:   167,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_tableau_protobuf_unittest_unittest_proto_goTypes,
 		DependencyIndexes: file_tableau_protobuf_unittest_unittest_proto_depIdxs,
+		EnumInfos:         file_tableau_protobuf_unittest_unittest_proto_enumTypes,
 		MessageInfos:      file_tableau_protobuf_unittest_unittest_proto_msgTypes,
 	}.Build()
 	File_tableau_protobuf_unittest_unittest_proto = out.File
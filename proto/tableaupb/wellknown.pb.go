@@ -12,6 +12,7 @@ package tableaupb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -686,78 +687,558 @@ func (x *Version) GetOthers() []uint32 {
 	return nil
 }
 
+// Exact fixed-point number, e.g.: prices and exchange rates.
+// Supported formats: 123.45, -0.05, +10
+//
+// The value is unscaled * 10^(-scale), e.g.: 123.45 is unscaled 12345 with
+// scale 2. Use type alias "decimal(P,S)" or field prop pattern "P,S" to
+// specify the max count of digits (precision) and digits after the decimal
+// point (scale).
+type Decimal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Unscaled int64 `protobuf:"varint,1,opt,name=unscaled,proto3" json:"unscaled,omitempty"` // unscaled integer value
+	Scale    int32 `protobuf:"varint,2,opt,name=scale,proto3" json:"scale,omitempty"`       // count of digits after the decimal point
+}
+
+func (x *Decimal) Reset() {
+	*x = Decimal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_wellknown_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decimal) ProtoMessage() {}
+
+func (x *Decimal) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_wellknown_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decimal.ProtoReflect.Descriptor instead.
+func (*Decimal) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_wellknown_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Decimal) GetUnscaled() int64 {
+	if x != nil {
+		return x.Unscaled
+	}
+	return 0
+}
+
+func (x *Decimal) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+// RGBA color, and each channel ranges from 0 to 255.
+// Supported formats:
+//   - hex: #RGB, #RGBA, #RRGGBB, #RRGGBBAA, e.g.: #FF8000, #FF800080
+//   - functional: rgb(255, 128, 0), rgba(255, 128, 0, 0.5)
+//   - named CSS colors: red, orange, transparent, and so on.
+//
+// The alpha channel is 255 (opaque) if not specified.
+type Color struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	R uint32 `protobuf:"varint,1,opt,name=r,proto3" json:"r,omitempty"` // red
+	G uint32 `protobuf:"varint,2,opt,name=g,proto3" json:"g,omitempty"` // green
+	B uint32 `protobuf:"varint,3,opt,name=b,proto3" json:"b,omitempty"` // blue
+	A uint32 `protobuf:"varint,4,opt,name=a,proto3" json:"a,omitempty"` // alpha, 0 is fully transparent and 255 is opaque
+}
+
+func (x *Color) Reset() {
+	*x = Color{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_wellknown_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Color) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Color) ProtoMessage() {}
+
+func (x *Color) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_wellknown_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Color.ProtoReflect.Descriptor instead.
+func (*Color) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_wellknown_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Color) GetR() uint32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *Color) GetG() uint32 {
+	if x != nil {
+		return x.G
+	}
+	return 0
+}
+
+func (x *Color) GetB() uint32 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *Color) GetA() uint32 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+// An interval using int64 bounds, e.g.: level range [1,10).
+type Interval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower          int64 `protobuf:"varint,1,opt,name=lower,proto3" json:"lower,omitempty"`                                         // lower bound
+	Upper          int64 `protobuf:"varint,2,opt,name=upper,proto3" json:"upper,omitempty"`                                         // upper bound
+	LowerExclusive bool  `protobuf:"varint,3,opt,name=lower_exclusive,json=lowerExclusive,proto3" json:"lower_exclusive,omitempty"` // whether the lower bound is exclusive
+	UpperExclusive bool  `protobuf:"varint,4,opt,name=upper_exclusive,json=upperExclusive,proto3" json:"upper_exclusive,omitempty"` // whether the upper bound is exclusive
+}
+
+func (x *Interval) Reset() {
+	*x = Interval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_wellknown_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interval) ProtoMessage() {}
+
+func (x *Interval) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_wellknown_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interval.ProtoReflect.Descriptor instead.
+func (*Interval) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_wellknown_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Interval) GetLower() int64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *Interval) GetUpper() int64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *Interval) GetLowerExclusive() bool {
+	if x != nil {
+		return x.LowerExclusive
+	}
+	return false
+}
+
+func (x *Interval) GetUpperExclusive() bool {
+	if x != nil {
+		return x.UpperExclusive
+	}
+	return false
+}
+
+// An interval using double bounds, e.g.: damage roll [0.5,1.5].
+type DoubleInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower          float64 `protobuf:"fixed64,1,opt,name=lower,proto3" json:"lower,omitempty"`                                        // lower bound
+	Upper          float64 `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`                                        // upper bound
+	LowerExclusive bool    `protobuf:"varint,3,opt,name=lower_exclusive,json=lowerExclusive,proto3" json:"lower_exclusive,omitempty"` // whether the lower bound is exclusive
+	UpperExclusive bool    `protobuf:"varint,4,opt,name=upper_exclusive,json=upperExclusive,proto3" json:"upper_exclusive,omitempty"` // whether the upper bound is exclusive
+}
+
+func (x *DoubleInterval) Reset() {
+	*x = DoubleInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_wellknown_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleInterval) ProtoMessage() {}
+
+func (x *DoubleInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_wellknown_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleInterval.ProtoReflect.Descriptor instead.
+func (*DoubleInterval) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_wellknown_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DoubleInterval) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *DoubleInterval) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *DoubleInterval) GetLowerExclusive() bool {
+	if x != nil {
+		return x.LowerExclusive
+	}
+	return false
+}
+
+func (x *DoubleInterval) GetUpperExclusive() bool {
+	if x != nil {
+		return x.UpperExclusive
+	}
+	return false
+}
+
+// An interval using timestamp bounds, e.g.: time window
+// [2024-01-01 00:00:00,2024-02-01 00:00:00).
+type TimestampInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lower          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=lower,proto3" json:"lower,omitempty"`                                          // lower bound
+	Upper          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=upper,proto3" json:"upper,omitempty"`                                          // upper bound
+	LowerExclusive bool                   `protobuf:"varint,3,opt,name=lower_exclusive,json=lowerExclusive,proto3" json:"lower_exclusive,omitempty"` // whether the lower bound is exclusive
+	UpperExclusive bool                   `protobuf:"varint,4,opt,name=upper_exclusive,json=upperExclusive,proto3" json:"upper_exclusive,omitempty"` // whether the upper bound is exclusive
+}
+
+func (x *TimestampInterval) Reset() {
+	*x = TimestampInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_wellknown_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimestampInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampInterval) ProtoMessage() {}
+
+func (x *TimestampInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_wellknown_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampInterval.ProtoReflect.Descriptor instead.
+func (*TimestampInterval) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_wellknown_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TimestampInterval) GetLower() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lower
+	}
+	return nil
+}
+
+func (x *TimestampInterval) GetUpper() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Upper
+	}
+	return nil
+}
+
+func (x *TimestampInterval) GetLowerExclusive() bool {
+	if x != nil {
+		return x.LowerExclusive
+	}
+	return false
+}
+
+func (x *TimestampInterval) GetUpperExclusive() bool {
+	if x != nil {
+		return x.UpperExclusive
+	}
+	return false
+}
+
+// Recurring schedule parsed from cron expression with five fields
+// "minute hour day-of-month month day-of-week" or six fields with leading
+// "second", e.g.: "0 5 * * MON" or "0 0 5 * * MON" (every Monday 05:00:00).
+// Each field supports "*", "?", lists "1,2", ranges "1-5", and steps "*/15"
+// and "1-30/5". Months and days of week can also be names, e.g.: "JAN" and
+// "MON". Predefined schedules are supported: "@yearly" (or "@annually"),
+// "@monthly", "@weekly", "@daily" (or "@midnight"), and "@hourly".
+//
+// If both day-of-month and day-of-week are restricted (not "*" or "?"), the
+// schedule occurs when either matches, same as standard cron.
+type Schedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cron     string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`         // normalized cron expression with six fields, e.g.: "0 0 5 * * MON"
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA time zone name the cron expression is evaluated in, e.g.: "Asia/Shanghai"
+	// Next occurrences precomputed by field prop "schedule".
+	Occurrences []*timestamppb.Timestamp `protobuf:"bytes,3,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tableau_protobuf_wellknown_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_tableau_protobuf_wellknown_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_tableau_protobuf_wellknown_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Schedule) GetOccurrences() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
 var File_tableau_protobuf_wellknown_proto protoreflect.FileDescriptor
 
 var file_tableau_protobuf_wellknown_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x77, 0x65, 0x6c, 0x6c, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x1a, 0x1e, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x08, 0x46,
-	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x65, 0x6e, 0x22, 0xe3, 0x01, 0x0a, 0x0a,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x69,
-	0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x61, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61,
-	0x75, 0x2e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x7e, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49, 0x47,
-	0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x49, 0x47,
-	0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0d, 0x0a,
-	0x09, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
-	0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55,
-	0x41, 0x4c, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x47, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x52, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x47,
-	0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10,
-	0x05, 0x22, 0x37, 0x0a, 0x07, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x12, 0x15, 0x0a, 0x01,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x58,
-	0x52, 0x01, 0x78, 0x12, 0x15, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07,
-	0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x59, 0x52, 0x01, 0x79, 0x22, 0x4e, 0x0a, 0x07, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x33, 0x12, 0x15, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x58, 0x52, 0x01, 0x78, 0x12, 0x15, 0x0a, 0x01,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x59,
-	0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07,
-	0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x5a, 0x52, 0x01, 0x7a, 0x22, 0x65, 0x0a, 0x07, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x34, 0x12, 0x15, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x58, 0x52, 0x01, 0x78, 0x12, 0x15, 0x0a, 0x01,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x59,
-	0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07,
-	0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x5a, 0x52, 0x01, 0x7a, 0x12, 0x15, 0x0a, 0x01, 0x77, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x57, 0x52, 0x01,
-	0x77, 0x22, 0x38, 0x0a, 0x08, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x69, 0x12, 0x15, 0x0a,
-	0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01,
-	0x58, 0x52, 0x01, 0x78, 0x12, 0x15, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x59, 0x52, 0x01, 0x79, 0x22, 0x4f, 0x0a, 0x08, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x69, 0x12, 0x15, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x58, 0x52, 0x01, 0x78, 0x12, 0x15,
-	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a,
-	0x01, 0x59, 0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x5a, 0x52, 0x01, 0x7a, 0x22, 0x66, 0x0a, 0x08,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x34, 0x69, 0x12, 0x15, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
+	0x74, 0x6f, 0x12, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x08,
+	0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x65, 0x6e, 0x22, 0xe3, 0x01, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x73,
+	0x69, 0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x61, 0x75, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x61, 0x75, 0x2e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x7e, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49,
+	0x47, 0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x49,
+	0x47, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51,
+	0x55, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x47, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x52, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x5f,
+	0x47, 0x52, 0x45, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c,
+	0x10, 0x05, 0x22, 0x37, 0x0a, 0x07, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x12, 0x15, 0x0a,
+	0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01,
+	0x58, 0x52, 0x01, 0x78, 0x12, 0x15, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42,
+	0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x59, 0x52, 0x01, 0x79, 0x22, 0x4e, 0x0a, 0x07, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x12, 0x15, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x58, 0x52, 0x01, 0x78, 0x12, 0x15, 0x0a,
+	0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01,
+	0x59, 0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42,
+	0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x5a, 0x52, 0x01, 0x7a, 0x22, 0x65, 0x0a, 0x07, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x34, 0x12, 0x15, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x58, 0x52, 0x01, 0x78, 0x12, 0x15, 0x0a,
+	0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01,
+	0x59, 0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42,
+	0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x5a, 0x52, 0x01, 0x7a, 0x12, 0x15, 0x0a, 0x01, 0x77,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x57, 0x52,
+	0x01, 0x77, 0x22, 0x38, 0x0a, 0x08, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x69, 0x12, 0x15,
+	0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a,
+	0x01, 0x58, 0x52, 0x01, 0x78, 0x12, 0x15, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x59, 0x52, 0x01, 0x79, 0x22, 0x4f, 0x0a, 0x08,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x69, 0x12, 0x15, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x58, 0x52, 0x01, 0x78, 0x12,
 	0x15, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03,
 	0x0a, 0x01, 0x59, 0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x5a, 0x52, 0x01, 0x7a, 0x12, 0x15, 0x0a,
-	0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01,
-	0x57, 0x52, 0x01, 0x77, 0x22, 0x87, 0x01, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
-	0x74, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x03, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69,
-	0x6e, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x42, 0x78,
-	0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x42, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x69, 0x6f, 0x2f, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x61, 0x75, 0x70, 0x62, 0xf8, 0x01, 0x01, 0xa2, 0x02, 0x03, 0x54, 0x50, 0x42, 0xaa, 0x02, 0x18,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x5a, 0x52, 0x01, 0x7a, 0x22, 0x66, 0x0a,
+	0x08, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x34, 0x69, 0x12, 0x15, 0x0a, 0x01, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x58, 0x52, 0x01, 0x78,
+	0x12, 0x15, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18,
+	0x03, 0x0a, 0x01, 0x59, 0x52, 0x01, 0x79, 0x12, 0x15, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a, 0x01, 0x5a, 0x52, 0x01, 0x7a, 0x12, 0x15,
+	0x0a, 0x01, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x0a,
+	0x01, 0x57, 0x52, 0x01, 0x77, 0x22, 0x87, 0x01, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x74, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x69, 0x6e, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x22,
+	0x3b, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x6e,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x3f, 0x0a, 0x05,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01,
+	0x67, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x62, 0x12,
+	0x0c, 0x0a, 0x01, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x61, 0x22, 0x88, 0x01,
+	0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x70, 0x70, 0x65, 0x72, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x70, 0x70, 0x65, 0x72,
+	0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x11, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x30, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x70,
+	0x70, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x70, 0x70, 0x65, 0x72, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x22, 0x78, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42,
+	0x78, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x42, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x69, 0x6f, 0x2f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x61, 0x75, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x61, 0x75, 0x70, 0x62, 0xf8, 0x01, 0x01, 0xa2, 0x02, 0x03, 0x54, 0x50, 0x42, 0xaa, 0x02,
+	0x18, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -773,27 +1254,37 @@ func file_tableau_protobuf_wellknown_proto_rawDescGZIP() []byte {
 }
 
 var file_tableau_protobuf_wellknown_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_tableau_protobuf_wellknown_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_tableau_protobuf_wellknown_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_tableau_protobuf_wellknown_proto_goTypes = []interface{}{
-	(Comparator_Sign)(0), // 0: tableau.Comparator.Sign
-	(*Fraction)(nil),     // 1: tableau.Fraction
-	(*Comparator)(nil),   // 2: tableau.Comparator
-	(*Vector2)(nil),      // 3: tableau.Vector2
-	(*Vector3)(nil),      // 4: tableau.Vector3
-	(*Vector4)(nil),      // 5: tableau.Vector4
-	(*Vector2I)(nil),     // 6: tableau.Vector2i
-	(*Vector3I)(nil),     // 7: tableau.Vector3i
-	(*Vector4I)(nil),     // 8: tableau.Vector4i
-	(*Version)(nil),      // 9: tableau.Version
+	(Comparator_Sign)(0),          // 0: tableau.Comparator.Sign
+	(*Fraction)(nil),              // 1: tableau.Fraction
+	(*Comparator)(nil),            // 2: tableau.Comparator
+	(*Vector2)(nil),               // 3: tableau.Vector2
+	(*Vector3)(nil),               // 4: tableau.Vector3
+	(*Vector4)(nil),               // 5: tableau.Vector4
+	(*Vector2I)(nil),              // 6: tableau.Vector2i
+	(*Vector3I)(nil),              // 7: tableau.Vector3i
+	(*Vector4I)(nil),              // 8: tableau.Vector4i
+	(*Version)(nil),               // 9: tableau.Version
+	(*Decimal)(nil),               // 10: tableau.Decimal
+	(*Color)(nil),                 // 11: tableau.Color
+	(*Interval)(nil),              // 12: tableau.Interval
+	(*DoubleInterval)(nil),        // 13: tableau.DoubleInterval
+	(*TimestampInterval)(nil),     // 14: tableau.TimestampInterval
+	(*Schedule)(nil),              // 15: tableau.Schedule
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_tableau_protobuf_wellknown_proto_depIdxs = []int32{
-	0, // 0: tableau.Comparator.sign:type_name -> tableau.Comparator.Sign
-	1, // 1: tableau.Comparator.value:type_name -> tableau.Fraction
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0,  // 0: tableau.Comparator.sign:type_name -> tableau.Comparator.Sign
+	1,  // 1: tableau.Comparator.value:type_name -> tableau.Fraction
+	16, // 2: tableau.TimestampInterval.lower:type_name -> google.protobuf.Timestamp
+	16, // 3: tableau.TimestampInterval.upper:type_name -> google.protobuf.Timestamp
+	16, // 4: tableau.Schedule.occurrences:type_name -> google.protobuf.Timestamp
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_tableau_protobuf_wellknown_proto_init() }
@@ -911,6 +1402,78 @@ func file_tableau_protobuf_wellknown_proto_init() {
 				return nil
 			}
 		}
+		file_tableau_protobuf_wellknown_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decimal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_wellknown_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Color); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_wellknown_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_wellknown_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleInterval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_wellknown_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimestampInterval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tableau_protobuf_wellknown_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -918,7 +1481,7 @@ func file_tableau_protobuf_wellknown_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tableau_protobuf_wellknown_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
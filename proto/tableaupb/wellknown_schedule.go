@@ -0,0 +1,269 @@
+package tableaupb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed cron expression of [Schedule].
+type CronSchedule struct {
+	spec string // normalized cron expression with six fields
+
+	// Each field is a bitset of matched values, e.g.: bit N of hour is set if
+	// the schedule occurs at hour N.
+	second, minute, hour, dom, month, dow uint64
+	// Whether day-of-month or day-of-week is unrestricted ("*" or "?").
+	domStar, dowStar bool
+}
+
+type cronBounds struct {
+	name     string
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	cronSeconds = cronBounds{name: "second", min: 0, max: 59}
+	cronMinutes = cronBounds{name: "minute", min: 0, max: 59}
+	cronHours   = cronBounds{name: "hour", min: 0, max: 23}
+	cronDoms    = cronBounds{name: "day-of-month", min: 1, max: 31}
+	cronMonths  = cronBounds{
+		name: "month", min: 1, max: 12,
+		names: map[string]uint{
+			"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+			"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+		},
+	}
+	// Both 0 and 7 are Sunday.
+	cronDows = cronBounds{
+		name: "day-of-week", min: 0, max: 7,
+		names: map[string]uint{
+			"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+		},
+	}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// cronYearLimit is the count of years to search for the next occurrence.
+const cronYearLimit = 5
+
+// ParseCron parses a cron expression with five fields "minute hour
+// day-of-month month day-of-week", or six fields with leading "second". See
+// [Schedule] for the supported syntax.
+func ParseCron(spec string) (*CronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@") {
+		descriptor, ok := cronDescriptors[strings.ToLower(spec)]
+		if !ok {
+			return nil, fmt.Errorf("unknown descriptor %q", spec)
+		}
+		spec = descriptor
+	}
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("expected 5 or 6 fields, but got %d", len(fields))
+	}
+	s := &CronSchedule{spec: strings.Join(fields, " ")}
+	var err error
+	if s.second, _, err = parseCronField(fields[0], cronSeconds); err != nil {
+		return nil, err
+	}
+	if s.minute, _, err = parseCronField(fields[1], cronMinutes); err != nil {
+		return nil, err
+	}
+	if s.hour, _, err = parseCronField(fields[2], cronHours); err != nil {
+		return nil, err
+	}
+	if s.dom, s.domStar, err = parseCronField(fields[3], cronDoms); err != nil {
+		return nil, err
+	}
+	if s.month, _, err = parseCronField(fields[4], cronMonths); err != nil {
+		return nil, err
+	}
+	if s.dow, s.dowStar, err = parseCronField(fields[5], cronDows); err != nil {
+		return nil, err
+	}
+	// fold Sunday 7 into 0
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	// e.g.: "0 0 0 30 2 *" (February 30th)
+	if s.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, fmt.Errorf("schedule never occurs")
+	}
+	return s, nil
+}
+
+// parseCronField parses a cron field into a bitset, and reports whether the
+// field is unrestricted ("*" or "?").
+func parseCronField(field string, b cronBounds) (bits uint64, star bool, err error) {
+	// the last value of the whole range, excluding Sunday 7 of day-of-week
+	last := b.max
+	if b.name == cronDows.name {
+		last = 6
+	}
+	parts := strings.Split(field, ",")
+	for _, part := range parts {
+		rangeStr, stepStr, hasStep := strings.Cut(part, "/")
+		var start, end, step uint = 0, 0, 1
+		switch {
+		case rangeStr == "*" || rangeStr == "?":
+			if rangeStr == "?" && b.name != cronDoms.name && b.name != cronDows.name {
+				return 0, false, fmt.Errorf("%q is only allowed in day-of-month or day-of-week", rangeStr)
+			}
+			start, end = b.min, last
+			star = len(parts) == 1 && !hasStep
+		default:
+			lowStr, highStr, hasHigh := strings.Cut(rangeStr, "-")
+			if start, err = parseCronValue(lowStr, b); err != nil {
+				return 0, false, err
+			}
+			end = start
+			if hasHigh {
+				if end, err = parseCronValue(highStr, b); err != nil {
+					return 0, false, err
+				}
+			} else if hasStep {
+				// "N/step" is the same as "N-max/step"
+				end = last
+			}
+		}
+		if hasStep {
+			n, err := strconv.ParseUint(stepStr, 10, 8)
+			if err != nil || n == 0 {
+				return 0, false, fmt.Errorf("invalid %s step %q", b.name, stepStr)
+			}
+			step = uint(n)
+		}
+		if start > end {
+			return 0, false, fmt.Errorf("%s range %q is reversed", b.name, rangeStr)
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+	return bits, star, nil
+}
+
+func parseCronValue(value string, b cronBounds) (uint, error) {
+	if n, ok := b.names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", b.name, value)
+	}
+	if uint(n) < b.min || uint(n) > b.max {
+		return 0, fmt.Errorf("%s %d is out of range [%d,%d]", b.name, n, b.min, b.max)
+	}
+	return uint(n), nil
+}
+
+// String returns the normalized cron expression with six fields.
+func (s *CronSchedule) String() string {
+	return s.spec
+}
+
+// Next returns the next occurrence strictly after t, evaluated in t's
+// location. It returns the zero time if no occurrence is found within five
+// years.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	// start from the next whole second
+	t = t.Add(time.Second - time.Duration(t.Nanosecond()))
+	// whether t has been reset to the start of the unit being searched
+	added := false
+	yearLimit := t.Year() + cronYearLimit
+wrap:
+	for t.Year() <= yearLimit {
+		for 1<<uint(t.Month())&s.month == 0 {
+			if !added {
+				added = true
+				t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+			}
+			t = t.AddDate(0, 1, 0)
+			if t.Month() == time.January {
+				continue wrap
+			}
+		}
+		for !s.dayMatches(t) {
+			if !added {
+				added = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+			}
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			if t.Day() == 1 {
+				continue wrap
+			}
+		}
+		for 1<<uint(t.Hour())&s.hour == 0 {
+			if !added {
+				added = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+			}
+			t = t.Add(time.Hour)
+			if t.Hour() == 0 {
+				continue wrap
+			}
+		}
+		for 1<<uint(t.Minute())&s.minute == 0 {
+			if !added {
+				added = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+			}
+			t = t.Add(time.Minute)
+			if t.Minute() == 0 {
+				continue wrap
+			}
+		}
+		for 1<<uint(t.Second())&s.second == 0 {
+			t = t.Add(time.Second)
+			if t.Second() == 0 {
+				continue wrap
+			}
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches reports whether the day of t matches. If both day-of-month and
+// day-of-week are restricted, either matches; otherwise both must match.
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	domMatch := 1<<uint(t.Day())&s.dom != 0
+	dowMatch := 1<<uint(t.Weekday())&s.dow != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// Next returns the next occurrence of the schedule strictly after t, in the
+// schedule's time zone. It returns the zero time if no occurrence is found
+// within five years.
+func (x *Schedule) Next(t time.Time) (time.Time, error) {
+	s, err := ParseCron(x.GetCron())
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := time.LoadLocation(x.GetTimezone())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return s.Next(t.In(loc)), nil
+}
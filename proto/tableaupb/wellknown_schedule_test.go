@@ -0,0 +1,144 @@
+package tableaupb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		want    string
+		wantErr bool
+	}{
+		{name: "five-fields", spec: "0 5 * * MON", want: "0 0 5 * * MON"},
+		{name: "six-fields", spec: " 30  0 5 * * 1-5 ", want: "30 0 5 * * 1-5"},
+		{name: "descriptor", spec: "@Daily", want: "0 0 0 * * *"},
+		{name: "steps-and-lists", spec: "*/15 0,30 8-20/2 ? JAN-MAR,DEC *", want: "*/15 0,30 8-20/2 ? JAN-MAR,DEC *"},
+		{name: "sunday-7", spec: "0 0 * * 7", want: "0 0 0 * * 7"},
+		{name: "leap-day", spec: "0 0 29 2 *", want: "0 0 0 29 2 *"},
+		{name: "unknown-descriptor", spec: "@every 1h", wantErr: true},
+		{name: "too-few-fields", spec: "0 5 * *", wantErr: true},
+		{name: "too-many-fields", spec: "0 0 5 * * MON 2024", wantErr: true},
+		{name: "out-of-range", spec: "0 60 * * *", wantErr: true},
+		{name: "reversed-range", spec: "0 20-8 * * *", wantErr: true},
+		{name: "zero-step", spec: "*/0 * * * *", wantErr: true},
+		{name: "invalid-name", spec: "0 0 * * FOO", wantErr: true},
+		{name: "question-mark-in-hour", spec: "0 ? * * *", wantErr: true},
+		{name: "never-occurs", spec: "0 0 30 2 *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCron(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCron() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseCron().String() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCronSchedule_Next(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "every-monday",
+			spec: "0 5 * * MON",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, shanghai), // Monday
+			want: time.Date(2024, 1, 1, 5, 0, 0, 0, shanghai),
+		},
+		{
+			name: "strictly-after",
+			spec: "0 5 * * MON",
+			from: time.Date(2024, 1, 1, 5, 0, 0, 0, shanghai),
+			want: time.Date(2024, 1, 8, 5, 0, 0, 0, shanghai),
+		},
+		{
+			name: "seconds",
+			spec: "*/15 * * * * *",
+			from: time.Date(2024, 1, 1, 0, 0, 14, 500, time.UTC),
+			want: time.Date(2024, 1, 1, 0, 0, 15, 0, time.UTC),
+		},
+		{
+			name: "next-year",
+			spec: "@yearly",
+			from: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "dom-or-dow",
+			spec: "0 0 15 * FRI",
+			from: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), // Friday
+			want: time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "dom-and-star-dow",
+			spec: "0 0 15 * *",
+			from: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday-7",
+			spec: "0 0 * * 7",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap-day",
+			spec: "0 0 29 2 *",
+			from: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "skip-dst-gap",
+			spec: "30 2 * * *",
+			from: time.Date(2024, 3, 9, 3, 0, 0, 0, newYork),
+			want: time.Date(2024, 3, 11, 2, 30, 0, 0, newYork),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseCron(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("CronSchedule.Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	schedule := &Schedule{Cron: "0 0 5 * * MON", Timezone: "Asia/Shanghai"}
+	got, err := schedule.Next(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := time.Date(2024, 1, 8, 5, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	if !got.Equal(want) {
+		t.Errorf("Schedule.Next() = %v, want %v", got, want)
+	}
+
+	if _, err := (&Schedule{Cron: "0 5 * *"}).Next(time.Now()); err == nil {
+		t.Errorf("Schedule.Next() should fail with invalid cron")
+	}
+	if _, err := (&Schedule{Cron: "@daily", Timezone: "Mars/Olympus"}).Next(time.Now()); err == nil {
+		t.Errorf("Schedule.Next() should fail with invalid timezone")
+	}
+}
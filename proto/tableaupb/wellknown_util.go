@@ -1,6 +1,12 @@
 package tableaupb
 
-import "slices"
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
 
 // NewFraction creates a new fraction.
 func NewFraction(num, den int32) *Fraction {
@@ -80,3 +86,81 @@ func (f *Fraction) MatchAll(cmps ...*Comparator) bool {
 	}
 	return true
 }
+
+// NewDecimal creates a new decimal, which is unscaled * 10^(-scale), e.g.:
+// NewDecimal(12345, 2) is 123.45.
+func NewDecimal(unscaled int64, scale int32) *Decimal {
+	return &Decimal{
+		Unscaled: unscaled,
+		Scale:    scale,
+	}
+}
+
+// ParseDecimal parses a decimal from its string form exactly, e.g.: "123.45",
+// "-0.05", and "+10". The scale is the count of digits after the decimal
+// point, e.g.: 2 for "1.50".
+func ParseDecimal(s string) (*Decimal, error) {
+	sign, text := "", s
+	if strings.HasPrefix(text, "+") || strings.HasPrefix(text, "-") {
+		sign, text = text[:1], text[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(text, ".")
+	if intPart == "" && fracPart == "" {
+		return nil, fmt.Errorf("no digits in %q", s)
+	}
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("invalid character %q in %q", c, s)
+		}
+	}
+	digits := strings.TrimLeft(intPart+fracPart, "0")
+	if digits == "" {
+		digits = "0"
+	}
+	unscaled, err := strconv.ParseInt(strings.TrimPrefix(sign, "+")+digits, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unscaled value of %q out of int64 range", s)
+	}
+	return NewDecimal(unscaled, int32(len(fracPart))), nil
+}
+
+// Rat returns the decimal as an exact rational number.
+func (d *Decimal) Rat() *big.Rat {
+	r := new(big.Rat).SetInt64(d.GetUnscaled())
+	scale := d.GetScale()
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(scale, -scale))), nil)
+	if scale >= 0 {
+		return r.Quo(r, new(big.Rat).SetInt(pow))
+	}
+	return r.Mul(r, new(big.Rat).SetInt(pow))
+}
+
+// Cmp compares two decimals exactly regardless of their scales, and returns:
+//   - -1 if d < other
+//   - 0 if d == other, e.g.: 1.5 and 1.50
+//   - +1 if d > other
+func (d *Decimal) Cmp(other *Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Text returns the string form of the decimal, which can be parsed by
+// [ParseDecimal] losslessly, e.g.: "123.45" and "1.50".
+func (d *Decimal) Text() string {
+	unscaled, scale := d.GetUnscaled(), d.GetScale()
+	digits := strconv.FormatInt(unscaled, 10)
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if scale <= 0 {
+		if unscaled != 0 {
+			digits += strings.Repeat("0", int(-scale))
+		}
+		return sign + digits
+	}
+	if len(digits) <= int(scale) {
+		digits = strings.Repeat("0", int(scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
@@ -173,3 +173,78 @@ func TestMatchAll(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    *Decimal
+		wantErr bool
+	}{
+		{name: "integer", s: "10", want: NewDecimal(10, 0)},
+		{name: "positive sign", s: "+123.45", want: NewDecimal(12345, 2)},
+		{name: "negative fraction", s: "-0.05", want: NewDecimal(-5, 2)},
+		{name: "trailing zeros kept as scale", s: "1.50", want: NewDecimal(150, 2)},
+		{name: "no integer part", s: ".5", want: NewDecimal(5, 1)},
+		{name: "max int64", s: "922337203685477580.7", want: NewDecimal(math.MaxInt64, 1)},
+		{name: "overflow", s: "922337203685477580.8", wantErr: true},
+		{name: "no digits", s: "-.", wantErr: true},
+		{name: "scientific notation", s: "1e3", wantErr: true},
+		{name: "thousands separator", s: "1,000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDecimal(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDecimal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Unscaled != tt.want.Unscaled || got.Scale != tt.want.Scale {
+				t.Errorf("ParseDecimal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Text(t *testing.T) {
+	tests := []struct {
+		unscaled int64
+		scale    int32
+		want     string
+	}{
+		{unscaled: 12345, scale: 2, want: "123.45"},
+		{unscaled: -5, scale: 2, want: "-0.05"},
+		{unscaled: 0, scale: 2, want: "0.00"},
+		{unscaled: 12, scale: 0, want: "12"},
+		{unscaled: 12, scale: -2, want: "1200"},
+		{unscaled: math.MinInt64, scale: 1, want: "-922337203685477580.8"},
+	}
+	for _, tt := range tests {
+		if got := NewDecimal(tt.unscaled, tt.scale).Text(); got != tt.want {
+			t.Errorf("NewDecimal(%d, %d).Text() = %q, want %q", tt.unscaled, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  *Decimal
+		right *Decimal
+		want  int
+	}{
+		{name: "equal with different scales", left: NewDecimal(15, 1), right: NewDecimal(150, 2), want: 0},
+		{name: "less", left: NewDecimal(-5, 2), right: NewDecimal(1, 3), want: -1},
+		{name: "greater", left: NewDecimal(10001, 2), right: NewDecimal(100, 0), want: 1},
+		{name: "large values", left: NewDecimal(math.MaxInt64, 0), right: NewDecimal(math.MaxInt64, 1), want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.left.Cmp(tt.right); got != tt.want {
+				t.Errorf("Cmp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
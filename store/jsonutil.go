@@ -5,6 +5,7 @@ import (
 
 	"github.com/tableauio/tableau/internal/types"
 	"github.com/tableauio/tableau/internal/x/xerrors"
+	"github.com/tableauio/tableau/internal/x/xproto"
 	"github.com/tableauio/tableau/store/jsonparser"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -63,13 +64,65 @@ func processWhenEmitTimezones(msg proto.Message, jsonStr string, parser jsonpars
 }
 
 func convertJSONTimestamp(msg protoreflect.Message, node jsonparser.Node, loc *time.Location, useProtoNames bool) error {
-	if msg.Descriptor().FullName() == types.WellKnownMessageTimestamp {
+	return rangeJSONMessages(msg, node, useProtoNames, func(msg protoreflect.Message, node jsonparser.Node) (bool, error) {
+		if msg.Descriptor().FullName() != types.WellKnownMessageTimestamp {
+			return false, nil
+		}
 		raw, err := node.StrictString()
 		if err != nil {
-			return err
+			return true, err
 		}
 		node.SetString(formatTimestamp(raw, loc))
-		return nil
+		return true, nil
+	})
+}
+
+// processWhenEmitColorHex emits well-known color (tableau.Color) in the
+// canonical hex string format, e.g.: "#FF8000" and "#FF800080".
+func processWhenEmitColorHex(msg proto.Message, jsonStr string, parser jsonparser.Parser, useProtoNames bool) (string, error) {
+	root, err := parser.Parse(jsonStr)
+	if err != nil {
+		return "", xerrors.Wrap(err)
+	}
+	err = rangeJSONMessages(msg.ProtoReflect(), root, useProtoNames, func(msg protoreflect.Message, node jsonparser.Node) (bool, error) {
+		if msg.Descriptor().FullName() != types.WellKnownMessageColor {
+			return false, nil
+		}
+		node.SetString(xproto.FormatColor(msg))
+		return true, nil
+	})
+	if err != nil {
+		return "", xerrors.Wrap(err)
+	}
+	return root.String()
+}
+
+// processWhenEmitDecimalString emits well-known decimal (tableau.Decimal) in
+// the string format, e.g.: "123.45", which can be parsed losslessly.
+func processWhenEmitDecimalString(msg proto.Message, jsonStr string, parser jsonparser.Parser, useProtoNames bool) (string, error) {
+	root, err := parser.Parse(jsonStr)
+	if err != nil {
+		return "", xerrors.Wrap(err)
+	}
+	err = rangeJSONMessages(msg.ProtoReflect(), root, useProtoNames, func(msg protoreflect.Message, node jsonparser.Node) (bool, error) {
+		if msg.Descriptor().FullName() != types.WellKnownMessageDecimal {
+			return false, nil
+		}
+		node.SetString(xproto.FormatDecimal(msg))
+		return true, nil
+	})
+	if err != nil {
+		return "", xerrors.Wrap(err)
+	}
+	return root.String()
+}
+
+// rangeJSONMessages calls f for msg and all its populated sub messages
+// recursively, along with the corresponding JSON nodes. If f returns true,
+// the sub messages of the current message will not be visited.
+func rangeJSONMessages(msg protoreflect.Message, node jsonparser.Node, useProtoNames bool, f func(protoreflect.Message, jsonparser.Node) (bool, error)) error {
+	if handled, err := f(msg, node); handled || err != nil {
+		return err
 	}
 	// See https://github.com/protocolbuffers/protobuf-go/blob/v1.34.2/encoding/protojson/encode.go#L262
 	fieldJSONName := func(fd protoreflect.FieldDescriptor) string {
@@ -89,7 +142,7 @@ func convertJSONTimestamp(msg protoreflect.Message, node jsonparser.Node, loc *t
 				return true
 			}
 			v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
-				err := convertJSONTimestamp(value.Message(), subNode.Get(key.String()), loc, useProtoNames)
+				err := rangeJSONMessages(value.Message(), subNode.Get(key.String()), useProtoNames, f)
 				if err != nil {
 					finalErr = err
 					return false
@@ -98,14 +151,14 @@ func convertJSONTimestamp(msg protoreflect.Message, node jsonparser.Node, loc *t
 			})
 		} else if fd.IsList() {
 			for i := 0; i < v.List().Len(); i++ {
-				err := convertJSONTimestamp(v.List().Get(i).Message(), subNode.Index(i), loc, useProtoNames)
+				err := rangeJSONMessages(v.List().Get(i).Message(), subNode.Index(i), useProtoNames, f)
 				if err != nil {
 					finalErr = err
 					break
 				}
 			}
 		} else {
-			err := convertJSONTimestamp(v.Message(), subNode, loc, useProtoNames)
+			err := rangeJSONMessages(v.Message(), subNode, useProtoNames, f)
 			if err != nil {
 				finalErr = err
 			}
@@ -54,6 +54,14 @@ type MarshalOptions struct {
 	// timezones (as indicated by an offset).
 	EmitTimezones bool
 
+	// EmitColorHex specifies whether to emit well-known color (tableau.Color)
+	// in the canonical hex string format, e.g.: "#FF8000".
+	EmitColorHex bool
+
+	// EmitDecimalString specifies whether to emit well-known decimal
+	// (tableau.Decimal) in the string format, e.g.: "123.45".
+	EmitDecimalString bool
+
 	// UseProtoNames uses proto field name instead of lowerCamelCase name in JSON
 	// field names.
 	UseProtoNames bool
@@ -82,6 +90,22 @@ func MarshalToJSON(msg proto.Message, options *MarshalOptions) (out []byte, err
 		}
 		messageJSON = []byte(result)
 	}
+	// process when emit color as hex string
+	if options.EmitColorHex {
+		result, err := processWhenEmitColorHex(msg, string(messageJSON), jsonparser.Fastjson, options.UseProtoNames)
+		if err != nil {
+			return nil, err
+		}
+		messageJSON = []byte(result)
+	}
+	// process when emit decimal as string
+	if options.EmitDecimalString {
+		result, err := processWhenEmitDecimalString(msg, string(messageJSON), jsonparser.Fastjson, options.UseProtoNames)
+		if err != nil {
+			return nil, err
+		}
+		messageJSON = []byte(result)
+	}
 	// protojson does not offer a "deterministic" field ordering, but fields
 	// are still ordered consistently by their index. However, protojson can
 	// output inconsistent whitespace for some reason, therefore it is
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tableauio/tableau/proto/tableaupb"
 	"github.com/tableauio/tableau/proto/tableaupb/unittestpb"
 	"google.golang.org/protobuf/proto"
 )
@@ -74,6 +75,45 @@ func Test_MarshalToJSON(t *testing.T) {
 }`),
 			wantErr: false,
 		},
+		{
+			name: "decimal-conf-emit-decimal-string",
+			args: args{
+				msg: &unittestpb.DecimalConf{
+					GoodsMap: map[uint32]*unittestpb.DecimalConf_Goods{
+						1: {Id: 1, Price: &tableaupb.Decimal{Unscaled: 10, Scale: 2}},
+						2: {Id: 2, Price: &tableaupb.Decimal{Unscaled: 1999, Scale: 2}},
+					},
+				},
+				options: &MarshalOptions{
+					EmitDecimalString: true,
+				},
+			},
+			wantOut: []byte(`{"goodsMap":{"1":{"id":1,"price":"0.10"},"2":{"id":2,"price":"19.99"}}}`),
+			wantErr: false,
+		},
+		{
+			name: "color-conf-emit-color-hex",
+			args: args{
+				msg: &unittestpb.ColorConf{
+					ThemeMap: map[uint32]*unittestpb.ColorConf_Theme{
+						1: {
+							Id:    1,
+							Color: &tableaupb.Color{R: 255, G: 165, B: 0, A: 255},
+							Palette: []*tableaupb.Color{
+								{R: 0, G: 0, B: 0, A: 255},
+								{R: 255, G: 255, B: 255, A: 136},
+							},
+						},
+						2: {Id: 2, Color: &tableaupb.Color{R: 0, G: 0, B: 255, A: 128}},
+					},
+				},
+				options: &MarshalOptions{
+					EmitColorHex: true,
+				},
+			},
+			wantOut: []byte(`{"themeMap":{"1":{"id":1,"color":"#FFA500","palette":["#000000","#FFFFFF88"]},"2":{"id":2,"color":"#0000FF80"}}}`),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -57,6 +57,14 @@ type Options struct {
 	// NOTE: use with option "LocationName".
 	EmitTimezones bool
 
+	// EmitColorHex specifies whether to emit well-known color (tableau.Color)
+	// in the canonical hex string format, e.g.: "#FF8000".
+	EmitColorHex bool
+
+	// EmitDecimalString specifies whether to emit well-known decimal
+	// (tableau.Decimal) in the string format, e.g.: "123.45".
+	EmitDecimalString bool
+
 	// UseProtoNames uses proto field name instead of lowerCamelCase name in JSON
 	// field names.
 	UseProtoNames bool
@@ -154,3 +162,19 @@ func EmitTimezones(v bool) Option {
 		opts.EmitTimezones = v
 	}
 }
+
+// EmitColorHex specifies whether to emit well-known color (tableau.Color)
+// in the canonical hex string format, e.g.: "#FF8000".
+func EmitColorHex(v bool) Option {
+	return func(opts *Options) {
+		opts.EmitColorHex = v
+	}
+}
+
+// EmitDecimalString specifies whether to emit well-known decimal
+// (tableau.Decimal) in the string format, e.g.: "123.45".
+func EmitDecimalString(v bool) Option {
+	return func(opts *Options) {
+		opts.EmitDecimalString = v
+	}
+}
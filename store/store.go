@@ -30,12 +30,14 @@ func Store(msg proto.Message, dir string, fmt format.Format, options ...Option)
 	case format.JSON:
 		filename += format.JSONExt
 		options := &MarshalOptions{
-			LocationName:    opts.LocationName,
-			Pretty:          opts.Pretty,
-			EmitUnpopulated: opts.EmitUnpopulated,
-			EmitTimezones:   opts.EmitTimezones,
-			UseProtoNames:   opts.UseProtoNames,
-			UseEnumNumbers:  opts.UseEnumNumbers,
+			LocationName:      opts.LocationName,
+			Pretty:            opts.Pretty,
+			EmitUnpopulated:   opts.EmitUnpopulated,
+			EmitTimezones:     opts.EmitTimezones,
+			EmitColorHex:      opts.EmitColorHex,
+			EmitDecimalString: opts.EmitDecimalString,
+			UseProtoNames:     opts.UseProtoNames,
+			UseEnumNumbers:    opts.UseEnumNumbers,
 		}
 		out, err = MarshalToJSON(msg, options)
 		if err != nil {
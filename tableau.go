@@ -3,10 +3,13 @@ package tableau
 import (
 	"context"
 
+	"github.com/tableauio/tableau/internal/bookgen"
 	"github.com/tableauio/tableau/internal/confgen"
+	"github.com/tableauio/tableau/internal/docgen"
 	"github.com/tableauio/tableau/internal/importer"
 	"github.com/tableauio/tableau/internal/importer/book"
 	"github.com/tableauio/tableau/internal/localizer"
+	"github.com/tableauio/tableau/internal/lsp"
 	"github.com/tableauio/tableau/internal/protogen"
 	"github.com/tableauio/tableau/internal/x/xerrors"
 	"github.com/tableauio/tableau/internal/x/xproto"
@@ -39,6 +42,21 @@ func GenProto(protoPackage, indir, outdir string, setters ...options.Option) (er
 	return g.Generate()
 }
 
+// CheckProto checks breaking changes between the previously generated
+// protoconf files in outdir and the new ones converted from Excel/CSV/XML/YAML
+// files. The previously generated protoconf files are kept untouched.
+func CheckProto(protoPackage, indir, outdir string, setters ...options.Option) error {
+	opts := options.ParseOptions(setters...)
+	if err := localizer.SetLang(opts.Lang); err != nil {
+		return err
+	}
+	if err := log.Init(opts.Log); err != nil {
+		return err
+	}
+	g := protogen.NewGenerator(protoPackage, indir, outdir, setters...)
+	return g.Check()
+}
+
 // GenConf converts Excel/CSV/XML/YAML files to different configuration files: JSON, Text, and Bin.
 func GenConf(protoPackage, indir, outdir string, setters ...options.Option) error {
 	opts := options.ParseOptions(setters...)
@@ -72,6 +90,34 @@ func NewConfGeneratorWithOptions(protoPackage, indir, outdir string, options *op
 	return confgen.NewGeneratorWithOptions(protoPackage, indir, outdir, options)
 }
 
+// NewBookGenerator creates a new book generator, which generates empty
+// workbook templates (Excel/CSV) from protoconf files.
+func NewBookGenerator(protoPackage, outdir string, options ...options.Option) *bookgen.Generator {
+	return bookgen.NewGenerator(protoPackage, outdir, options...)
+}
+
+// NewBookGeneratorWithOptions creates a new book generator with options.
+func NewBookGeneratorWithOptions(protoPackage, outdir string, options *options.Options) *bookgen.Generator {
+	return bookgen.NewGeneratorWithOptions(protoPackage, outdir, options)
+}
+
+// NewDocGenerator creates a new doc generator, which generates the reference
+// documentation (Markdown or HTML) from protoconf files.
+func NewDocGenerator(protoPackage, outdir string, options ...options.Option) *docgen.Generator {
+	return docgen.NewGenerator(protoPackage, outdir, options...)
+}
+
+// NewDocGeneratorWithOptions creates a new doc generator with options.
+func NewDocGeneratorWithOptions(protoPackage, outdir string, options *options.Options) *docgen.Generator {
+	return docgen.NewGeneratorWithOptions(protoPackage, outdir, options)
+}
+
+// NewLanguageServer creates a new language server of workbooks in text
+// formats (CSV, YAML, and XML), which serves LSP over stdio.
+func NewLanguageServer(protoPackage, indir string, options *options.Options) *lsp.Server {
+	return lsp.NewServer(protoPackage, indir, options)
+}
+
 // SetLang sets the default language.
 // E.g: en, zh.
 func SetLang(lang string) error {